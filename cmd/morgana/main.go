@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -16,19 +18,32 @@ var (
 
 const (
 	flagConfigFilePath = "config-file-path"
+	flagQueueName      = "queue-name"
+	flagLimit          = "limit"
+
+	defaultDeadLetterQueueLimit = 100
 )
 
-func server() *cobra.Command {
+// role is a part of the application that can be deployed on its own.
+type role interface {
+	Start() error
+}
+
+func roleCommand(
+	use string,
+	long string,
+	initializeRole func(configFilePath configs.ConfigFilePath) (role, func(), error),
+) *cobra.Command {
 	command := &cobra.Command{
-		Use:  "standalone-server",
-		Long: "Start all components of GoLoad - gRPC + HTTP server, Kafka consumer, Cronjobs - as a single process",
+		Use:  use,
+		Long: long,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
 			if err != nil {
 				return err
 			}
 
-			app, cleanup, err := wiring.InitializeStandaloneServer(configs.ConfigFilePath(configFilePath))
+			app, cleanup, err := initializeRole(configs.ConfigFilePath(configFilePath))
 			if err != nil {
 				return err
 			}
@@ -44,11 +59,151 @@ func server() *cobra.Command {
 	return command
 }
 
+func server() *cobra.Command {
+	return roleCommand(
+		"standalone-server",
+		"Start all components of GoLoad - gRPC + HTTP server, Kafka consumer, Cronjobs - as a single process",
+		func(configFilePath configs.ConfigFilePath) (role, func(), error) {
+			return wiring.InitializeStandaloneServer(configFilePath)
+		},
+	)
+}
+
+func apiServer() *cobra.Command {
+	return roleCommand(
+		"api-server",
+		"Start the gRPC + HTTP server, which serves the API without executing download tasks",
+		func(configFilePath configs.ConfigFilePath) (role, func(), error) {
+			return wiring.InitializeAPIServer(configFilePath)
+		},
+	)
+}
+
+func worker() *cobra.Command {
+	return roleCommand(
+		"worker",
+		"Start the message queue consumer, which executes the download tasks that are created",
+		func(configFilePath configs.ConfigFilePath) (role, func(), error) {
+			return wiring.InitializeWorker(configFilePath)
+		},
+	)
+}
+
+func scheduler() *cobra.Command {
+	return roleCommand(
+		"scheduler",
+		"Start the Cronjobs, which execute pending download tasks and perform periodic maintenance",
+		func(configFilePath configs.ConfigFilePath) (role, func(), error) {
+			return wiring.InitializeScheduler(configFilePath)
+		},
+	)
+}
+
+func deadLetterQueueList() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "Print the messages of the dead letter queue of a queue that were not replayed yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			queueName, err := cmd.Flags().GetString(flagQueueName)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			deadLetterQueue, cleanup, err := wiring.InitializeDeadLetterQueue(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			messageList, err := deadLetterQueue.GetMessageList(context.Background(), queueName, limit)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for _, message := range messageList {
+				if err = encoder.Encode(message); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	command.Flags().Int(flagLimit, defaultDeadLetterQueueLimit, "The maximum number of messages to print.")
+
+	return command
+}
+
+func deadLetterQueueReplay() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "replay",
+		Short: "Produce the messages of the dead letter queue of a queue that were not replayed yet back to the queue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			queueName, err := cmd.Flags().GetString(flagQueueName)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetInt(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			deadLetterQueue, cleanup, err := wiring.InitializeDeadLetterQueue(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			replayedMessageCount, err := deadLetterQueue.Replay(context.Background(), queueName, limit)
+			fmt.Fprintf(cmd.OutOrStdout(), "replayed %d message(s)\n", replayedMessageCount)
+			return err
+		},
+	}
+
+	command.Flags().Int(flagLimit, defaultDeadLetterQueueLimit, "The maximum number of messages to replay.")
+
+	return command
+}
+
+func deadLetterQueue() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "dead-letter-queue",
+		Short: "Inspect and replay the messages Kafka consumers could not handle",
+	}
+
+	command.PersistentFlags().String(flagConfigFilePath, "", "If provided, will use the provided config file.")
+	command.PersistentFlags().String(flagQueueName, "", "The queue whose dead letter queue to use.")
+	_ = command.MarkPersistentFlagRequired(flagQueueName)
+	command.AddCommand(deadLetterQueueList(), deadLetterQueueReplay())
+
+	return command
+}
+
 func main() {
 	rootCommand := &cobra.Command{
 		Version: fmt.Sprintf("%s-%s", version, commitHash),
 	}
-	rootCommand.AddCommand(server())
+	rootCommand.AddCommand(server(), apiServer(), worker(), scheduler(), deadLetterQueue())
 	err := rootCommand.Execute()
 	if err != nil {
 		log.Panic(err)
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/handler/grpc"
+	"github.com/hoangdv99/morgana/internal/handler/http"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
+	"go.uber.org/zap"
+)
+
+// APIServer serves the gRPC API and its HTTP gateway.
+type APIServer struct {
+	grpcServer     grpc.Server
+	httpServer     http.Server
+	metricsServer  metrics.Server
+	logger         *zap.Logger
+	shutdownConfig configs.Shutdown
+}
+
+func NewAPIServer(
+	grpcServer grpc.Server,
+	httpServer http.Server,
+	metricsServer metrics.Server,
+	logger *zap.Logger,
+	shutdownConfig configs.Shutdown,
+) *APIServer {
+	return &APIServer{
+		grpcServer:     grpcServer,
+		httpServer:     httpServer,
+		metricsServer:  metricsServer,
+		logger:         logger,
+		shutdownConfig: shutdownConfig,
+	}
+}
+
+func (s APIServer) start(ctx context.Context, waitGroup *sync.WaitGroup) error {
+	waitGroup.Add(2)
+
+	go func() {
+		defer waitGroup.Done()
+		err := s.grpcServer.Start(ctx)
+		if err != nil {
+			s.logger.With(zap.Error(err)).Info("failed to start gRPC server")
+		}
+	}()
+
+	go func() {
+		defer waitGroup.Done()
+		err := s.httpServer.Start(ctx)
+		if err != nil {
+			s.logger.With(zap.Error(err)).Info("failed to start HTTP server")
+		}
+	}()
+
+	return nil
+}
+
+func (s APIServer) Start() error {
+	return run(s.shutdownConfig, s.logger, startMetricsServer(s.metricsServer, s.logger), s.start)
+}
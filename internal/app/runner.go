@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+// startFunc starts the components of a role in goroutines added to waitGroup. The components run
+// until ctx is canceled.
+type startFunc func(ctx context.Context, waitGroup *sync.WaitGroup) error
+
+// startMetricsServer returns the startFunc of the metrics server, which every role runs alongside
+// its own components.
+func startMetricsServer(metricsServer metrics.Server, logger *zap.Logger) startFunc {
+	return func(ctx context.Context, waitGroup *sync.WaitGroup) error {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+			err := metricsServer.Start(ctx)
+			if err != nil {
+				logger.With(zap.Error(err)).Info("failed to start metrics server")
+			}
+		}()
+
+		return nil
+	}
+}
+
+// shutdownCleanupTimeout is how long the components are given, once the shutdown timeout has
+// expired and the work still in progress is canceled, to record that work as interrupted.
+const shutdownCleanupTimeout = 5 * time.Second
+
+// waitUntilStopped waits for the components to stop, up to the shutdown timeout, so that the
+// resources they use are not closed while they still need them. The work still in progress past
+// the shutdown timeout is canceled through cancelWork.
+func waitUntilStopped(
+	waitGroup *sync.WaitGroup,
+	shutdownTimeout time.Duration,
+	cancelWork context.CancelFunc,
+	logger *zap.Logger,
+) {
+	stopped := make(chan struct{})
+	go func() {
+		waitGroup.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		logger.Info("all components stopped")
+		return
+	case <-time.After(shutdownTimeout):
+		logger.Warn("not all components stopped before the shutdown timeout, canceling work in progress")
+		cancelWork()
+	}
+
+	select {
+	case <-stopped:
+		logger.Info("all components stopped")
+	case <-time.After(shutdownCleanupTimeout):
+		logger.Warn("not all components stopped after canceling work in progress")
+	}
+}
+
+// run starts the components of the given roles and runs them until the process receives SIGINT
+// or SIGTERM. The servers then stop accepting requests, the consumer stops consuming messages,
+// and the cron scheduler stops scheduling jobs, while the work already in progress is given the
+// shutdown timeout to finish: downloads run under a context that is only canceled once the
+// shutdown timeout has expired. Downloads still running by then are marked as pending, so that
+// they are executed again from the start.
+func run(shutdownConfig configs.Shutdown, logger *zap.Logger, startFuncList ...startFunc) error {
+	shutdownTimeout, err := shutdownConfig.GetTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse shutdown timeout")
+		return err
+	}
+
+	shutdownDeadlineCtx, cancelWork := context.WithCancel(context.Background())
+	defer cancelWork()
+
+	ctx, stop := signal.NotifyContext(
+		utils.WithShutdownDeadline(context.Background(), shutdownDeadlineCtx),
+		syscall.SIGINT,
+		syscall.SIGTERM,
+	)
+	defer stop()
+
+	var waitGroup sync.WaitGroup
+	for _, start := range startFuncList {
+		if err = start(ctx, &waitGroup); err != nil {
+			// The components that were already started are stopped as well.
+			stop()
+			waitUntilStopped(&waitGroup, shutdownTimeout, cancelWork, logger)
+			return err
+		}
+	}
+
+	<-ctx.Done()
+	// Another signal received while shutting down terminates the process right away.
+	stop()
+	logger.Info("shutting down")
+	waitUntilStopped(&waitGroup, shutdownTimeout, cancelWork, logger)
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/handler/jobs"
+	handlerMetrics "github.com/hoangdv99/morgana/internal/handler/metrics"
+	"github.com/hoangdv99/morgana/internal/logic"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	cronJobNameExecuteAllPendingDownloadTask                         = "execute_all_pending_download_task"
+	cronJobNameUpdateDownloadingAndFailedDownloadTaskStatusToPending = "update_downloading_and_failed_download_task_status_to_pending"
+	cronJobNameRewrapAllDownloadTaskDataKey                          = "rewrap_all_download_task_data_key"
+	cronJobNameRotateTokenSigningKey                                 = "rotate_token_signing_key"
+
+	tracerName = "github.com/hoangdv99/morgana/internal/app"
+)
+
+// Scheduler runs the cron jobs. When leader election is enabled, only the replica elected as the
+// leader runs them.
+type Scheduler struct {
+	executeAllPendingDownloadTaskJob                         jobs.ExecuteAllPendingDownloadTask
+	updateDownloadingAndFailedDownloadTaskStatusToPendingJob jobs.UpdateDownloadingAndFailedDownloadTaskStatusToPending
+	rewrapAllDownloadTaskDataKeyJob                          jobs.RewrapAllDownloadTaskDataKey
+	rotateTokenSigningKeyJob                                 jobs.RotateTokenSigningKey
+	leaderElection                                           logic.LeaderElection
+	metricsServer                                            handlerMetrics.Server
+	tracer                                                   trace.Tracer
+	logger                                                   *zap.Logger
+	cronConfig                                               configs.Cron
+	shutdownConfig                                           configs.Shutdown
+}
+
+func NewScheduler(
+	executeAllPendingDownloadTaskJob jobs.ExecuteAllPendingDownloadTask,
+	updateDownloadingAndFailedDownloadTaskStatusToPendingJob jobs.UpdateDownloadingAndFailedDownloadTaskStatusToPending,
+	rewrapAllDownloadTaskDataKeyJob jobs.RewrapAllDownloadTaskDataKey,
+	rotateTokenSigningKeyJob jobs.RotateTokenSigningKey,
+	leaderElection logic.LeaderElection,
+	metricsServer handlerMetrics.Server,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+	cronConfig configs.Cron,
+	shutdownConfig configs.Shutdown,
+) *Scheduler {
+	return &Scheduler{
+		executeAllPendingDownloadTaskJob:                         executeAllPendingDownloadTaskJob,
+		updateDownloadingAndFailedDownloadTaskStatusToPendingJob: updateDownloadingAndFailedDownloadTaskStatusToPendingJob,
+		rewrapAllDownloadTaskDataKeyJob:                          rewrapAllDownloadTaskDataKeyJob,
+		rotateTokenSigningKeyJob:                                 rotateTokenSigningKeyJob,
+		leaderElection:                                           leaderElection,
+		metricsServer:                                            metricsServer,
+		tracer:                                                   tracerProvider.Tracer(tracerName),
+		logger:                                                   logger,
+		cronConfig:                                               cronConfig,
+		shutdownConfig:                                           shutdownConfig,
+	}
+}
+
+// runCronJob runs a cron job as a request of its own, within its own trace, and records its outcome
+// in the metrics.
+func (s Scheduler) runCronJob(ctx context.Context, jobName string, run func(context.Context) error) error {
+	ctx = utils.WithRequestID(ctx, utils.NewRequestID())
+	ctx, span := s.tracer.Start(ctx, jobName)
+	defer span.End()
+
+	startTime := time.Now()
+	err := run(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	metrics.CronJobDurationSeconds.WithLabelValues(jobName).Observe(time.Since(startTime).Seconds())
+	metrics.CronJobRunsTotal.WithLabelValues(jobName, metrics.GetResult(err)).Inc()
+	return err
+}
+
+func (s Scheduler) scheduleCronJobs(ctx context.Context, scheduler gocron.Scheduler) error {
+	_, err := scheduler.NewJob(
+		gocron.CronJob(s.cronConfig.ExecuteAllPendingDownloadTask.Schedule, true),
+		gocron.NewTask(func() {
+			err := s.runCronJob(ctx, cronJobNameExecuteAllPendingDownloadTask, s.executeAllPendingDownloadTaskJob.Run)
+			if err != nil {
+				s.logger.With(zap.Error(err)).Error("failed to run execute all pending download task job")
+			}
+		}),
+	)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to schedule execute all pending download task job")
+		return err
+	}
+
+	_, err = scheduler.NewJob(
+		gocron.CronJob(s.cronConfig.UpdateDownloadingAndFailedDownloadTaskStatusToPending.Schedule, true),
+		gocron.NewTask(func() {
+			err := s.runCronJob(
+				ctx,
+				cronJobNameUpdateDownloadingAndFailedDownloadTaskStatusToPending,
+				s.updateDownloadingAndFailedDownloadTaskStatusToPendingJob.Run,
+			)
+			if err != nil {
+				s.logger.With(zap.Error(err)).Error("failed to run update downloading and failed download task status to pending job")
+			}
+		}),
+	)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to schedule update downloading and failed download task status to pending job")
+		return err
+	}
+
+	_, err = scheduler.NewJob(
+		gocron.CronJob(s.cronConfig.RewrapAllDownloadTaskDataKey.Schedule, true),
+		gocron.NewTask(func() {
+			err := s.runCronJob(ctx, cronJobNameRewrapAllDownloadTaskDataKey, s.rewrapAllDownloadTaskDataKeyJob.Run)
+			if err != nil {
+				s.logger.With(zap.Error(err)).Error("failed to run rewrap all download task data key job")
+			}
+		}),
+	)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to schedule rewrap all download task data key job")
+		return err
+	}
+
+	_, err = scheduler.NewJob(
+		gocron.CronJob(s.cronConfig.RotateTokenSigningKey.Schedule, true),
+		gocron.NewTask(func() {
+			err := s.runCronJob(ctx, cronJobNameRotateTokenSigningKey, s.rotateTokenSigningKeyJob.Run)
+			if err != nil {
+				s.logger.With(zap.Error(err)).Error("failed to run rotate token signing key job")
+			}
+		}),
+	)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to schedule rotate token signing key job")
+		return err
+	}
+
+	return nil
+}
+
+func (s Scheduler) start(ctx context.Context, waitGroup *sync.WaitGroup) error {
+	shutdownTimeout, err := s.shutdownConfig.GetTimeoutDuration()
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to parse shutdown timeout")
+		return err
+	}
+
+	schedulerOptionList := []gocron.SchedulerOption{gocron.WithStopTimeout(shutdownTimeout)}
+	if s.cronConfig.LeaderElection.Enabled {
+		schedulerOptionList = append(schedulerOptionList, gocron.WithDistributedElector(s.leaderElection))
+	}
+
+	scheduler, err := gocron.NewScheduler(schedulerOptionList...)
+	if err != nil {
+		s.logger.With(zap.Error(err)).Error("failed to create cron scheduler")
+		return err
+	}
+
+	err = s.scheduleCronJobs(ctx, scheduler)
+	if err != nil {
+		if shutdownErr := scheduler.Shutdown(); shutdownErr != nil {
+			s.logger.With(zap.Error(shutdownErr)).Warn("failed to shut down cron scheduler")
+		}
+		return err
+	}
+
+	scheduler.Start()
+
+	// The lease keeps being renewed while the cron scheduler shuts down, since the running jobs
+	// are waited for up to the shutdown timeout, which can outlast the lease.
+	leaderElectionCtx, stopLeaderElection := context.WithCancel(context.WithoutCancel(ctx))
+	leaderElectionStopped := make(chan struct{})
+	go func() {
+		defer close(leaderElectionStopped)
+		if s.cronConfig.LeaderElection.Enabled {
+			s.leaderElection.Run(leaderElectionCtx)
+		}
+	}()
+
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		<-ctx.Done()
+		shutdownErr := scheduler.Shutdown()
+		if shutdownErr != nil {
+			s.logger.With(zap.Error(shutdownErr)).Warn("failed to shut down cron scheduler")
+		}
+
+		// The leadership is only given up once the running jobs are done, so that they are not run
+		// concurrently by the next leader. Jobs still running past the shutdown timeout keep the
+		// lease until it expires instead.
+		stopLeaderElection()
+		<-leaderElectionStopped
+		if s.cronConfig.LeaderElection.Enabled && shutdownErr == nil {
+			s.leaderElection.Resign(context.WithoutCancel(ctx))
+		}
+	}()
+
+	return nil
+}
+
+func (s Scheduler) Start() error {
+	return run(s.shutdownConfig, s.logger, startMetricsServer(s.metricsServer, s.logger), s.start)
+}
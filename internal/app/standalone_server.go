@@ -1,87 +1,39 @@
 package app
 
 import (
-	"context"
-	"syscall"
-
-	"github.com/go-co-op/gocron/v2"
 	"github.com/hoangdv99/morgana/internal/configs"
-	"github.com/hoangdv99/morgana/internal/handler/consumers"
-	"github.com/hoangdv99/morgana/internal/handler/grpc"
-	"github.com/hoangdv99/morgana/internal/handler/http"
-	"github.com/hoangdv99/morgana/internal/handler/jobs"
-	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
 	"go.uber.org/zap"
 )
 
+// StandaloneServer runs the API server, the worker and the scheduler in a single process.
 type StandaloneServer struct {
-	grpcServer                                               grpc.Server
-	httpServer                                               http.Server
-	rootConsumer                                             consumers.Root
-	executeAllPendingDownloadTaskJob                         jobs.ExecuteAllPendingDownloadTask
-	updateDownloadingAndFailedDownloadTaskStatusToPendingJob jobs.UpdateDownloadingAndFailedDownloadTaskStatusToPending
-	logger                                                   *zap.Logger
-	cronConfig                                               configs.Cron
+	apiServer      *APIServer
+	worker         *Worker
+	scheduler      *Scheduler
+	metricsServer  metrics.Server
+	logger         *zap.Logger
+	shutdownConfig configs.Shutdown
 }
 
 func NewStandaloneServer(
-	grpcServer grpc.Server,
-	httpServer http.Server,
-	rootConsumer consumers.Root,
-	executeAllPendingDownloadTaskJob jobs.ExecuteAllPendingDownloadTask,
-	updateDownloadingAndFailedDownloadTaskStatusToPendingJob jobs.UpdateDownloadingAndFailedDownloadTaskStatusToPending,
+	apiServer *APIServer,
+	worker *Worker,
+	scheduler *Scheduler,
+	metricsServer metrics.Server,
 	logger *zap.Logger,
-	cronConfig configs.Cron,
+	shutdownConfig configs.Shutdown,
 ) *StandaloneServer {
 	return &StandaloneServer{
-		grpcServer:                       grpcServer,
-		httpServer:                       httpServer,
-		rootConsumer:                     rootConsumer,
-		executeAllPendingDownloadTaskJob: executeAllPendingDownloadTaskJob,
-		updateDownloadingAndFailedDownloadTaskStatusToPendingJob: updateDownloadingAndFailedDownloadTaskStatusToPendingJob,
-		logger:     logger,
-		cronConfig: cronConfig,
-	}
-}
-
-func (s StandaloneServer) scheduleCronJobs(scheduler gocron.Scheduler) error {
-	_, err := scheduler.NewJob(
-		gocron.CronJob(s.cronConfig.ExecuteAllPendingDownloadTask.Schedule, true),
-		gocron.NewTask(func() {
-			err := s.executeAllPendingDownloadTaskJob.Run(context.Background())
-			if err != nil {
-				s.logger.With(zap.Error(err)).Error("failed to run execute all pending download task job")
-			}
-		}),
-	)
-	if err != nil {
-		s.logger.With(zap.Error(err)).Error("failed to schedule execute all pending download task job")
-		return err
+		apiServer:      apiServer,
+		worker:         worker,
+		scheduler:      scheduler,
+		metricsServer:  metricsServer,
+		logger:         logger,
+		shutdownConfig: shutdownConfig,
 	}
-	return nil
 }
 
 func (s StandaloneServer) Start() error {
-	go func() {
-		err := s.grpcServer.Start(context.Background())
-		if err != nil {
-			s.logger.With(zap.Error(err)).Info("failed to start gRPC server")
-		}
-	}()
-
-	go func() {
-		err := s.httpServer.Start(context.Background())
-		if err != nil {
-			s.logger.With(zap.Error(err)).Info("failed to start HTTP server")
-		}
-	}()
-
-	go func() {
-		err := s.rootConsumer.Start(context.Background())
-		s.logger.With(zap.Error(err)).Info("message queue consumer stopped")
-	}()
-
-	utils.BlockUntilSignal(syscall.SIGINT, syscall.SIGTERM)
-
-	return nil
+	return run(s.shutdownConfig, s.logger, startMetricsServer(s.metricsServer, s.logger), s.apiServer.start, s.worker.start, s.scheduler.start)
 }
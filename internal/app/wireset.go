@@ -3,5 +3,8 @@ package app
 import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
+	NewAPIServer,
+	NewWorker,
+	NewScheduler,
 	NewStandaloneServer,
 )
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/handler/consumers"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
+	"go.uber.org/zap"
+)
+
+// Worker consumes the message queue, and executes the download tasks it is notified of.
+type Worker struct {
+	rootConsumer   consumers.Root
+	metricsServer  metrics.Server
+	logger         *zap.Logger
+	shutdownConfig configs.Shutdown
+}
+
+func NewWorker(
+	rootConsumer consumers.Root,
+	metricsServer metrics.Server,
+	logger *zap.Logger,
+	shutdownConfig configs.Shutdown,
+) *Worker {
+	return &Worker{
+		rootConsumer:   rootConsumer,
+		metricsServer:  metricsServer,
+		logger:         logger,
+		shutdownConfig: shutdownConfig,
+	}
+}
+
+func (w Worker) start(ctx context.Context, waitGroup *sync.WaitGroup) error {
+	waitGroup.Add(1)
+
+	go func() {
+		defer waitGroup.Done()
+		err := w.rootConsumer.Start(ctx)
+		w.logger.With(zap.Error(err)).Info("message queue consumer stopped")
+	}()
+
+	return nil
+}
+
+func (w Worker) Start() error {
+	return run(w.shutdownConfig, w.logger, startMetricsServer(w.metricsServer, w.logger), w.start)
+}
@@ -1,19 +1,144 @@
 package configs
 
-import "time"
+import (
+	"time"
 
+	"github.com/dustin/go-humanize"
+)
+
+type HashAlgorithm string
+
+const (
+	HashAlgorithmBcrypt   HashAlgorithm = "bcrypt"
+	HashAlgorithmArgon2id HashAlgorithm = "argon2id"
+)
+
+type Argon2id struct {
+	Memory      string `yaml:"memory"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+// Hash configures how new passwords are hashed. Passwords hashed with another algorithm or with
+// other parameters keep working, and are hashed again the next time they are used to log in.
 type Hash struct {
-	Cost int `yaml:"cost"`
+	// Algorithm defaults to bcrypt.
+	Algorithm HashAlgorithm `yaml:"algorithm"`
+	// Cost is the bcrypt cost.
+	Cost     int      `yaml:"cost"`
+	Argon2id Argon2id `yaml:"argon2id"`
+}
+
+// TokenSigningKey configures the keys tokens are signed with. A new key is published Overlap
+// before it starts signing, so that verifiers refreshing their key set pick it up in time, and
+// old keys are retired once no token signed with them can still be valid.
+type TokenSigningKey struct {
+	RotationInterval string `yaml:"rotation_interval"`
+	Overlap          string `yaml:"overlap"`
+	// EncryptionKey is the base64 encoded 32 byte key private keys are encrypted with in the
+	// database. It should be read from EncryptionKeyFile instead, such as a mounted secret, or
+	// from the MORGANA_TOKEN_SIGNING_KEY_ENCRYPTION_KEY environment variable when both are empty,
+	// rather than written in the config file.
+	EncryptionKey     string `yaml:"encryption_key"`
+	EncryptionKeyFile string `yaml:"encryption_key_file"`
 }
 
 type Token struct {
 	ExpiresIn                   string `yaml:"expires_in"`
 	RegenerateTokenBeforeExpiry string `yaml:"regenerate_token_before_expiry"`
+	// RefreshTokenExpiresIn is how long a session stays alive without being refreshed. Every
+	// refresh issues a new refresh token and extends the session by the same duration.
+	RefreshTokenExpiresIn string          `yaml:"refresh_token_expires_in"`
+	SigningKey            TokenSigningKey `yaml:"signing_key"`
+}
+
+// OIDC configures single sign-on with an OpenID Connect provider. Accounts are created the first
+// time someone logs in with the provider, and are matched by the issuer and subject of their ID
+// token afterwards.
+type OIDC struct {
+	Enabled      bool     `yaml:"enabled"`
+	IssuerURL    string   `yaml:"issuer_url"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectURL  string   `yaml:"redirect_url"`
+	Scopes       []string `yaml:"scopes"`
+	// PostLoginRedirectURL is where the browser is sent once the session cookies are set. Logins
+	// to accounts with two-factor authentication are sent there without cookies instead, with the
+	// two_factor_challenge_token and two_factor_challenge_expire_time parameters in the fragment,
+	// and the page completes them with CreateSession.
+	PostLoginRedirectURL string `yaml:"post_login_redirect_url"`
+	// LinkAccountsByVerifiedEmail links the first login of a provider identity to the existing
+	// account with the same email, when the provider says that the email is verified and the
+	// account has verified it too, by completing a password reset. Only enable it for providers
+	// that are trusted to verify emails.
+	LinkAccountsByVerifiedEmail bool `yaml:"link_accounts_by_verified_email"`
+}
+
+// PasswordPolicy is checked whenever a password is set. Lengths are counted in characters, and
+// zero values are not enforced.
+type PasswordPolicy struct {
+	MinLength        int  `yaml:"min_length"`
+	MaxLength        int  `yaml:"max_length"`
+	RequireLowercase bool `yaml:"require_lowercase"`
+	RequireUppercase bool `yaml:"require_uppercase"`
+	RequireDigit     bool `yaml:"require_digit"`
+	RequireSymbol    bool `yaml:"require_symbol"`
+	// BreachedPasswordListFile lists passwords known to have been leaked, one per line, which are
+	// rejected regardless of the other rules. The comparison is case insensitive.
+	BreachedPasswordListFile string `yaml:"breached_password_list_file"`
+}
+
+type PasswordReset struct {
+	TokenExpiresIn string `yaml:"token_expires_in"`
+	// URL is the page reset links point to, the reset token is added to it as the token query
+	// parameter.
+	URL string `yaml:"url"`
+}
+
+// LoginThrottle slows down and then locks out password guessing. Failed logins are counted per
+// account name and per IP address within FailedAttemptWindow. Past FreeAttemptCount failures,
+// every failure blocks further logins for a delay that starts at BaseDelay and doubles up to
+// MaxDelay, and reaching a lockout threshold blocks logins for LockoutDuration. Zero thresholds
+// are not enforced.
+type LoginThrottle struct {
+	Enabled                   bool   `yaml:"enabled"`
+	FailedAttemptWindow       string `yaml:"failed_attempt_window"`
+	FreeAttemptCount          uint64 `yaml:"free_attempt_count"`
+	BaseDelay                 string `yaml:"base_delay"`
+	MaxDelay                  string `yaml:"max_delay"`
+	AccountLockoutThreshold   uint64 `yaml:"account_lockout_threshold"`
+	IPAddressLockoutThreshold uint64 `yaml:"ip_address_lockout_threshold"`
+	LockoutDuration           string `yaml:"lockout_duration"`
+}
+
+// TwoFactor configures TOTP two-factor authentication, which accounts can opt into for their
+// password and single sign-on logins. Secrets are encrypted in the database with EncryptionKey, a
+// base64 encoded 32 byte key. It should be read from EncryptionKeyFile instead, or from the
+// MORGANA_TWO_FACTOR_ENCRYPTION_KEY environment variable when both are empty, rather than written
+// in the config file.
+type TwoFactor struct {
+	// Issuer is the name authenticator apps show next to the account name.
+	Issuer            string `yaml:"issuer"`
+	EncryptionKey     string `yaml:"encryption_key"`
+	EncryptionKeyFile string `yaml:"encryption_key_file"`
+	// ChallengeExpiresIn is how long a login has to be completed with a code.
+	ChallengeExpiresIn string `yaml:"challenge_expires_in"`
+	RecoveryCodeCount  int    `yaml:"recovery_code_count"`
 }
 
 type Auth struct {
-	Hash  Hash
-	Token Token
+	Hash           Hash
+	Token          Token
+	OIDC           OIDC           `yaml:"oidc"`
+	PasswordPolicy PasswordPolicy `yaml:"password_policy"`
+	PasswordReset  PasswordReset  `yaml:"password_reset"`
+	LoginThrottle  LoginThrottle  `yaml:"login_throttle"`
+	TwoFactor      TwoFactor      `yaml:"two_factor"`
+	// AdminAccountNames are always given the admin role, whatever role is stored for them, so
+	// that the first admin can be set up without editing the database.
+	AdminAccountNames []string `yaml:"admin_account_names"`
 }
 
 func (t Token) GetExpiresInDuration() (time.Duration, error) {
@@ -23,3 +148,49 @@ func (t Token) GetExpiresInDuration() (time.Duration, error) {
 func (t Token) GetRegenerateTokenBeforeExpiryDuration() (time.Duration, error) {
 	return time.ParseDuration(t.RegenerateTokenBeforeExpiry)
 }
+
+func (t Token) GetRefreshTokenExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(t.RefreshTokenExpiresIn)
+}
+
+func (p PasswordReset) GetTokenExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(p.TokenExpiresIn)
+}
+
+func (t TokenSigningKey) GetRotationIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(t.RotationInterval)
+}
+
+func (t TokenSigningKey) GetOverlapDuration() (time.Duration, error) {
+	return time.ParseDuration(t.Overlap)
+}
+
+func (l LoginThrottle) GetFailedAttemptWindowDuration() (time.Duration, error) {
+	return time.ParseDuration(l.FailedAttemptWindow)
+}
+
+func (l LoginThrottle) GetBaseDelayDuration() (time.Duration, error) {
+	return time.ParseDuration(l.BaseDelay)
+}
+
+func (l LoginThrottle) GetMaxDelayDuration() (time.Duration, error) {
+	return time.ParseDuration(l.MaxDelay)
+}
+
+func (l LoginThrottle) GetLockoutDurationDuration() (time.Duration, error) {
+	return time.ParseDuration(l.LockoutDuration)
+}
+
+func (t TwoFactor) GetChallengeExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(t.ChallengeExpiresIn)
+}
+
+// GetMemoryInKibibytes returns the memory in the unit argon2 expects it.
+func (a Argon2id) GetMemoryInKibibytes() (uint32, error) {
+	memoryInBytes, err := humanize.ParseBytes(a.Memory)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(memoryInBytes / humanize.KiByte), nil
+}
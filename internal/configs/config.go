@@ -20,6 +20,11 @@ type Config struct {
 	MQ       MQ       `yaml:"mq"`
 	Download Download `yaml:"download"`
 	Cron     Cron     `yaml:"cron"`
+	Quota    Quota    `yaml:"quota"`
+	Notifier Notifier `yaml:"notifier"`
+	Shutdown Shutdown `yaml:"shutdown"`
+	Metrics  Metrics  `yaml:"metrics"`
+	Tracing  Tracing  `yaml:"tracing"`
 }
 
 func NewConfig(filePath ConfigFilePath) (Config, error) {
@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type ExecuteAllPendingDownloadTask struct {
 	Schedule         string `yaml:"schedule"`
 	ConcurrencyLimit int    `yaml:"concurrency_limit"`
@@ -9,7 +11,35 @@ type UpdateDownloadingAndFailedDownloadTaskStatusToPending struct {
 	Schedule string `yaml:"schedule"`
 }
 
+type RewrapAllDownloadTaskDataKey struct {
+	Schedule string `yaml:"schedule"`
+}
+
+type RotateTokenSigningKey struct {
+	Schedule string `yaml:"schedule"`
+}
+
+// LeaderElection makes only one of the replicas running the scheduler run the cron jobs. The
+// leader holds a lease that it renews every renew interval. When it stops renewing it, another
+// replica takes over once the lease TTL has passed.
+type LeaderElection struct {
+	Enabled       bool   `yaml:"enabled"`
+	LeaseTTL      string `yaml:"lease_ttl"`
+	RenewInterval string `yaml:"renew_interval"`
+}
+
+func (l LeaderElection) GetLeaseTTLDuration() (time.Duration, error) {
+	return time.ParseDuration(l.LeaseTTL)
+}
+
+func (l LeaderElection) GetRenewIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(l.RenewInterval)
+}
+
 type Cron struct {
+	LeaderElection                                        LeaderElection                                        `yaml:"leader_election"`
 	ExecuteAllPendingDownloadTask                         ExecuteAllPendingDownloadTask                         `yaml:"execute_all_pending_download_task"`
 	UpdateDownloadingAndFailedDownloadTaskStatusToPending UpdateDownloadingAndFailedDownloadTaskStatusToPending `yaml:"update_downloading_and_failed_download_task_status_to_pending"`
+	RewrapAllDownloadTaskDataKey                          RewrapAllDownloadTaskDataKey                          `yaml:"rewrap_all_download_task_data_key"`
+	RotateTokenSigningKey                                 RotateTokenSigningKey                                 `yaml:"rotate_token_signing_key"`
 }
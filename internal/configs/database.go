@@ -3,14 +3,21 @@ package configs
 type DatabaseType string
 
 const (
-	DatabaseTypeMySQL DatabaseType = "mysql"
+	DatabaseTypeMySQL      DatabaseType = "mysql"
+	DatabaseTypePostgreSQL DatabaseType = "postgres"
+	DatabaseTypeSQLite     DatabaseType = "sqlite"
 )
 
 type Database struct {
+	// Type defaults to mysql. For sqlite, Database is the path of the database file, and the
+	// connection fields are not used.
 	Type     DatabaseType `yaml:"type"`
 	Host     string       `yaml:"host"`
 	Port     int          `yaml:"port"`
 	Username string       `yaml:"username"`
 	Password string       `yaml:"password"`
 	Database string       `yaml:"database"`
+	// SSLMode is the sslmode of PostgreSQL connections, such as disable or verify-full. It
+	// defaults to prefer, like libpq.
+	SSLMode string `yaml:"ssl_mode"`
 }
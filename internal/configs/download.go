@@ -3,15 +3,58 @@ package configs
 type DownloadMode string
 
 const (
-	DownloadModeLocal DownloadMode = "local"
-	DownloadModeS3    DownloadMode = "s3"
+	DownloadModeLocal     DownloadMode = "local"
+	DownloadModeS3        DownloadMode = "s3"
+	DownloadModeAzureBlob DownloadMode = "azure-blob"
+	DownloadModeGCS       DownloadMode = "gcs"
 )
 
+type DownloadStorageLayout string
+
+const (
+	DownloadStorageLayoutPerTask          DownloadStorageLayout = "per-task"
+	DownloadStorageLayoutContentAddressed DownloadStorageLayout = "content-addressed"
+)
+
+type DownloadCompressionCodec string
+
+const (
+	DownloadCompressionCodecNone DownloadCompressionCodec = "none"
+	DownloadCompressionCodecGzip DownloadCompressionCodec = "gzip"
+	DownloadCompressionCodecZstd DownloadCompressionCodec = "zstd"
+)
+
+type DownloadCompression struct {
+	Codec            DownloadCompressionCodec `yaml:"codec"`
+	Level            int                      `yaml:"level"`
+	SkippedMIMETypes []string                 `yaml:"skipped_mime_types"`
+}
+
+type DownloadEncryptionMasterKey struct {
+	ID string `yaml:"id"`
+	// Key is the base64 encoded 32 byte master key. KeyFile can be used instead to read the same
+	// value from a file.
+	Key     string `yaml:"key"`
+	KeyFile string `yaml:"key_file"`
+}
+
+// DownloadEncryption configures envelope encryption of stored files. Keys that are no longer
+// active must be kept in MasterKeys until all data keys wrapped with them have been rewrapped.
+type DownloadEncryption struct {
+	Enabled           bool                          `yaml:"enabled"`
+	ActiveMasterKeyID string                        `yaml:"active_master_key_id"`
+	MasterKeys        []DownloadEncryptionMasterKey `yaml:"master_keys"`
+}
+
 type Download struct {
-	Mode              DownloadMode `yaml:"mode"`
-	DownloadDirectory string       `yaml:"download_directory"`
-	Bucket            string       `yaml:"bucket"`
-	Address           string       `yaml:"address"`
-	Username          string       `yaml:"username"`
-	Password          string       `yaml:"password"`
+	Mode              DownloadMode          `yaml:"mode"`
+	StorageLayout     DownloadStorageLayout `yaml:"storage_layout"`
+	DownloadDirectory string                `yaml:"download_directory"`
+	Bucket            string                `yaml:"bucket"`
+	Address           string                `yaml:"address"`
+	Username          string                `yaml:"username"`
+	Password          string                `yaml:"password"`
+	CredentialsFile   string                `yaml:"credentials_file"`
+	Compression       DownloadCompression   `yaml:"compression"`
+	Encryption        DownloadEncryption    `yaml:"encryption"`
 }
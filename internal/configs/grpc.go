@@ -1,6 +1,9 @@
 package configs
 
 import (
+	"fmt"
+	"net/netip"
+
 	"github.com/dustin/go-humanize"
 )
 
@@ -15,4 +18,27 @@ func (g GetDownloadTaskFile) GetResponseBufferSizeInBytes() (uint64, error) {
 type GRPC struct {
 	Address             string              `yaml:"address"`
 	GetDownloadTaskFile GetDownloadTaskFile `yaml:"get_download_task_file"`
+	// TrustedProxyList lists the addresses or CIDR ranges of the load balancers and ingresses in
+	// front of the server, whose X-Forwarded-For entries are skipped to find the address of the
+	// client. Loopback addresses, where the HTTP gateway connects from, are always trusted.
+	TrustedProxyList []string `yaml:"trusted_proxy_list"`
+}
+
+func (g GRPC) GetTrustedProxyPrefixList() ([]netip.Prefix, error) {
+	trustedProxyPrefixList := make([]netip.Prefix, 0, len(g.TrustedProxyList))
+	for _, trustedProxy := range g.TrustedProxyList {
+		if prefix, err := netip.ParsePrefix(trustedProxy); err == nil {
+			trustedProxyPrefixList = append(trustedProxyPrefixList, prefix.Masked())
+			continue
+		}
+
+		address, err := netip.ParseAddr(trustedProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", trustedProxy, err)
+		}
+
+		trustedProxyPrefixList = append(trustedProxyPrefixList, netip.PrefixFrom(address, address.BitLen()))
+	}
+
+	return trustedProxyPrefixList, nil
 }
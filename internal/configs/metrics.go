@@ -0,0 +1,6 @@
+package configs
+
+type Metrics struct {
+	Enabled bool   `yaml:"enabled"`
+	Address string `yaml:"address"`
+}
@@ -1,6 +1,112 @@
 package configs
 
+import "time"
+
+type MQType string
+
+const (
+	MQTypeKafka    MQType = "kafka"
+	MQTypeInMemory MQType = "in-memory"
+	MQTypeRedis    MQType = "redis"
+	MQTypeNATS     MQType = "nats"
+)
+
+type MQSASLMechanism string
+
+const (
+	MQSASLMechanismPlain       MQSASLMechanism = "PLAIN"
+	MQSASLMechanismSCRAMSHA256 MQSASLMechanism = "SCRAM-SHA-256"
+	MQSASLMechanismSCRAMSHA512 MQSASLMechanism = "SCRAM-SHA-512"
+)
+
+type MQCompression string
+
+const (
+	MQCompressionNone   MQCompression = "none"
+	MQCompressionGZIP   MQCompression = "gzip"
+	MQCompressionSnappy MQCompression = "snappy"
+	MQCompressionLZ4    MQCompression = "lz4"
+	MQCompressionZSTD   MQCompression = "zstd"
+)
+
+const (
+	defaultMQHandlerRetryBaseDelay = time.Second
+	defaultMQHandlerRetryMaxDelay  = 30 * time.Second
+)
+
+// MQHandlerRetry configures how a Kafka consumer retries a message whose handler failed before
+// moving it to the dead letter queue of its queue. Empty delays default to 1s and 30s, so that
+// configs written before retries existed keep working.
+type MQHandlerRetry struct {
+	MaxAttemptCount int    `yaml:"max_attempt_count"`
+	BaseDelay       string `yaml:"base_delay"`
+	MaxDelay        string `yaml:"max_delay"`
+}
+
+// MQTLS configures TLS connections to Kafka brokers. The client certificate is only presented
+// when both its files are set.
+type MQTLS struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type MQProducer struct {
+	// Compression defaults to none.
+	Compression MQCompression `yaml:"compression"`
+	// Idempotent makes the brokers discard messages the producer sent again after a retry.
+	Idempotent bool `yaml:"idempotent"`
+}
+
+// MQTopic configures the Kafka topics of queues, and of their dead letter queues, that are
+// created when they do not exist yet.
+type MQTopic struct {
+	AutoCreate        bool  `yaml:"auto_create"`
+	PartitionCount    int32 `yaml:"partition_count"`
+	ReplicationFactor int16 `yaml:"replication_factor"`
+}
+
 type MQ struct {
+	// Type defaults to kafka. The in-memory queue only delivers messages within the process that
+	// produced them, and is therefore only suitable for standalone deployments and tests.
+	Type      MQType   `yaml:"type"`
 	Addresses []string `yaml:"addresses"`
 	ClientID  string   `yaml:"client_id"`
+	// GroupID names the Kafka consumer group, the Redis Streams consumer group or the durable
+	// JetStream consumer. It defaults to the client ID.
+	GroupID  string `yaml:"group_id"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// SASLMechanism authenticates to Kafka with the username and password when set.
+	SASLMechanism MQSASLMechanism `yaml:"sasl_mechanism"`
+	TLS           MQTLS           `yaml:"tls"`
+	Producer      MQProducer      `yaml:"producer"`
+	Topic         MQTopic         `yaml:"topic"`
+	HandlerRetry  MQHandlerRetry  `yaml:"handler_retry"`
+}
+
+func (m MQ) GetGroupID() string {
+	if m.GroupID != "" {
+		return m.GroupID
+	}
+
+	return m.ClientID
+}
+
+func (m MQHandlerRetry) GetBaseDelayDuration() (time.Duration, error) {
+	if m.BaseDelay == "" {
+		return defaultMQHandlerRetryBaseDelay, nil
+	}
+
+	return time.ParseDuration(m.BaseDelay)
+}
+
+func (m MQHandlerRetry) GetMaxDelayDuration() (time.Duration, error) {
+	if m.MaxDelay == "" {
+		return defaultMQHandlerRetryMaxDelay, nil
+	}
+
+	return time.ParseDuration(m.MaxDelay)
 }
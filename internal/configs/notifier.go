@@ -0,0 +1,23 @@
+package configs
+
+type NotifierType string
+
+const (
+	// NotifierTypeLog only logs notifications, which is enough for local development.
+	NotifierTypeLog  NotifierType = "log"
+	NotifierTypeSMTP NotifierType = "smtp"
+)
+
+// SMTP configures the server emails are sent through. Authentication is skipped when Username is
+// empty, and STARTTLS is used whenever the server supports it.
+type SMTP struct {
+	Address  string `yaml:"address"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+}
+
+type Notifier struct {
+	Type NotifierType `yaml:"type"`
+	SMTP SMTP         `yaml:"smtp"`
+}
@@ -0,0 +1,27 @@
+package configs
+
+import (
+	"github.com/dustin/go-humanize"
+)
+
+// OwnerQuota limits the download tasks a single owner can have. A limit is not enforced when it
+// is left empty.
+type OwnerQuota struct {
+	MaxDownloadTaskCount uint64 `yaml:"max_download_task_count"`
+	MaxTotalFileSize     string `yaml:"max_total_file_size"`
+}
+
+func (o OwnerQuota) GetMaxTotalFileSizeInBytes() (uint64, error) {
+	if o.MaxTotalFileSize == "" {
+		return 0, nil
+	}
+
+	return humanize.ParseBytes(o.MaxTotalFileSize)
+}
+
+// Quota configures the limits of accounts and organizations. The download tasks of an
+// organization count towards its own quota, not towards the quotas of its members.
+type Quota struct {
+	Account      OwnerQuota `yaml:"account"`
+	Organization OwnerQuota `yaml:"organization"`
+}
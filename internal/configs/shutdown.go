@@ -0,0 +1,21 @@
+package configs
+
+import "time"
+
+const (
+	defaultShutdownTimeout = 30 * time.Second
+)
+
+type Shutdown struct {
+	// Timeout is how long components are given to finish their in-flight work after a shutdown
+	// signal is received, before they are stopped forcefully. Defaults to 30s.
+	Timeout string `yaml:"timeout"`
+}
+
+func (s Shutdown) GetTimeoutDuration() (time.Duration, error) {
+	if s.Timeout == "" {
+		return defaultShutdownTimeout, nil
+	}
+
+	return time.ParseDuration(s.Timeout)
+}
@@ -0,0 +1,32 @@
+package configs
+
+type TracingExporter string
+
+const (
+	TracingExporterOTLPGRPC TracingExporter = "otlp-grpc"
+	TracingExporterOTLPHTTP TracingExporter = "otlp-http"
+)
+
+type Tracing struct {
+	// Spans are only recorded and exported when Enabled is set. Log lines still carry the request
+	// and account IDs when it is not.
+	Enabled bool `yaml:"enabled"`
+	// Exporter defaults to otlp-grpc.
+	Exporter TracingExporter `yaml:"exporter"`
+	// Endpoint is the host and port of the OTLP collector, such as 127.0.0.1:4317.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure disables TLS for the connection to the collector.
+	Insecure    bool   `yaml:"insecure"`
+	ServiceName string `yaml:"service_name"`
+	// SampleRatio is the fraction of traces started by this service that are sampled, from 0 to
+	// 1. Traces started by a caller that sampled them are always sampled.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+func (t Tracing) GetServiceName() string {
+	if t.ServiceName == "" {
+		return "morgana"
+	}
+
+	return t.ServiceName
+}
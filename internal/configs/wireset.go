@@ -13,4 +13,9 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Download"),
 	wire.FieldsOf(new(Config), "Cron"),
+	wire.FieldsOf(new(Config), "Quota"),
+	wire.FieldsOf(new(Config), "Notifier"),
+	wire.FieldsOf(new(Config), "Shutdown"),
+	wire.FieldsOf(new(Config), "Metrics"),
+	wire.FieldsOf(new(Config), "Tracing"),
 )
@@ -9,9 +9,12 @@ import (
 
 	"slices"
 
+	"github.com/go-redis/redis/extra/redisotel/v8"
 	"github.com/go-redis/redis/v8"
 	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/metrics"
 	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,9 +24,19 @@ var (
 	ErrCacheMiss = errors.New("cache miss")
 )
 
+// inMemoryExpiredEntrySweepInterval is how often the in-memory client removes the expired entries
+// that were not read since they expired, so that entries such as abandoned two-factor challenges
+// do not pile up.
+const inMemoryExpiredEntrySweepInterval = time.Minute
+
 type Client interface {
 	Set(ctx context.Context, key string, data any, ttl time.Duration) error
 	Get(ctx context.Context, key string) (any, error)
+	Delete(ctx context.Context, key string) error
+	// Increment adds one to the counter stored at key and returns the new value. The ttl is only
+	// applied when the counter is created, so that the counter expires ttl after its first
+	// increment.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 	AddToSet(ctx context.Context, key string, data ...any) error
 	IsDataInSet(ctx context.Context, key string, data any) (bool, error)
 }
@@ -33,35 +46,58 @@ type redisClient struct {
 	logger      *zap.Logger
 }
 
+// inMemoryEntry is a value of the in-memory client. Entries with a zero expiry time never expire,
+// like Redis keys set without a ttl.
+type inMemoryEntry struct {
+	data       any
+	expireTime time.Time
+}
+
+func (e inMemoryEntry) isExpired(now time.Time) bool {
+	return !e.expireTime.IsZero() && !now.Before(e.expireTime)
+}
+
 type inMemoryClient struct {
-	cache      map[string]any
-	cacheMutex *sync.Mutex
-	logger     *zap.Logger
+	cache         map[string]inMemoryEntry
+	cacheMutex    *sync.Mutex
+	nextSweepTime time.Time
+	logger        *zap.Logger
 }
 
 func NewClient(
 	cacheConfig configs.Cache,
+	tracerProvider trace.TracerProvider,
 	logger *zap.Logger,
-) (Client, error) {
+) (Client, func(), error) {
 	switch cacheConfig.Type {
 	case configs.CacheTypeInMemory:
-		return NewInMemoryClient(cacheConfig, logger), nil
+		return NewInMemoryClient(cacheConfig, logger), func() {}, nil
 	case configs.CacheTypeRedis:
-		return NewRedisClient(cacheConfig, logger), nil
+		client, cleanup := NewRedisClient(cacheConfig, tracerProvider, logger)
+		return client, cleanup, nil
 	default:
-		return nil, fmt.Errorf("unsupported cache type: %s", cacheConfig.Type)
+		return nil, nil, fmt.Errorf("unsupported cache type: %s", cacheConfig.Type)
 	}
 }
 
-func NewRedisClient(cacheConfig configs.Cache, logger *zap.Logger) Client {
-	return &redisClient{
-		redisClient: redis.NewClient(&redis.Options{
-			Addr:     cacheConfig.Address,
-			Username: cacheConfig.Username,
-			Password: cacheConfig.Password,
-		}),
-		logger: logger,
+func NewRedisClient(cacheConfig configs.Cache, tracerProvider trace.TracerProvider, logger *zap.Logger) (Client, func()) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cacheConfig.Address,
+		Username: cacheConfig.Username,
+		Password: cacheConfig.Password,
+	})
+	client.AddHook(redisotel.NewTracingHook(redisotel.WithTracerProvider(tracerProvider)))
+
+	cleanup := func() {
+		if closeErr := client.Close(); closeErr != nil {
+			logger.With(zap.Error(closeErr)).Error("failed to close redis client")
+		}
 	}
+
+	return &redisClient{
+		redisClient: client,
+		logger:      logger,
+	}, cleanup
 }
 
 func (c redisClient) AddToSet(ctx context.Context, key string, data ...any) error {
@@ -82,15 +118,45 @@ func (c redisClient) Get(ctx context.Context, key string) (any, error) {
 	data, err := c.redisClient.Get(ctx, key).Result()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
+			metrics.CacheLookupsTotal.WithLabelValues(string(configs.CacheTypeRedis), metrics.CacheResultMiss).Inc()
 			return nil, ErrCacheMiss
 		}
+		metrics.CacheLookupsTotal.WithLabelValues(string(configs.CacheTypeRedis), metrics.CacheResultError).Inc()
 		logger.With(zap.Error(err)).Error("failed to get data from cache")
 		return nil, status.Error(codes.Internal, "failed to get data from cache")
 	}
 
+	metrics.CacheLookupsTotal.WithLabelValues(string(configs.CacheTypeRedis), metrics.CacheResultHit).Inc()
 	return data, nil
 }
 
+func (c redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if err := c.redisClient.Del(ctx, key).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete data from cache")
+		return status.Error(codes.Internal, "failed to delete data from cache")
+	}
+
+	return nil
+}
+
+func (c redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("key", key)).
+		With(zap.Duration("ttl", ttl))
+
+	pipeline := c.redisClient.TxPipeline()
+	incrCmd := pipeline.Incr(ctx, key)
+	pipeline.ExpireNX(ctx, key, ttl)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment counter in cache")
+		return 0, status.Error(codes.Internal, "failed to increment counter in cache")
+	}
+
+	return incrCmd.Val(), nil
+}
+
 func (c redisClient) IsDataInSet(ctx context.Context, key string, data any) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger).
 		With(zap.String("key", key)).
@@ -122,33 +188,107 @@ func (c redisClient) Set(ctx context.Context, key string, data any, ttl time.Dur
 
 func NewInMemoryClient(cacheConfig configs.Cache, logger *zap.Logger) Client {
 	return &inMemoryClient{
-		cache:      make(map[string]any),
-		cacheMutex: new(sync.Mutex),
-		logger:     logger,
+		cache:         make(map[string]inMemoryEntry),
+		cacheMutex:    new(sync.Mutex),
+		nextSweepTime: time.Now().Add(inMemoryExpiredEntrySweepInterval),
+		logger:        logger,
+	}
+}
+
+func (c *inMemoryClient) getExpireTime(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(ttl)
+}
+
+// getEntry returns the entry stored at key, removing it if it has expired. The cache mutex must be
+// held.
+func (c *inMemoryClient) getEntry(key string) (inMemoryEntry, bool) {
+	entry, ok := c.cache[key]
+	if !ok {
+		return inMemoryEntry{}, false
 	}
+
+	if entry.isExpired(time.Now()) {
+		delete(c.cache, key)
+		return inMemoryEntry{}, false
+	}
+
+	return entry, true
 }
 
-func (c inMemoryClient) AddToSet(ctx context.Context, key string, data ...any) error {
+// setEntry stores an entry, and removes the expired entries at most once per sweep interval. The
+// cache mutex must be held.
+func (c *inMemoryClient) setEntry(key string, entry inMemoryEntry) {
+	c.cache[key] = entry
+
+	now := time.Now()
+	if now.Before(c.nextSweepTime) {
+		return
+	}
+
+	for existingKey, existingEntry := range c.cache {
+		if existingEntry.isExpired(now) {
+			delete(c.cache, existingKey)
+		}
+	}
+
+	c.nextSweepTime = now.Add(inMemoryExpiredEntrySweepInterval)
+}
+
+func (c *inMemoryClient) AddToSet(ctx context.Context, key string, data ...any) error {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
 
 	set := c.getSet(key)
 	set = append(set, data...)
-	c.cache[key] = set
+	c.setEntry(key, inMemoryEntry{data: set})
 
 	return nil
 }
 
-func (c inMemoryClient) Get(ctx context.Context, key string) (any, error) {
-	data, ok := c.cache[key]
+func (c *inMemoryClient) Get(ctx context.Context, key string) (any, error) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	entry, ok := c.getEntry(key)
 	if !ok {
+		metrics.CacheLookupsTotal.WithLabelValues(string(configs.CacheTypeInMemory), metrics.CacheResultMiss).Inc()
 		return nil, ErrCacheMiss
 	}
 
-	return data, nil
+	metrics.CacheLookupsTotal.WithLabelValues(string(configs.CacheTypeInMemory), metrics.CacheResultHit).Inc()
+	return entry.data, nil
 }
 
-func (c inMemoryClient) IsDataInSet(ctx context.Context, key string, data any) (bool, error) {
+func (c *inMemoryClient) Delete(ctx context.Context, key string) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	delete(c.cache, key)
+	return nil
+}
+
+func (c *inMemoryClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	entry, ok := c.getEntry(key)
+	if !ok {
+		entry = inMemoryEntry{data: int64(0), expireTime: c.getExpireTime(ttl)}
+	}
+
+	counter, _ := entry.data.(int64)
+	counter++
+	entry.data = counter
+	c.setEntry(key, entry)
+
+	return counter, nil
+}
+
+func (c *inMemoryClient) IsDataInSet(ctx context.Context, key string, data any) (bool, error) {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
 
@@ -160,17 +300,21 @@ func (c inMemoryClient) IsDataInSet(ctx context.Context, key string, data any) (
 	return false, nil
 }
 
-func (c inMemoryClient) Set(ctx context.Context, key string, data any, ttl time.Duration) error {
-	c.cache[key] = data
+func (c *inMemoryClient) Set(ctx context.Context, key string, data any, ttl time.Duration) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	c.setEntry(key, inMemoryEntry{data: data, expireTime: c.getExpireTime(ttl)})
 	return nil
 }
 
-func (c inMemoryClient) getSet(key string) []any {
-	setValue, ok := c.cache[key]
+// getSet returns the set stored at key. The cache mutex must be held.
+func (c *inMemoryClient) getSet(key string) []any {
+	entry, ok := c.getEntry(key)
 	if !ok {
 		return make([]any, 0)
 	}
-	set, ok := setValue.([]any)
+	set, ok := entry.data.([]any)
 	if !ok {
 		return make([]any, 0)
 	}
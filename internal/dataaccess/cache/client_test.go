@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+)
+
+const testTTL = 50 * time.Millisecond
+
+func newTestInMemoryClient() *inMemoryClient {
+	return NewInMemoryClient(configs.Cache{Type: configs.CacheTypeInMemory}, zap.NewNop()).(*inMemoryClient)
+}
+
+func TestInMemoryClientSetExpires(t *testing.T) {
+	ctx := context.Background()
+	client := newTestInMemoryClient()
+
+	if err := client.Set(ctx, "expiring", "value", testTTL); err != nil {
+		t.Fatalf("failed to set: %v", err)
+	}
+
+	if err := client.Set(ctx, "persistent", "value", 0); err != nil {
+		t.Fatalf("failed to set: %v", err)
+	}
+
+	if data, err := client.Get(ctx, "expiring"); err != nil || data != "value" {
+		t.Fatalf("expected value before expiry, got %v, %v", data, err)
+	}
+
+	time.Sleep(2 * testTTL)
+
+	if _, err := client.Get(ctx, "expiring"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected cache miss after expiry, got %v", err)
+	}
+
+	if data, err := client.Get(ctx, "persistent"); err != nil || data != "value" {
+		t.Fatalf("expected value without ttl to be kept, got %v, %v", data, err)
+	}
+}
+
+func TestInMemoryClientIncrementWindow(t *testing.T) {
+	ctx := context.Background()
+	client := newTestInMemoryClient()
+
+	for expectedCounter := int64(1); expectedCounter <= 3; expectedCounter++ {
+		counter, err := client.Increment(ctx, "counter", testTTL)
+		if err != nil || counter != expectedCounter {
+			t.Fatalf("expected counter %d, got %d, %v", expectedCounter, counter, err)
+		}
+	}
+
+	time.Sleep(2 * testTTL)
+
+	// The window starts over with the first increment after the counter expired.
+	counter, err := client.Increment(ctx, "counter", testTTL)
+	if err != nil || counter != 1 {
+		t.Fatalf("expected counter to start over after expiry, got %d, %v", counter, err)
+	}
+}
+
+func TestInMemoryClientSweepsExpiredEntries(t *testing.T) {
+	ctx := context.Background()
+	client := newTestInMemoryClient()
+
+	for _, key := range []string{"first", "second"} {
+		if err := client.Set(ctx, key, "value", testTTL); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+	}
+
+	time.Sleep(2 * testTTL)
+
+	client.cacheMutex.Lock()
+	client.nextSweepTime = time.Now()
+	client.cacheMutex.Unlock()
+
+	if err := client.Set(ctx, "third", "value", testTTL); err != nil {
+		t.Fatalf("failed to set: %v", err)
+	}
+
+	client.cacheMutex.Lock()
+	defer client.cacheMutex.Unlock()
+
+	if len(client.cache) != 1 {
+		t.Fatalf("expected expired entries to be swept, %d entries are left", len(client.cache))
+	}
+}
+
+func TestInMemoryClientConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	client := newTestInMemoryClient()
+
+	const goroutineCount = 8
+	const incrementCount = 100
+	waitGroup := sync.WaitGroup{}
+	for range goroutineCount {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			for range incrementCount {
+				client.Increment(ctx, "counter", time.Minute)
+				client.Set(ctx, "key", "value", time.Minute)
+				client.Get(ctx, "key")
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	counter, err := client.Increment(ctx, "counter", time.Minute)
+	if err != nil || counter != goroutineCount*incrementCount+1 {
+		t.Fatalf("expected counter %d, got %d, %v", goroutineCount*incrementCount+1, counter, err)
+	}
+}
@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+// LoginAttempt counts failed logins and blocks further logins for a while. Keys identify what is
+// being throttled, such as an account name or an IP address.
+type LoginAttempt interface {
+	IncrementFailedAttemptCount(ctx context.Context, key string, window time.Duration) (uint64, error)
+	ResetFailedAttemptCount(ctx context.Context, key string) error
+	Block(ctx context.Context, key string, duration time.Duration) error
+	IsBlocked(ctx context.Context, key string) (bool, error)
+	Unblock(ctx context.Context, key string) error
+}
+
+type loginAttempt struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewLoginAttempt(
+	client Client,
+	logger *zap.Logger,
+) LoginAttempt {
+	return &loginAttempt{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l loginAttempt) getFailedAttemptCountCacheKey(key string) string {
+	return fmt.Sprintf("failed_login_attempt_count:%s", key)
+}
+
+func (l loginAttempt) getBlockedCacheKey(key string) string {
+	return fmt.Sprintf("blocked_login:%s", key)
+}
+
+func (l loginAttempt) IncrementFailedAttemptCount(ctx context.Context, key string, window time.Duration) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	count, err := l.client.Increment(ctx, l.getFailedAttemptCountCacheKey(key), window)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment failed login attempt count")
+		return 0, err
+	}
+
+	return uint64(count), nil
+}
+
+func (l loginAttempt) ResetFailedAttemptCount(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	err := l.client.Delete(ctx, l.getFailedAttemptCountCacheKey(key))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to reset failed login attempt count")
+		return err
+	}
+
+	return nil
+}
+
+func (l loginAttempt) Block(ctx context.Context, key string, duration time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	err := l.client.Set(ctx, l.getBlockedCacheKey(key), "1", duration)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to block login")
+		return err
+	}
+
+	return nil
+}
+
+func (l loginAttempt) IsBlocked(ctx context.Context, key string) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	_, err := l.client.Get(ctx, l.getBlockedCacheKey(key))
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return false, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to check if login is blocked")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (l loginAttempt) Unblock(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", key))
+
+	err := l.client.Delete(ctx, l.getBlockedCacheKey(key))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to unblock login")
+		return err
+	}
+
+	return nil
+}
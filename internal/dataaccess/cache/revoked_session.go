@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+// RevokedSession lists the sessions that were logged out or revoked. An entry only needs to live as
+// long as an access token issued for the session can still be valid.
+type RevokedSession interface {
+	Add(ctx context.Context, sessionID uint64, ttl time.Duration) error
+	Has(ctx context.Context, sessionID uint64) (bool, error)
+}
+
+type revokedSession struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewRevokedSession(
+	client Client,
+	logger *zap.Logger,
+) RevokedSession {
+	return &revokedSession{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (r revokedSession) getRevokedSessionCacheKey(sessionID uint64) string {
+	return fmt.Sprintf("revoked_session:%d", sessionID)
+}
+
+func (r revokedSession) Add(ctx context.Context, sessionID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", sessionID))
+
+	err := r.client.Set(ctx, r.getRevokedSessionCacheKey(sessionID), "1", ttl)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to add session to revoked sessions cache")
+		return err
+	}
+
+	return nil
+}
+
+func (r revokedSession) Has(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", sessionID))
+
+	_, err := r.client.Get(ctx, r.getRevokedSessionCacheKey(sessionID))
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return false, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to check if session is in revoked sessions cache")
+		return false, err
+	}
+
+	return true, nil
+}
@@ -11,6 +11,7 @@ import (
 type TokenPublicKey interface {
 	Get(ctx context.Context, id uint64) (string, error)
 	Set(ctx context.Context, id uint64, data string) error
+	Delete(ctx context.Context, id uint64) error
 }
 
 type tokenPublicKey struct {
@@ -67,3 +68,16 @@ func (c tokenPublicKey) Set(ctx context.Context, id uint64, data string) error {
 
 	return nil
 }
+
+func (c tokenPublicKey) Delete(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("id", id))
+
+	cacheKey := c.getTokenPublicKeyCacheKey(id)
+	err := c.client.Delete(ctx, cacheKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete token public key cache")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+// TwoFactorChallenge maps the hash of a challenge token, issued when a password login still has
+// to be completed with a two-factor code, to the account being logged into.
+type TwoFactorChallenge interface {
+	Add(ctx context.Context, challengeTokenHash string, accountID uint64, ttl time.Duration) error
+	Get(ctx context.Context, challengeTokenHash string) (uint64, error)
+	Delete(ctx context.Context, challengeTokenHash string) error
+}
+
+type twoFactorChallenge struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewTwoFactorChallenge(
+	client Client,
+	logger *zap.Logger,
+) TwoFactorChallenge {
+	return &twoFactorChallenge{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (t twoFactorChallenge) getTwoFactorChallengeCacheKey(challengeTokenHash string) string {
+	return fmt.Sprintf("two_factor_challenge:%s", challengeTokenHash)
+}
+
+func (t twoFactorChallenge) Add(ctx context.Context, challengeTokenHash string, accountID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID))
+
+	err := t.client.Set(ctx, t.getTwoFactorChallengeCacheKey(challengeTokenHash), strconv.FormatUint(accountID, 10), ttl)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to add two-factor challenge to cache")
+		return err
+	}
+
+	return nil
+}
+
+// Get returns ErrCacheMiss when the challenge does not exist or has expired.
+func (t twoFactorChallenge) Get(ctx context.Context, challengeTokenHash string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	cacheEntry, err := t.client.Get(ctx, t.getTwoFactorChallengeCacheKey(challengeTokenHash))
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			logger.With(zap.Error(err)).Error("failed to get two-factor challenge from cache")
+		}
+		return 0, err
+	}
+
+	cacheEntryString, ok := cacheEntry.(string)
+	if !ok {
+		logger.Error("cache entry is not of type string")
+		return 0, ErrCacheMiss
+	}
+
+	accountID, err := strconv.ParseUint(cacheEntryString, 10, 64)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("cache entry is not an account id")
+		return 0, ErrCacheMiss
+	}
+
+	return accountID, nil
+}
+
+func (t twoFactorChallenge) Delete(ctx context.Context, challengeTokenHash string) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	err := t.client.Delete(ctx, t.getTwoFactorChallengeCacheKey(challengeTokenHash))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete two-factor challenge from cache")
+		return err
+	}
+
+	return nil
+}
@@ -6,4 +6,7 @@ var WireSet = wire.NewSet(
 	NewRedisClient,
 	NewTokenPublicKey,
 	NewTakenAccountName,
+	NewRevokedSession,
+	NewLoginAttempt,
+	NewTwoFactorChallenge,
 )
@@ -2,8 +2,10 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/doug-martin/goqu/v9"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
 	"github.com/hoangdv99/morgana/internal/utils"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -13,23 +15,43 @@ import (
 var (
 	TabNameAccounts = goqu.T("accounts")
 
-	ErrAccountNotFound = status.Error(codes.NotFound, "account not found")
+	ErrAccountNotFound  = status.Error(codes.NotFound, "account not found")
+	ErrAccountNameTaken = status.Error(codes.AlreadyExists, "account name is already taken")
 )
 
 const (
-	ColNameAccountsID          = "id"
-	ColNameAccountsAccountName = "account_name"
+	ColNameAccountsID              = "id"
+	ColNameAccountsAccountName     = "account_name"
+	ColNameAccountsRole            = "role"
+	ColNameAccountsDisabledAt      = "disabled_at"
+	ColNameAccountsEmail           = "email"
+	ColNameAccountsEmailVerifiedAt = "email_verified_at"
 )
 
 type Account struct {
-	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
-	AccountName string `db:"account_name"`
+	ID          uint64              `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountName string              `db:"account_name"`
+	Role        morgana.AccountRole `db:"role"`
+	DisabledAt  sql.NullTime        `db:"disabled_at"`
+	// Email is where notifications such as password reset links are sent. Accounts without one
+	// cannot reset their password.
+	Email sql.NullString `db:"email"`
+	// EmailVerifiedAt is set once the account has proven that it owns its email, by completing a
+	// password reset or by logging in with an identity provider that verified it. Only verified
+	// emails are trusted to link identities to accounts.
+	EmailVerifiedAt sql.NullTime `db:"email_verified_at"`
 }
 
 type AccountDataAccessor interface {
 	CreateAccount(ctx context.Context, account Account) (uint64, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
+	GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error)
 	GetAccountByAccountName(ctx context.Context, accountName string) (Account, error)
+	GetAccountListByVerifiedEmail(ctx context.Context, email string) ([]Account, error)
+	GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error)
+	GetAccountCount(ctx context.Context) (uint64, error)
+	GetDisabledAccountCount(ctx context.Context) (uint64, error)
+	UpdateAccount(ctx context.Context, account Account) error
 	WithDatabase(database Database) AccountDataAccessor
 }
 
@@ -48,26 +70,25 @@ func NewAccountDataAccessor(database *goqu.Database, logger *zap.Logger) Account
 func (a accountDataAccessor) CreateAccount(ctx context.Context, account Account) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("account", account))
 
-	result, err := a.database.
+	insertDataset := a.database.
 		Insert(TabNameAccounts).
 		Rows(goqu.Record{
-			ColNameAccountsAccountName: account.AccountName,
-		}).
-		Executor().
-		ExecContext(ctx)
-
+			ColNameAccountsAccountName:     account.AccountName,
+			ColNameAccountsRole:            morgana.AccountRole_ACCOUNT_ROLE_USER,
+			ColNameAccountsEmail:           account.Email,
+			ColNameAccountsEmailVerifiedAt: account.EmailVerifiedAt,
+		})
+	id, err := insertReturningID(ctx, a.database, insertDataset)
 	if err != nil {
+		if isUniqueConstraintViolation(err) {
+			return 0, ErrAccountNameTaken
+		}
+
 		logger.With(zap.Error(err)).Error("failed to create account")
 		return 0, status.Error(codes.Internal, "failed to create account")
 	}
 
-	lastInsertedID, err := result.LastInsertId()
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get last inserted id")
-		return 0, status.Error(codes.Internal, "failed to get last inserted id: "+err.Error())
-	}
-
-	return uint64(lastInsertedID), nil
+	return id, nil
 }
 
 func (a *accountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (Account, error) {
@@ -111,6 +132,119 @@ func (a *accountDataAccessor) GetAccountByAccountName(ctx context.Context, accou
 	return account, nil
 }
 
+func (a accountDataAccessor) GetAccountListByVerifiedEmail(ctx context.Context, email string) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("email", email))
+
+	accountList := make([]Account, 0)
+	err := a.database.
+		Select().
+		From(TabNameAccounts).
+		Where(
+			goqu.C(ColNameAccountsEmail).Eq(email),
+			goqu.C(ColNameAccountsEmailVerifiedAt).IsNotNull(),
+		).
+		Order(goqu.C(ColNameAccountsID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &accountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account list by verified email")
+		return nil, status.Error(codes.Internal, "failed to get account list by verified email")
+	}
+
+	return accountList, nil
+}
+
+func (a accountDataAccessor) GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	account := Account{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccounts).
+		Where(goqu.Ex{ColNameAccountsID: id}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &account)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account by id with x lock")
+		return Account{}, status.Error(codes.Internal, "failed to get account by id with x lock")
+	}
+
+	if !found {
+		return Account{}, ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+func (a accountDataAccessor) GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	accountList := make([]Account, 0)
+	err := a.database.
+		Select().
+		From(TabNameAccounts).
+		Order(goqu.C(ColNameAccountsID).Asc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &accountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account list")
+		return nil, status.Error(codes.Internal, "failed to get account list")
+	}
+
+	return accountList, nil
+}
+
+func (a accountDataAccessor) GetAccountCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	count, err := a.database.
+		From(TabNameAccounts).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account count")
+		return 0, status.Error(codes.Internal, "failed to get account count")
+	}
+
+	return uint64(count), nil
+}
+
+func (a accountDataAccessor) GetDisabledAccountCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	count, err := a.database.
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsDisabledAt).IsNotNull()).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get disabled account count")
+		return 0, status.Error(codes.Internal, "failed to get disabled account count")
+	}
+
+	return uint64(count), nil
+}
+
+func (a accountDataAccessor) UpdateAccount(ctx context.Context, account Account) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("account", account))
+
+	_, err := a.database.
+		Update(TabNameAccounts).
+		Set(account).
+		Where(goqu.Ex{ColNameAccountsID: account.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account")
+		return status.Error(codes.Internal, "failed to update account")
+	}
+
+	return nil
+}
+
 func (a accountDataAccessor) WithDatabase(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
 		database: database,
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAccountIdentities = goqu.T("account_identities")
+
+	ErrAccountIdentityNotFound      = status.Error(codes.NotFound, "account identity not found")
+	ErrAccountIdentityAlreadyExists = status.Error(codes.AlreadyExists, "account identity already exists")
+)
+
+const (
+	ColNameAccountIdentitiesID        = "id"
+	ColNameAccountIdentitiesAccountID = "account_id"
+	ColNameAccountIdentitiesIssuer    = "issuer"
+	ColNameAccountIdentitiesSubject   = "subject"
+)
+
+// AccountIdentity links an account to an identity of an external identity provider, identified
+// by the issuer and subject of its ID tokens.
+type AccountIdentity struct {
+	ID        uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountID uint64 `db:"account_id"`
+	Issuer    string `db:"issuer"`
+	Subject   string `db:"subject"`
+}
+
+type AccountIdentityDataAccessor interface {
+	CreateAccountIdentity(ctx context.Context, accountIdentity AccountIdentity) (uint64, error)
+	GetAccountIdentity(ctx context.Context, issuer, subject string) (AccountIdentity, error)
+	WithDatabase(database Database) AccountIdentityDataAccessor
+}
+
+type accountIdentityDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountIdentityDataAccessor(database *goqu.Database, logger *zap.Logger) AccountIdentityDataAccessor {
+	return &accountIdentityDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountIdentityDataAccessor) CreateAccountIdentity(ctx context.Context, accountIdentity AccountIdentity) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("account_identity", accountIdentity))
+
+	insertDataset := a.database.
+		Insert(TabNameAccountIdentities).
+		Rows(accountIdentity)
+	id, err := insertReturningID(ctx, a.database, insertDataset)
+	if err != nil {
+		if isUniqueConstraintViolation(err) {
+			return 0, ErrAccountIdentityAlreadyExists
+		}
+
+		logger.With(zap.Error(err)).Error("failed to create account identity")
+		return 0, status.Error(codes.Internal, "failed to create account identity")
+	}
+
+	return id, nil
+}
+
+func (a accountIdentityDataAccessor) GetAccountIdentity(ctx context.Context, issuer, subject string) (AccountIdentity, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("issuer", issuer)).
+		With(zap.String("subject", subject))
+
+	accountIdentity := AccountIdentity{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccountIdentities).
+		Where(goqu.Ex{
+			ColNameAccountIdentitiesIssuer:  issuer,
+			ColNameAccountIdentitiesSubject: subject,
+		}).
+		ScanStructContext(ctx, &accountIdentity)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account identity")
+		return AccountIdentity{}, status.Error(codes.Internal, "failed to get account identity")
+	}
+
+	if !found {
+		return AccountIdentity{}, ErrAccountIdentityNotFound
+	}
+
+	return accountIdentity, nil
+}
+
+func (a accountIdentityDataAccessor) WithDatabase(database Database) AccountIdentityDataAccessor {
+	return &accountIdentityDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -28,6 +28,7 @@ type AccountPassword struct {
 type AccountPasswordDataAccessor interface {
 	CreateAccountPassword(ctx context.Context, accountPassword AccountPassword) error
 	GetAccountPassword(ctx context.Context, accountID uint64) (AccountPassword, error)
+	GetAccountPasswordWithXLock(ctx context.Context, accountID uint64) (AccountPassword, error)
 	UpdateAccountPassword(ctx context.Context, accountPassword AccountPassword) error
 	WithDatabase(database Database) AccountPasswordDataAccessor
 }
@@ -84,6 +85,30 @@ func (a accountPasswordDataAccessor) GetAccountPassword(ctx context.Context, acc
 	return accountPassword, nil
 }
 
+func (a accountPasswordDataAccessor) GetAccountPasswordWithXLock(ctx context.Context, accountID uint64) (AccountPassword, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+	accountPassword := AccountPassword{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccountPasswords).
+		Where(goqu.Ex{ColNameAccountPasswordsAccountID: accountID}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &accountPassword)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account password by account id with x lock")
+		return AccountPassword{}, status.Error(codes.Internal, "failed to get account password by account id with x lock")
+	}
+
+	if !found {
+		logger.Warn("account password not found")
+		return AccountPassword{}, sql.ErrNoRows
+	}
+
+	return accountPassword, nil
+}
+
 func (a accountPasswordDataAccessor) UpdateAccountPassword(ctx context.Context, accountPassword AccountPassword) error {
 	logger := utils.LoggerWithContext(ctx, a.logger)
 	_, err := a.database.
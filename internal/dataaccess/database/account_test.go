@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestAccountRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	accountDataAccessor := NewAccountDataAccessor(newTestDatabase(t), zap.NewNop())
+
+	id, err := accountDataAccessor.CreateAccount(ctx, Account{
+		AccountName: "user",
+		Email:       sql.NullString{String: "user@example.com", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	account, err := accountDataAccessor.GetAccountByAccountName(ctx, "user")
+	if err != nil {
+		t.Fatalf("failed to get account by account name: %v", err)
+	}
+
+	if account.ID != id || account.Email.String != "user@example.com" || account.EmailVerifiedAt.Valid || account.DisabledAt.Valid {
+		t.Fatalf("unexpected account %+v", account)
+	}
+
+	account.EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	account.DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err = accountDataAccessor.UpdateAccount(ctx, account); err != nil {
+		t.Fatalf("failed to update account: %v", err)
+	}
+
+	account, err = accountDataAccessor.GetAccountByID(ctx, id)
+	if err != nil {
+		t.Fatalf("failed to get account by id: %v", err)
+	}
+
+	if !account.EmailVerifiedAt.Valid || !account.DisabledAt.Valid {
+		t.Fatalf("expected updated account to be verified and disabled, got %+v", account)
+	}
+
+	accountList, err := accountDataAccessor.GetAccountListByVerifiedEmail(ctx, "user@example.com")
+	if err != nil || len(accountList) != 1 || accountList[0].ID != id {
+		t.Fatalf("expected account %d to be found by its verified email, got %+v, %v", id, accountList, err)
+	}
+
+	disabledAccountCount, err := accountDataAccessor.GetDisabledAccountCount(ctx)
+	if err != nil || disabledAccountCount != 1 {
+		t.Fatalf("expected 1 disabled account, got %d, %v", disabledAccountCount, err)
+	}
+
+	if _, err = accountDataAccessor.CreateAccount(ctx, Account{AccountName: "user"}); !errors.Is(err, ErrAccountNameTaken) {
+		t.Fatalf("expected creating an account with a taken name to fail with %v, got %v", ErrAccountNameTaken, err)
+	}
+}
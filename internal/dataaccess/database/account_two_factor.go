@@ -0,0 +1,159 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAccountTwoFactors = goqu.T("account_two_factors")
+
+	ErrAccountTwoFactorNotFound = status.Error(codes.NotFound, "account two-factor authentication not found")
+)
+
+const (
+	ColNameAccountTwoFactorsAccountID        = "account_id"
+	ColNameAccountTwoFactorsEncryptedSecret  = "encrypted_secret"
+	ColNameAccountTwoFactorsEnabledAt        = "enabled_at"
+	ColNameAccountTwoFactorsLastUsedTimeStep = "last_used_time_step"
+	ColNameAccountTwoFactorsCreatedAt        = "created_at"
+)
+
+// AccountTwoFactor is the TOTP secret of an account. It is only enforced once EnabledAt is set,
+// which happens when the enrollment is confirmed with a first code. LastUsedTimeStep is the time
+// step of the last accepted code, so that a code cannot be used twice.
+type AccountTwoFactor struct {
+	AccountID        uint64       `db:"account_id" goqu:"skipupdate"`
+	EncryptedSecret  []byte       `db:"encrypted_secret"`
+	EnabledAt        sql.NullTime `db:"enabled_at"`
+	LastUsedTimeStep uint64       `db:"last_used_time_step"`
+	CreatedAt        time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+}
+
+type AccountTwoFactorDataAccessor interface {
+	CreateAccountTwoFactor(ctx context.Context, accountTwoFactor AccountTwoFactor) error
+	GetAccountTwoFactor(ctx context.Context, accountID uint64) (AccountTwoFactor, error)
+	GetAccountTwoFactorWithXLock(ctx context.Context, accountID uint64) (AccountTwoFactor, error)
+	UpdateAccountTwoFactor(ctx context.Context, accountTwoFactor AccountTwoFactor) error
+	DeleteAccountTwoFactor(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountTwoFactorDataAccessor
+}
+
+type accountTwoFactorDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountTwoFactorDataAccessor(database *goqu.Database, logger *zap.Logger) AccountTwoFactorDataAccessor {
+	return &accountTwoFactorDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountTwoFactorDataAccessor) CreateAccountTwoFactor(ctx context.Context, accountTwoFactor AccountTwoFactor) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountTwoFactor.AccountID))
+
+	_, err := a.database.
+		Insert(TabNameAccountTwoFactors).
+		Rows(accountTwoFactor).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account two-factor authentication")
+		return status.Error(codes.Internal, "failed to create account two-factor authentication")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorDataAccessor) GetAccountTwoFactor(ctx context.Context, accountID uint64) (AccountTwoFactor, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	accountTwoFactor := AccountTwoFactor{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccountTwoFactors).
+		Where(goqu.Ex{ColNameAccountTwoFactorsAccountID: accountID}).
+		ScanStructContext(ctx, &accountTwoFactor)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account two-factor authentication")
+		return AccountTwoFactor{}, status.Error(codes.Internal, "failed to get account two-factor authentication")
+	}
+
+	if !found {
+		return AccountTwoFactor{}, ErrAccountTwoFactorNotFound
+	}
+
+	return accountTwoFactor, nil
+}
+
+func (a accountTwoFactorDataAccessor) GetAccountTwoFactorWithXLock(ctx context.Context, accountID uint64) (AccountTwoFactor, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	accountTwoFactor := AccountTwoFactor{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccountTwoFactors).
+		Where(goqu.Ex{ColNameAccountTwoFactorsAccountID: accountID}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &accountTwoFactor)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account two-factor authentication with x lock")
+		return AccountTwoFactor{}, status.Error(codes.Internal, "failed to get account two-factor authentication with x lock")
+	}
+
+	if !found {
+		return AccountTwoFactor{}, ErrAccountTwoFactorNotFound
+	}
+
+	return accountTwoFactor, nil
+}
+
+func (a accountTwoFactorDataAccessor) UpdateAccountTwoFactor(ctx context.Context, accountTwoFactor AccountTwoFactor) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountTwoFactor.AccountID))
+
+	_, err := a.database.
+		Update(TabNameAccountTwoFactors).
+		Set(accountTwoFactor).
+		Where(goqu.Ex{ColNameAccountTwoFactorsAccountID: accountTwoFactor.AccountID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account two-factor authentication")
+		return status.Error(codes.Internal, "failed to update account two-factor authentication")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorDataAccessor) DeleteAccountTwoFactor(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := a.database.
+		Delete(TabNameAccountTwoFactors).
+		Where(goqu.Ex{ColNameAccountTwoFactorsAccountID: accountID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account two-factor authentication")
+		return status.Error(codes.Internal, "failed to delete account two-factor authentication")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorDataAccessor) WithDatabase(database Database) AccountTwoFactorDataAccessor {
+	return &accountTwoFactorDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
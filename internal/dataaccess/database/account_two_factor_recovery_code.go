@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAccountTwoFactorRecoveryCodes = goqu.T("account_two_factor_recovery_codes")
+
+	ErrAccountTwoFactorRecoveryCodeNotFound = status.Error(codes.NotFound, "account two-factor recovery code not found")
+)
+
+const (
+	ColNameAccountTwoFactorRecoveryCodesCodeHash  = "code_hash"
+	ColNameAccountTwoFactorRecoveryCodesAccountID = "account_id"
+	ColNameAccountTwoFactorRecoveryCodesCreatedAt = "created_at"
+	ColNameAccountTwoFactorRecoveryCodesUsedAt    = "used_at"
+)
+
+// AccountTwoFactorRecoveryCode is a single use code that replaces a TOTP code when the
+// authenticator is lost, identified by the hex encoded sha256 of the code.
+type AccountTwoFactorRecoveryCode struct {
+	CodeHash  string       `db:"code_hash" goqu:"skipupdate"`
+	AccountID uint64       `db:"account_id" goqu:"skipupdate"`
+	CreatedAt time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+type AccountTwoFactorRecoveryCodeDataAccessor interface {
+	CreateAccountTwoFactorRecoveryCodeList(ctx context.Context, recoveryCodeList []AccountTwoFactorRecoveryCode) error
+	GetAccountTwoFactorRecoveryCodeWithXLock(ctx context.Context, codeHash string) (AccountTwoFactorRecoveryCode, error)
+	UpdateAccountTwoFactorRecoveryCode(ctx context.Context, recoveryCode AccountTwoFactorRecoveryCode) error
+	DeleteAccountTwoFactorRecoveryCodeListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountTwoFactorRecoveryCodeDataAccessor
+}
+
+type accountTwoFactorRecoveryCodeDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountTwoFactorRecoveryCodeDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountTwoFactorRecoveryCodeDataAccessor {
+	return &accountTwoFactorRecoveryCodeDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountTwoFactorRecoveryCodeDataAccessor) CreateAccountTwoFactorRecoveryCodeList(
+	ctx context.Context,
+	recoveryCodeList []AccountTwoFactorRecoveryCode,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	_, err := a.database.
+		Insert(TabNameAccountTwoFactorRecoveryCodes).
+		Rows(recoveryCodeList).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account two-factor recovery code list")
+		return status.Error(codes.Internal, "failed to create account two-factor recovery code list")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorRecoveryCodeDataAccessor) GetAccountTwoFactorRecoveryCodeWithXLock(
+	ctx context.Context,
+	codeHash string,
+) (AccountTwoFactorRecoveryCode, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	recoveryCode := AccountTwoFactorRecoveryCode{}
+	found, err := a.database.
+		Select().
+		From(TabNameAccountTwoFactorRecoveryCodes).
+		Where(goqu.Ex{ColNameAccountTwoFactorRecoveryCodesCodeHash: codeHash}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &recoveryCode)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account two-factor recovery code with x lock")
+		return AccountTwoFactorRecoveryCode{}, status.Error(codes.Internal, "failed to get account two-factor recovery code with x lock")
+	}
+
+	if !found {
+		return AccountTwoFactorRecoveryCode{}, ErrAccountTwoFactorRecoveryCodeNotFound
+	}
+
+	return recoveryCode, nil
+}
+
+func (a accountTwoFactorRecoveryCodeDataAccessor) UpdateAccountTwoFactorRecoveryCode(
+	ctx context.Context,
+	recoveryCode AccountTwoFactorRecoveryCode,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", recoveryCode.AccountID))
+
+	_, err := a.database.
+		Update(TabNameAccountTwoFactorRecoveryCodes).
+		Set(recoveryCode).
+		Where(goqu.Ex{ColNameAccountTwoFactorRecoveryCodesCodeHash: recoveryCode.CodeHash}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account two-factor recovery code")
+		return status.Error(codes.Internal, "failed to update account two-factor recovery code")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorRecoveryCodeDataAccessor) DeleteAccountTwoFactorRecoveryCodeListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := a.database.
+		Delete(TabNameAccountTwoFactorRecoveryCodes).
+		Where(goqu.Ex{ColNameAccountTwoFactorRecoveryCodesAccountID: accountID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account two-factor recovery code list of account")
+		return status.Error(codes.Internal, "failed to delete account two-factor recovery code list of account")
+	}
+
+	return nil
+}
+
+func (a accountTwoFactorRecoveryCodeDataAccessor) WithDatabase(database Database) AccountTwoFactorRecoveryCodeDataAccessor {
+	return &accountTwoFactorRecoveryCodeDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,186 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAPIKeys = goqu.T("api_keys")
+
+	ErrAPIKeyNotFound = status.Error(codes.NotFound, "api key not found")
+)
+
+const (
+	ColNameAPIKeysID         = "id"
+	ColNameAPIKeysAccountID  = "account_id"
+	ColNameAPIKeysName       = "name"
+	ColNameAPIKeysKeyPrefix  = "key_prefix"
+	ColNameAPIKeysSecretHash = "secret_hash"
+	ColNameAPIKeysScopes     = "scopes"
+	ColNameAPIKeysCreatedAt  = "created_at"
+	ColNameAPIKeysExpiresAt  = "expires_at"
+	ColNameAPIKeysLastUsedAt = "last_used_at"
+)
+
+// APIKey is a long lived credential of an account. Only the hex encoded sha256 of the key is
+// stored, along with its first characters so that users can tell their keys apart. Scopes is a
+// comma separated list.
+type APIKey struct {
+	ID         uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountID  uint64       `db:"account_id" goqu:"skipupdate"`
+	Name       string       `db:"name"`
+	KeyPrefix  string       `db:"key_prefix" goqu:"skipupdate"`
+	SecretHash string       `db:"secret_hash" goqu:"skipupdate"`
+	Scopes     string       `db:"scopes"`
+	CreatedAt  time.Time    `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+}
+
+type APIKeyDataAccessor interface {
+	CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error)
+	GetAPIKey(ctx context.Context, id uint64) (APIKey, error)
+	GetAPIKeyBySecretHash(ctx context.Context, secretHash string) (APIKey, error)
+	GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error)
+	UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error
+	DeleteAPIKey(ctx context.Context, id uint64) error
+	WithDatabase(database Database) APIKeyDataAccessor
+}
+
+type apiKeyDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAPIKeyDataAccessor(database *goqu.Database, logger *zap.Logger) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a apiKeyDataAccessor) CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("account_id", apiKey.AccountID)).
+		With(zap.String("key_prefix", apiKey.KeyPrefix))
+
+	insertDataset := a.database.
+		Insert(TabNameAPIKeys).
+		Rows(apiKey)
+	id, err := insertReturningID(ctx, a.database, insertDataset)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create api key")
+		return 0, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	return id, nil
+}
+
+func (a apiKeyDataAccessor) GetAPIKey(ctx context.Context, id uint64) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	apiKey := APIKey{}
+	found, err := a.database.
+		Select().
+		From(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeysID: id}).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key")
+		return APIKey{}, status.Error(codes.Internal, "failed to get api key")
+	}
+
+	if !found {
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+func (a apiKeyDataAccessor) GetAPIKeyBySecretHash(ctx context.Context, secretHash string) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	apiKey := APIKey{}
+	found, err := a.database.
+		Select().
+		From(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeysSecretHash: secretHash}).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key by secret hash")
+		return APIKey{}, status.Error(codes.Internal, "failed to get api key by secret hash")
+	}
+
+	if !found {
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+func (a apiKeyDataAccessor) GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	apiKeyList := make([]APIKey, 0)
+	err := a.database.
+		Select().
+		From(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeysAccountID: accountID}).
+		Order(goqu.C(ColNameAPIKeysID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &apiKeyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key list of account")
+		return nil, status.Error(codes.Internal, "failed to get api key list of account")
+	}
+
+	return apiKeyList, nil
+}
+
+func (a apiKeyDataAccessor) UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	_, err := a.database.
+		Update(TabNameAPIKeys).
+		Set(goqu.Record{ColNameAPIKeysLastUsedAt: lastUsedAt}).
+		Where(goqu.Ex{ColNameAPIKeysID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update api key last used at")
+		return status.Error(codes.Internal, "failed to update api key last used at")
+	}
+
+	return nil
+}
+
+func (a apiKeyDataAccessor) DeleteAPIKey(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	_, err := a.database.
+		Delete(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeysID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete api key")
+		return status.Error(codes.Internal, "failed to delete api key")
+	}
+
+	return nil
+}
+
+func (a apiKeyDataAccessor) WithDatabase(database Database) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameAuditEvents = goqu.T("audit_events")
+)
+
+const (
+	ColNameAuditEventsID        = "id"
+	ColNameAuditEventsCreatedAt = "created_at"
+)
+
+// AuditEvent records a security relevant event. AccountName and IPAddress are whichever of the
+// two the event is about, ActorAccountID is set when the event was caused by another account,
+// and ExpiresAt is set for events that only last for a while, such as lockouts.
+type AuditEvent struct {
+	ID             uint64                 `db:"id" goqu:"skipinsert,skipupdate"`
+	EventType      morgana.AuditEventType `db:"event_type"`
+	AccountName    string                 `db:"account_name"`
+	IPAddress      string                 `db:"ip_address"`
+	ActorAccountID sql.Null[uint64]       `db:"actor_account_id"`
+	CreatedAt      time.Time              `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ExpiresAt      sql.NullTime           `db:"expires_at"`
+}
+
+type AuditEventDataAccessor interface {
+	CreateAuditEvent(ctx context.Context, auditEvent AuditEvent) (uint64, error)
+	GetAuditEventList(ctx context.Context, offset, limit uint64) ([]AuditEvent, error)
+	GetAuditEventCount(ctx context.Context) (uint64, error)
+	WithDatabase(database Database) AuditEventDataAccessor
+}
+
+type auditEventDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAuditEventDataAccessor(database *goqu.Database, logger *zap.Logger) AuditEventDataAccessor {
+	return &auditEventDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a auditEventDataAccessor) CreateAuditEvent(ctx context.Context, auditEvent AuditEvent) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("audit_event", auditEvent))
+
+	insertDataset := a.database.
+		Insert(TabNameAuditEvents).
+		Rows(auditEvent)
+	id, err := insertReturningID(ctx, a.database, insertDataset)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create audit event")
+		return 0, status.Error(codes.Internal, "failed to create audit event")
+	}
+
+	return id, nil
+}
+
+func (a auditEventDataAccessor) GetAuditEventList(ctx context.Context, offset, limit uint64) ([]AuditEvent, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	auditEventList := make([]AuditEvent, 0)
+	err := a.database.
+		Select().
+		From(TabNameAuditEvents).
+		Order(goqu.C(ColNameAuditEventsID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &auditEventList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get audit event list")
+		return nil, status.Error(codes.Internal, "failed to get audit event list")
+	}
+
+	return auditEventList, nil
+}
+
+func (a auditEventDataAccessor) GetAuditEventCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	count, err := a.database.
+		From(TabNameAuditEvents).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get audit event count")
+		return 0, status.Error(codes.Internal, "failed to get audit event count")
+	}
+
+	return uint64(count), nil
+}
+
+func (a auditEventDataAccessor) WithDatabase(database Database) AuditEventDataAccessor {
+	return &auditEventDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
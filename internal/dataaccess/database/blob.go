@@ -0,0 +1,177 @@
+package database
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameBlobs = goqu.T("blobs")
+
+	ErrBlobNotFound = status.Error(codes.NotFound, "blob not found")
+)
+
+const (
+	ColNameBlobsSHA256         = "sha256"
+	ColNameBlobsSize           = "size"
+	ColNameBlobsReferenceCount = "reference_count"
+	ColNameBlobsFilePath       = "file_path"
+	ColNameBlobsContentSHA256  = "content_sha256"
+	ColNameBlobsCodec          = "codec"
+	ColNameBlobsMasterKeyID    = "master_key_id"
+	ColNameBlobsDataKey        = "data_key"
+)
+
+type Blob struct {
+	SHA256         string `db:"sha256" goqu:"skipupdate"`
+	Size           uint64 `db:"size"`
+	ReferenceCount uint64 `db:"reference_count"`
+	// FilePath is where the content is stored. It is empty for blobs stored before it was recorded,
+	// which are stored at a path derived from SHA256.
+	FilePath string `db:"file_path" goqu:"skipupdate"`
+	// ContentSHA256 is the sha256 of the content before it was compressed and encrypted, and Codec
+	// the codec it was compressed with. Both are empty for blobs stored before they were recorded.
+	ContentSHA256 string `db:"content_sha256" goqu:"skipupdate"`
+	Codec         string `db:"codec" goqu:"skipupdate"`
+	// MasterKeyID and DataKey are the base64 encoded data key the content was encrypted with and the
+	// master key that wraps it. Both are empty if the content is stored in plaintext.
+	MasterKeyID string `db:"master_key_id" goqu:"skipupdate"`
+	DataKey     string `db:"data_key" goqu:"skipupdate"`
+}
+
+type BlobDataAccessor interface {
+	// CreateBlobIfNotExists reports whether the blob was created. It is not when a blob with the same
+	// sha256 exists, including one created by a concurrent transaction that has not committed yet,
+	// which it waits for instead of failing.
+	CreateBlobIfNotExists(ctx context.Context, blob Blob) (bool, error)
+	GetBlob(ctx context.Context, sha256 string) (Blob, error)
+	GetBlobWithXLock(ctx context.Context, sha256 string) (Blob, error)
+	UpdateBlob(ctx context.Context, blob Blob) error
+	DeleteBlob(ctx context.Context, sha256 string) error
+	WithDatabase(database Database) BlobDataAccessor
+}
+
+type blobDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewBlobDataAccessor(database *goqu.Database, logger *zap.Logger) BlobDataAccessor {
+	return &blobDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (b blobDataAccessor) CreateBlobIfNotExists(ctx context.Context, blob Blob) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.Any("blob", blob))
+
+	result, err := b.database.
+		Insert(TabNameBlobs).
+		Rows(blob).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create blob")
+		return false, status.Error(codes.Internal, "failed to create blob")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get affected rows")
+		return false, status.Error(codes.Internal, "failed to create blob")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (b blobDataAccessor) GetBlob(ctx context.Context, sha256 string) (Blob, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("sha256", sha256))
+
+	blob := Blob{}
+	found, err := b.database.
+		Select().
+		From(TabNameBlobs).
+		Where(goqu.Ex{ColNameBlobsSHA256: sha256}).
+		Executor().
+		ScanStructContext(ctx, &blob)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blob")
+		return Blob{}, status.Error(codes.Internal, "failed to get blob")
+	}
+
+	if !found {
+		return Blob{}, ErrBlobNotFound
+	}
+
+	return blob, nil
+}
+
+func (b blobDataAccessor) GetBlobWithXLock(ctx context.Context, sha256 string) (Blob, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("sha256", sha256))
+
+	blob := Blob{}
+	found, err := b.database.
+		Select().
+		From(TabNameBlobs).
+		Where(goqu.Ex{ColNameBlobsSHA256: sha256}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &blob)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blob with x lock")
+		return Blob{}, status.Error(codes.Internal, "failed to get blob with x lock")
+	}
+
+	if !found {
+		return Blob{}, ErrBlobNotFound
+	}
+
+	return blob, nil
+}
+
+func (b blobDataAccessor) UpdateBlob(ctx context.Context, blob Blob) error {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.Any("blob", blob))
+
+	_, err := b.database.
+		Update(TabNameBlobs).
+		Set(blob).
+		Where(goqu.Ex{ColNameBlobsSHA256: blob.SHA256}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update blob")
+		return status.Error(codes.Internal, "failed to update blob")
+	}
+
+	return nil
+}
+
+func (b blobDataAccessor) DeleteBlob(ctx context.Context, sha256 string) error {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("sha256", sha256))
+
+	_, err := b.database.
+		Delete(TabNameBlobs).
+		Where(goqu.Ex{ColNameBlobsSHA256: sha256}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete blob")
+		return status.Error(codes.Internal, "failed to delete blob")
+	}
+
+	return nil
+}
+
+func (b blobDataAccessor) WithDatabase(database Database) BlobDataAccessor {
+	return &blobDataAccessor{
+		database: database,
+		logger:   b.logger,
+	}
+}
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameBlobReferences = goqu.T("blob_references")
+
+	ErrBlobReferenceNotFound = status.Error(codes.NotFound, "blob reference not found")
+)
+
+const (
+	ColNameBlobReferencesFilePath   = "file_path"
+	ColNameBlobReferencesBlobSHA256 = "blob_sha256"
+)
+
+type BlobReference struct {
+	FilePath   string `db:"file_path" goqu:"skipupdate"`
+	BlobSHA256 string `db:"blob_sha256"`
+}
+
+type BlobReferenceDataAccessor interface {
+	CreateBlobReference(ctx context.Context, blobReference BlobReference) error
+	GetBlobReference(ctx context.Context, filePath string) (BlobReference, error)
+	GetBlobReferenceWithXLock(ctx context.Context, filePath string) (BlobReference, error)
+	UpdateBlobReference(ctx context.Context, blobReference BlobReference) error
+	DeleteBlobReference(ctx context.Context, filePath string) error
+	WithDatabase(database Database) BlobReferenceDataAccessor
+}
+
+type blobReferenceDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewBlobReferenceDataAccessor(database *goqu.Database, logger *zap.Logger) BlobReferenceDataAccessor {
+	return &blobReferenceDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (b blobReferenceDataAccessor) CreateBlobReference(ctx context.Context, blobReference BlobReference) error {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.Any("blob_reference", blobReference))
+
+	_, err := b.database.
+		Insert(TabNameBlobReferences).
+		Rows(blobReference).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create blob reference")
+		return status.Error(codes.Internal, "failed to create blob reference")
+	}
+
+	return nil
+}
+
+func (b blobReferenceDataAccessor) GetBlobReference(ctx context.Context, filePath string) (BlobReference, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("file_path", filePath))
+
+	blobReference := BlobReference{}
+	found, err := b.database.
+		Select().
+		From(TabNameBlobReferences).
+		Where(goqu.Ex{ColNameBlobReferencesFilePath: filePath}).
+		ScanStructContext(ctx, &blobReference)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blob reference")
+		return BlobReference{}, status.Error(codes.Internal, "failed to get blob reference")
+	}
+
+	if !found {
+		return BlobReference{}, ErrBlobReferenceNotFound
+	}
+
+	return blobReference, nil
+}
+
+func (b blobReferenceDataAccessor) GetBlobReferenceWithXLock(ctx context.Context, filePath string) (BlobReference, error) {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("file_path", filePath))
+
+	blobReference := BlobReference{}
+	found, err := b.database.
+		Select().
+		From(TabNameBlobReferences).
+		Where(goqu.Ex{ColNameBlobReferencesFilePath: filePath}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &blobReference)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get blob reference with x lock")
+		return BlobReference{}, status.Error(codes.Internal, "failed to get blob reference with x lock")
+	}
+
+	if !found {
+		return BlobReference{}, ErrBlobReferenceNotFound
+	}
+
+	return blobReference, nil
+}
+
+func (b blobReferenceDataAccessor) UpdateBlobReference(ctx context.Context, blobReference BlobReference) error {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.Any("blob_reference", blobReference))
+
+	_, err := b.database.
+		Update(TabNameBlobReferences).
+		Set(blobReference).
+		Where(goqu.Ex{ColNameBlobReferencesFilePath: blobReference.FilePath}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update blob reference")
+		return status.Error(codes.Internal, "failed to update blob reference")
+	}
+
+	return nil
+}
+
+func (b blobReferenceDataAccessor) DeleteBlobReference(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, b.logger).With(zap.String("file_path", filePath))
+
+	_, err := b.database.
+		Delete(TabNameBlobReferences).
+		Where(goqu.Ex{ColNameBlobReferencesFilePath: filePath}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete blob reference")
+		return status.Error(codes.Internal, "failed to delete blob reference")
+	}
+
+	return nil
+}
+
+func (b blobReferenceDataAccessor) WithDatabase(database Database) BlobReferenceDataAccessor {
+	return &blobReferenceDataAccessor{
+		database: database,
+		logger:   b.logger,
+	}
+}
@@ -3,15 +3,46 @@ package database
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/url"
+	"strconv"
 
+	"github.com/XSAM/otelsql"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/go-sql-driver/mysql"
 	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"modernc.org/sqlite"
+	sqlitelib "modernc.org/sqlite/lib"
 
-	_ "github.com/doug-martin/goqu/v9/dialect/mysql" // Import MySQL goqu dialect
-	_ "github.com/go-sql-driver/mysql"               // Import MySQL driver
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"    // Import MySQL goqu dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres" // Import PostgreSQL goqu dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"  // Import SQLite goqu dialect
+	_ "github.com/jackc/pgx/v5/stdlib"                  // Import PostgreSQL driver
+)
+
+const (
+	goquDialectMySQL      = "mysql"
+	goquDialectPostgreSQL = "postgres"
+	goquDialectSQLite     = "sqlite3"
+
+	// SQLite has no row locks, so every transaction takes the database write lock when it begins
+	// instead, which serializes writing transactions the same way SELECT ... FOR UPDATE would.
+	// Statements waiting for the lock give up after the busy timeout.
+	sqliteBusyTimeoutInMilliseconds = 5000
+
+	colNameID = "id"
+
+	mysqlErrorNumberDuplicateEntry     = 1062
+	postgreSQLErrorCodeUniqueViolation = "23505"
 )
 
 type Database interface {
@@ -42,25 +73,119 @@ type Database interface {
 	Update(table interface{}) *goqu.UpdateDataset
 }
 
-func InitializeAndMigrateUpDB(databaseConfig configs.Database, logger *zap.Logger) (*sql.DB, func(), error) {
-	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
-		databaseConfig.Username,
-		databaseConfig.Password,
-		databaseConfig.Host,
-		databaseConfig.Port,
-		databaseConfig.Database,
-	)
-	db, err := sql.Open("mysql", connectionString)
+func getDatabaseType(databaseConfig configs.Database) (configs.DatabaseType, error) {
+	switch databaseConfig.Type {
+	case configs.DatabaseTypeMySQL, "":
+		return configs.DatabaseTypeMySQL, nil
+	case configs.DatabaseTypePostgreSQL:
+		return configs.DatabaseTypePostgreSQL, nil
+	case configs.DatabaseTypeSQLite:
+		return configs.DatabaseTypeSQLite, nil
+	default:
+		return "", fmt.Errorf("unsupported database type: %s", databaseConfig.Type)
+	}
+}
+
+func getDatabaseSystemAttribute(databaseType configs.DatabaseType) attribute.KeyValue {
+	switch databaseType {
+	case configs.DatabaseTypePostgreSQL:
+		return semconv.DBSystemPostgreSQL
+	case configs.DatabaseTypeSQLite:
+		return semconv.DBSystemSqlite
+	default:
+		return semconv.DBSystemMySQL
+	}
+}
+
+func getDriverNameAndConnectionString(databaseConfig configs.Database) (string, string, error) {
+	databaseType, err := getDatabaseType(databaseConfig)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch databaseType {
+	case configs.DatabaseTypePostgreSQL:
+		query := url.Values{}
+		if databaseConfig.SSLMode != "" {
+			query.Set("sslmode", databaseConfig.SSLMode)
+		}
+
+		connectionURL := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(databaseConfig.Username, databaseConfig.Password),
+			Host:     net.JoinHostPort(databaseConfig.Host, strconv.Itoa(databaseConfig.Port)),
+			Path:     "/" + databaseConfig.Database,
+			RawQuery: query.Encode(),
+		}
+		return "pgx", connectionURL.String(), nil
+
+	case configs.DatabaseTypeSQLite:
+		query := url.Values{}
+		query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", sqliteBusyTimeoutInMilliseconds))
+		query.Add("_pragma", "journal_mode(WAL)")
+		query.Add("_pragma", "foreign_keys(1)")
+		query.Set("_txlock", "immediate")
+		query.Set("_time_format", "sqlite")
+		return "sqlite", "file:" + databaseConfig.Database + "?" + query.Encode(), nil
+
+	default:
+		return "mysql", fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true",
+			databaseConfig.Username,
+			databaseConfig.Password,
+			databaseConfig.Host,
+			databaseConfig.Port,
+			databaseConfig.Database,
+		), nil
+	}
+}
+
+func InitializeAndMigrateUpDB(
+	databaseConfig configs.Database,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+) (*sql.DB, func(), error) {
+	databaseType, err := getDatabaseType(databaseConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get database type")
+		return nil, nil, err
+	}
+
+	driverName, connectionString, err := getDriverNameAndConnectionString(databaseConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get database connection string")
+		return nil, nil, err
+	}
+
+	metricsDriver, err := newMetricsDriver(driverName)
+	if err != nil {
+		log.Printf("error opening database driver: %v", err)
+		return nil, nil, err
+	}
+
+	// goqu has no hooks of its own, so the queries it runs are timed and traced by wrapping the
+	// driver, which also covers the ones run inside transactions.
+	connector, err := otelsql.WrapDriver(
+		metricsDriver,
+		otelsql.WithTracerProvider(tracerProvider),
+		otelsql.WithAttributes(getDatabaseSystemAttribute(databaseType)),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			OmitRows:             true,
+		}),
+	).(driver.DriverContext).OpenConnector(connectionString)
 	if err != nil {
 		log.Printf("error opening database connection: %v", err)
 		return nil, nil, err
 	}
 
+	db := sql.OpenDB(connector)
+
 	cleanup := func() {
 		db.Close()
 	}
 
-	migrator := NewMigrator(db, logger)
+	migrator := NewMigrator(db, databaseConfig, logger)
 	err = migrator.Up(context.Background())
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to migrate database")
@@ -69,6 +194,77 @@ func InitializeAndMigrateUpDB(databaseConfig configs.Database, logger *zap.Logge
 	return db, cleanup, nil
 }
 
-func InitializeGoquDB(db *sql.DB) *goqu.Database {
-	return goqu.New("mysql", db)
+func InitializeGoquDB(db *sql.DB, databaseConfig configs.Database) (*goqu.Database, error) {
+	databaseType, err := getDatabaseType(databaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Values are sent as placeholder arguments instead of being interpolated into the SQL, which
+	// mangles, or fails on, binary values that are not valid UTF-8, such as the encrypted token
+	// signing keys and two-factor secrets. This is a process wide goqu setting rather than one of
+	// the returned database, so it applies to every dataset whatever the dialect.
+	goqu.SetDefaultPrepared(true)
+
+	switch databaseType {
+	case configs.DatabaseTypePostgreSQL:
+		return goqu.New(goquDialectPostgreSQL, db), nil
+	case configs.DatabaseTypeSQLite:
+		return goqu.New(goquDialectSQLite, db), nil
+	default:
+		return goqu.New(goquDialectMySQL, db), nil
+	}
+}
+
+// insertReturningID runs an insert into a table with an auto incremented id column and returns
+// the id of the inserted row. PostgreSQL drivers do not support LastInsertId, so the id is
+// returned by the insert itself there.
+func insertReturningID(ctx context.Context, database Database, insertDataset *goqu.InsertDataset) (uint64, error) {
+	if database.Dialect() == goquDialectPostgreSQL {
+		var id uint64
+		_, err := insertDataset.
+			Returning(goqu.C(colNameID)).
+			Executor().
+			ScanValContext(ctx, &id)
+		if err != nil {
+			return 0, err
+		}
+
+		return id, nil
+	}
+
+	result, err := insertDataset.Executor().ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+// isUniqueConstraintViolation returns whether an insert or an update failed because it would have
+// duplicated the value of a unique key, so that accessors can report it instead of an internal
+// error.
+func isUniqueConstraintViolation(err error) bool {
+	var mysqlError *mysql.MySQLError
+	if errors.As(err, &mysqlError) {
+		return mysqlError.Number == mysqlErrorNumberDuplicateEntry
+	}
+
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) {
+		return pgError.Code == postgreSQLErrorCodeUniqueViolation
+	}
+
+	var sqliteError *sqlite.Error
+	if errors.As(err, &sqliteError) {
+		return sqliteError.Code() == sqlitelib.SQLITE_CONSTRAINT_UNIQUE ||
+			sqliteError.Code() == sqlitelib.SQLITE_CONSTRAINT_PRIMARYKEY
+	}
+
+	return false
 }
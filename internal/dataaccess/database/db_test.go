@@ -0,0 +1,100 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+func newTestDatabaseConfig(t *testing.T) configs.Database {
+	return configs.Database{
+		Type:     configs.DatabaseTypeSQLite,
+		Database: filepath.Join(t.TempDir(), "morgana.db"),
+	}
+}
+
+// newTestDatabase migrates up a SQLite database in a temporary file, so that the accessors can be
+// tested without any service.
+func newTestDatabase(t *testing.T) *goqu.Database {
+	t.Helper()
+
+	databaseConfig := newTestDatabaseConfig(t)
+
+	db, cleanup, err := InitializeAndMigrateUpDB(databaseConfig, noop.NewTracerProvider(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	// InitializeAndMigrateUpDB only logs migration failures, migrating up again returns them.
+	err = NewMigrator(db, databaseConfig, zap.NewNop()).Up(context.Background())
+	if err != nil {
+		t.Fatalf("failed to migrate database up: %v", err)
+	}
+
+	goquDatabase, err := InitializeGoquDB(db, databaseConfig)
+	if err != nil {
+		t.Fatalf("failed to initialize goqu database: %v", err)
+	}
+
+	return goquDatabase
+}
+
+func TestBinaryValueRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	tokenPublicKeyDataAccessor := NewTokenPublicKeyDataAccessor(newTestDatabase(t), zap.NewNop())
+
+	// Interpolating this value into the SQL would fail on the NUL byte and on the invalid UTF-8.
+	encryptedPrivateKey := []byte{0x00, 0xff, 0x80, '\'', 0xc3, '\\', 0x00}
+	id, err := tokenPublicKeyDataAccessor.CreatePublicKey(ctx, TokenPublicKey{
+		PublicKey:           "public key",
+		EncryptedPrivateKey: encryptedPrivateKey,
+	})
+	if err != nil {
+		t.Fatalf("failed to create token public key: %v", err)
+	}
+
+	tokenPublicKey, err := tokenPublicKeyDataAccessor.GetPublicKey(ctx, id)
+	if err != nil {
+		t.Fatalf("failed to get token public key: %v", err)
+	}
+
+	if !bytes.Equal(tokenPublicKey.EncryptedPrivateKey, encryptedPrivateKey) {
+		t.Fatalf("expected encrypted private key %x, got %x", encryptedPrivateKey, tokenPublicKey.EncryptedPrivateKey)
+	}
+}
+
+func TestMigrateDownAndUp(t *testing.T) {
+	ctx := context.Background()
+	databaseConfig := newTestDatabaseConfig(t)
+
+	db, cleanup, err := InitializeAndMigrateUpDB(databaseConfig, noop.NewTracerProvider(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	migrator := NewMigrator(db, databaseConfig, zap.NewNop())
+	for _, migrate := range []func(context.Context) error{migrator.Up, migrator.Down, migrator.Up} {
+		if err = migrate(ctx); err != nil {
+			t.Fatalf("failed to migrate database: %v", err)
+		}
+	}
+
+	goquDatabase, err := InitializeGoquDB(db, databaseConfig)
+	if err != nil {
+		t.Fatalf("failed to initialize goqu database: %v", err)
+	}
+
+	// The seed rows of the migrations are back after migrating down and up again.
+	leaderLeaseCount, err := goquDatabase.From(TabNameLeaderLeases).CountContext(ctx)
+	if err != nil || leaderLeaseCount != 1 {
+		t.Fatalf("expected 1 leader lease, got %d, %v", leaderLeaseCount, err)
+	}
+}
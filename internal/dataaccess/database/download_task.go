@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/doug-martin/goqu/v9"
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
@@ -22,6 +23,8 @@ const (
 	ColNameDownloadTaskURL            = "url"
 	ColNameDownloadTaskDownloadStatus = "download_status"
 	ColNameDownloadTaskMetadata       = "metadata"
+	ColNameDownloadTaskOrganizationID = "organization_id"
+	ColNameDownloadTaskFileSize       = "file_size"
 )
 
 type DownloadTask struct {
@@ -31,17 +34,47 @@ type DownloadTask struct {
 	URL            string                 `db:"url"`
 	DownloadStatus morgana.DownloadStatus `db:"download_status"`
 	Metadata       JSON                   `db:"metadata"`
+	// OrganizationID is set when the download task is owned by an organization, in which case
+	// AccountID is the account that created it.
+	OrganizationID sql.Null[uint64] `db:"organization_id" goqu:"skipupdate"`
+	FileSize       uint64           `db:"file_size"`
+}
+
+// DownloadTaskFilter narrows down the download tasks listed across all accounts. Zero values are
+// not applied.
+type DownloadTaskFilter struct {
+	AccountID      uint64
+	DownloadStatus morgana.DownloadStatus
+}
+
+// DownloadTaskUsage sums up the download tasks of an owner, to be checked against its quota.
+type DownloadTaskUsage struct {
+	DownloadTaskCount uint64 `db:"download_task_count"`
+	TotalFileSize     uint64 `db:"total_file_size"`
+}
+
+type DownloadTaskStatusCount struct {
+	DownloadStatus morgana.DownloadStatus `db:"download_status"`
+	Count          uint64                 `db:"count"`
 }
 
 type DownloadTaskDataAccessor interface {
 	CreateDownloadTask(ctx context.Context, task DownloadTask) (uint64, error)
 	GetDownloadTaskListOfAccount(ctx context.Context, accountID, offset, limit uint64) ([]DownloadTask, error)
 	GetDownloadTaskCountOfAccount(ctx context.Context, accountID uint64) (uint64, error)
+	GetDownloadTaskUsageOfAccount(ctx context.Context, accountID uint64) (DownloadTaskUsage, error)
+	GetDownloadTaskListOfOrganization(ctx context.Context, organizationID, offset, limit uint64) ([]DownloadTask, error)
+	GetDownloadTaskCountOfOrganization(ctx context.Context, organizationID uint64) (uint64, error)
+	GetDownloadTaskUsageOfOrganization(ctx context.Context, organizationID uint64) (DownloadTaskUsage, error)
+	GetDownloadTaskList(ctx context.Context, filter DownloadTaskFilter, offset, limit uint64) ([]DownloadTask, error)
+	GetDownloadTaskCount(ctx context.Context, filter DownloadTaskFilter) (uint64, error)
+	GetDownloadTaskStatusCountList(ctx context.Context) ([]DownloadTaskStatusCount, error)
 	GetDownloadTask(ctx context.Context, id uint64) (DownloadTask, error)
 	GetDownloadTaskWithXLock(ctx context.Context, id uint64) (DownloadTask, error)
 	UpdateDownloadTask(ctx context.Context, task DownloadTask) error
 	DeleteDownloadTask(ctx context.Context, id uint64) error
 	GetPendingDownloadTaskIDList(ctx context.Context) ([]uint64, error)
+	GetSuccessfulDownloadTaskIDList(ctx context.Context, afterID, limit uint64) ([]uint64, error)
 	UpdateDownloadingAndFailedDownloadTaskStatusToPending(ctx context.Context) error
 	WithDatabase(database Database) DownloadTaskDataAccessor
 }
@@ -61,23 +94,16 @@ func NewDownloadTaskDataAccessor(database *goqu.Database, logger *zap.Logger) Do
 func (d downloadTaskDataAccessor) CreateDownloadTask(ctx context.Context, task DownloadTask) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("task", task))
 
-	result, err := d.database.
+	insertDataset := d.database.
 		Insert(TabNameDownloadTasks).
-		Rows(task).
-		Executor().
-		ExecContext(ctx)
+		Rows(task)
+	id, err := insertReturningID(ctx, d.database, insertDataset)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create download task")
 		return 0, status.Error(codes.Internal, "failed to create download task")
 	}
 
-	lastInsertedID, err := result.LastInsertId()
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get last inserted id")
-		return 0, status.Error(codes.Internal, "failed to get last inserted id")
-	}
-
-	return uint64(lastInsertedID), nil
+	return id, nil
 }
 
 func (d downloadTaskDataAccessor) DeleteDownloadTask(ctx context.Context, id uint64) error {
@@ -106,7 +132,7 @@ func (d downloadTaskDataAccessor) GetDownloadTaskListOfAccount(ctx context.Conte
 	err := d.database.
 		Select().
 		From(TabNameDownloadTasks).
-		Where(goqu.Ex{ColNameDownloadTaskAccountID: accountID}).
+		Where(d.getDownloadTaskOfAccountExpression(accountID)).
 		Offset(uint(offset)).
 		Limit(uint(limit)).
 		Executor().
@@ -162,7 +188,7 @@ func (d downloadTaskDataAccessor) GetDownloadTaskCountOfAccount(ctx context.Cont
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", accountID))
 	count, err := d.database.
 		From(TabNameDownloadTasks).
-		Where(goqu.Ex{ColNameDownloadTaskAccountID: accountID}).
+		Where(d.getDownloadTaskOfAccountExpression(accountID)).
 		CountContext(ctx)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get download task count of account")
@@ -172,6 +198,176 @@ func (d downloadTaskDataAccessor) GetDownloadTaskCountOfAccount(ctx context.Cont
 	return uint64(count), nil
 }
 
+// getDownloadTaskOfAccountExpression matches the download tasks owned by an account, leaving out
+// the ones it created on behalf of organizations.
+func (d downloadTaskDataAccessor) getDownloadTaskOfAccountExpression(accountID uint64) goqu.Ex {
+	return goqu.Ex{
+		ColNameDownloadTaskAccountID:      accountID,
+		ColNameDownloadTaskOrganizationID: nil,
+	}
+}
+
+func (d downloadTaskDataAccessor) getDownloadTaskUsage(ctx context.Context, expression goqu.Ex) (DownloadTaskUsage, error) {
+	downloadTaskUsage := DownloadTaskUsage{}
+	_, err := d.database.
+		Select(
+			goqu.COUNT(goqu.Star()).As("download_task_count"),
+			goqu.COALESCE(goqu.SUM(goqu.C(ColNameDownloadTaskFileSize)), 0).As("total_file_size"),
+		).
+		From(TabNameDownloadTasks).
+		Where(expression).
+		ScanStructContext(ctx, &downloadTaskUsage)
+	return downloadTaskUsage, err
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskUsageOfAccount(ctx context.Context, accountID uint64) (DownloadTaskUsage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", accountID))
+
+	downloadTaskUsage, err := d.getDownloadTaskUsage(ctx, d.getDownloadTaskOfAccountExpression(accountID))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task usage of account")
+		return DownloadTaskUsage{}, status.Error(codes.Internal, "failed to get download task usage of account")
+	}
+
+	return downloadTaskUsage, nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskListOfOrganization(
+	ctx context.Context,
+	organizationID uint64,
+	offset uint64,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("organization_id", organizationID)).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	downloadTaskList := make([]DownloadTask, 0)
+	err := d.database.
+		Select().
+		From(TabNameDownloadTasks).
+		Where(goqu.Ex{ColNameDownloadTaskOrganizationID: organizationID}).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list of organization")
+		return nil, status.Error(codes.Internal, "failed to get download task list of organization")
+	}
+
+	return downloadTaskList, nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskCountOfOrganization(ctx context.Context, organizationID uint64) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("organization_id", organizationID))
+
+	count, err := d.database.
+		From(TabNameDownloadTasks).
+		Where(goqu.Ex{ColNameDownloadTaskOrganizationID: organizationID}).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task count of organization")
+		return 0, status.Error(codes.Internal, "failed to get download task count of organization")
+	}
+
+	return uint64(count), nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskUsageOfOrganization(
+	ctx context.Context,
+	organizationID uint64,
+) (DownloadTaskUsage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("organization_id", organizationID))
+
+	downloadTaskUsage, err := d.getDownloadTaskUsage(ctx, goqu.Ex{ColNameDownloadTaskOrganizationID: organizationID})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task usage of organization")
+		return DownloadTaskUsage{}, status.Error(codes.Internal, "failed to get download task usage of organization")
+	}
+
+	return downloadTaskUsage, nil
+}
+
+func (d downloadTaskDataAccessor) getDownloadTaskFilterExpression(filter DownloadTaskFilter) goqu.Ex {
+	expression := goqu.Ex{}
+	if filter.AccountID != 0 {
+		expression[ColNameDownloadTaskAccountID] = filter.AccountID
+	}
+
+	if filter.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED {
+		expression[ColNameDownloadTaskDownloadStatus] = filter.DownloadStatus
+	}
+
+	return expression
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskList(
+	ctx context.Context,
+	filter DownloadTaskFilter,
+	offset uint64,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("filter", filter)).
+		With(zap.Uint64("offset", offset)).
+		With(zap.Uint64("limit", limit))
+
+	downloadTaskList := make([]DownloadTask, 0)
+	err := d.database.
+		Select().
+		From(TabNameDownloadTasks).
+		Where(d.getDownloadTaskFilterExpression(filter)).
+		Order(goqu.C(ColNameDownloadTaskID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list")
+		return nil, status.Error(codes.Internal, "failed to get download task list")
+	}
+
+	return downloadTaskList, nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskCount(ctx context.Context, filter DownloadTaskFilter) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("filter", filter))
+
+	count, err := d.database.
+		From(TabNameDownloadTasks).
+		Where(d.getDownloadTaskFilterExpression(filter)).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task count")
+		return 0, status.Error(codes.Internal, "failed to get download task count")
+	}
+
+	return uint64(count), nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskStatusCountList(ctx context.Context) ([]DownloadTaskStatusCount, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	downloadTaskStatusCountList := make([]DownloadTaskStatusCount, 0)
+	err := d.database.
+		Select(
+			goqu.C(ColNameDownloadTaskDownloadStatus),
+			goqu.COUNT(goqu.Star()).As("count"),
+		).
+		From(TabNameDownloadTasks).
+		GroupBy(goqu.C(ColNameDownloadTaskDownloadStatus)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskStatusCountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task status count list")
+		return nil, status.Error(codes.Internal, "failed to get download task status count list")
+	}
+
+	return downloadTaskStatusCountList, nil
+}
+
 func (d downloadTaskDataAccessor) GetDownloadTaskWithXLock(ctx context.Context, id uint64) (DownloadTask, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 
@@ -216,6 +412,31 @@ func (d downloadTaskDataAccessor) GetPendingDownloadTaskIDList(ctx context.Conte
 	return downloadTaskIDList, nil
 }
 
+func (d downloadTaskDataAccessor) GetSuccessfulDownloadTaskIDList(ctx context.Context, afterID, limit uint64) ([]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("after_id", afterID)).
+		With(zap.Uint64("limit", limit))
+
+	downloadTaskIDList := make([]uint64, 0)
+	err := d.database.
+		Select(ColNameDownloadTaskID).
+		From(TabNameDownloadTasks).
+		Where(
+			goqu.C(ColNameDownloadTaskDownloadStatus).Eq(morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS),
+			goqu.C(ColNameDownloadTaskID).Gt(afterID),
+		).
+		Order(goqu.C(ColNameDownloadTaskID).Asc()).
+		Limit(uint(limit)).
+		ScanValsContext(ctx, &downloadTaskIDList)
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get successful download task id list")
+		return nil, status.Error(codes.Internal, "failed to get successful download task id list")
+	}
+
+	return downloadTaskIDList, nil
+}
+
 func (d downloadTaskDataAccessor) UpdateDownloadingAndFailedDownloadTaskStatusToPending(ctx context.Context) error {
 	logger := utils.LoggerWithContext(ctx, d.logger)
 
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doug-martin/goqu/v9"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"go.uber.org/zap"
+)
+
+func TestDownloadTaskRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	goquDatabase := newTestDatabase(t)
+	downloadTaskDataAccessor := NewDownloadTaskDataAccessor(goquDatabase, zap.NewNop())
+
+	accountID, err := NewAccountDataAccessor(goquDatabase, zap.NewNop()).CreateAccount(ctx, Account{AccountName: "user"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	id, err := downloadTaskDataAccessor.CreateDownloadTask(ctx, DownloadTask{
+		AccountID:      accountID,
+		DownloadType:   morgana.DownloadType_DOWNLOAD_TYPE_HTTP,
+		URL:            "https://example.com/file",
+		DownloadStatus: morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		Metadata:       JSON{Data: map[string]any{"file_name": "file"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create download task: %v", err)
+	}
+
+	pendingDownloadTaskIDList, err := downloadTaskDataAccessor.GetPendingDownloadTaskIDList(ctx)
+	if err != nil || len(pendingDownloadTaskIDList) != 1 || pendingDownloadTaskIDList[0] != id {
+		t.Fatalf("expected download task %d to be pending, got %v, %v", id, pendingDownloadTaskIDList, err)
+	}
+
+	// Download tasks are updated in transactions that lock them first.
+	err = goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		downloadTask.DownloadStatus = morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS
+		downloadTask.FileSize = 1234
+		return downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+	})
+	if err != nil {
+		t.Fatalf("failed to update download task: %v", err)
+	}
+
+	downloadTaskList, err := downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, accountID, 0, 10)
+	if err != nil || len(downloadTaskList) != 1 {
+		t.Fatalf("expected 1 download task, got %+v, %v", downloadTaskList, err)
+	}
+
+	downloadTask := downloadTaskList[0]
+	metadata, _ := downloadTask.Metadata.Data.(map[string]any)
+	if downloadTask.ID != id ||
+		downloadTask.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS ||
+		downloadTask.FileSize != 1234 ||
+		metadata["file_name"] != "file" {
+		t.Fatalf("unexpected download task %+v", downloadTask)
+	}
+
+	usage, err := downloadTaskDataAccessor.GetDownloadTaskUsageOfAccount(ctx, accountID)
+	if err != nil || usage.DownloadTaskCount != 1 || usage.TotalFileSize != 1234 {
+		t.Fatalf("unexpected download task usage %+v, %v", usage, err)
+	}
+
+	if err = downloadTaskDataAccessor.DeleteDownloadTask(ctx, id); err != nil {
+		t.Fatalf("failed to delete download task: %v", err)
+	}
+
+	downloadTaskCount, err := downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, accountID)
+	if err != nil || downloadTaskCount != 0 {
+		t.Fatalf("expected no download task left, got %d, %v", downloadTaskCount, err)
+	}
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameLeaderLeases = goqu.T("leader_leases")
+
+	ErrLeaderLeaseNotFound = status.Error(codes.NotFound, "leader lease not found")
+)
+
+const (
+	ColNameLeaderLeasesName      = "name"
+	ColNameLeaderLeasesHolderID  = "holder_id"
+	ColNameLeaderLeasesExpiresAt = "expires_at"
+)
+
+// LeaderLease grants the replica identified by HolderID the leadership named Name until ExpiresAt.
+// The rows of all leaderships are created by migrations, with an empty HolderID until first
+// acquired.
+type LeaderLease struct {
+	Name      string    `db:"name" goqu:"skipupdate"`
+	HolderID  string    `db:"holder_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+type LeaderLeaseDataAccessor interface {
+	GetLeaderLeaseWithXLock(ctx context.Context, name string) (LeaderLease, error)
+	UpdateLeaderLease(ctx context.Context, leaderLease LeaderLease) error
+	WithDatabase(database Database) LeaderLeaseDataAccessor
+}
+
+type leaderLeaseDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewLeaderLeaseDataAccessor(database *goqu.Database, logger *zap.Logger) LeaderLeaseDataAccessor {
+	return &leaderLeaseDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a leaderLeaseDataAccessor) GetLeaderLeaseWithXLock(ctx context.Context, name string) (LeaderLease, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("name", name))
+
+	leaderLease := LeaderLease{}
+	found, err := a.database.
+		Select().
+		From(TabNameLeaderLeases).
+		Where(goqu.Ex{ColNameLeaderLeasesName: name}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &leaderLease)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get leader lease with x lock")
+		return LeaderLease{}, status.Error(codes.Internal, "failed to get leader lease with x lock")
+	}
+
+	if !found {
+		logger.Error("leader lease not found")
+		return LeaderLease{}, ErrLeaderLeaseNotFound
+	}
+
+	return leaderLease, nil
+}
+
+func (a leaderLeaseDataAccessor) UpdateLeaderLease(ctx context.Context, leaderLease LeaderLease) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("name", leaderLease.Name))
+
+	_, err := a.database.
+		Update(TabNameLeaderLeases).
+		Set(leaderLease).
+		Where(goqu.Ex{ColNameLeaderLeasesName: leaderLease.Name}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update leader lease")
+		return status.Error(codes.Internal, "failed to update leader lease")
+	}
+
+	return nil
+}
+
+func (a leaderLeaseDataAccessor) WithDatabase(database Database) LeaderLeaseDataAccessor {
+	return &leaderLeaseDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,330 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/metrics"
+)
+
+const (
+	statementOperationOther = "other"
+)
+
+var (
+	// Statements built by goqu name their table right after one of these keywords.
+	statementTableRegexp = regexp.MustCompile("(?is)^\\s*(?:select\\b.*?\\bfrom|insert\\s+(?:(?:or\\s+)?ignore\\s+)?into|update|delete\\s+from)\\s+[`\"]?(\\w+)")
+
+	statementOperationSet = map[string]struct{}{
+		"select": {},
+		"insert": {},
+		"update": {},
+		"delete": {},
+	}
+)
+
+// getStatementLabels returns the operation and the table of a statement, which are the labels its
+// duration is recorded with. Anything else about the statement would make the labels unbounded.
+func getStatementLabels(query string) (string, string) {
+	operation := statementOperationOther
+	if fieldList := strings.Fields(query); len(fieldList) > 0 {
+		if _, ok := statementOperationSet[strings.ToLower(fieldList[0])]; ok {
+			operation = strings.ToLower(fieldList[0])
+		}
+	}
+
+	table := ""
+	if operation != statementOperationOther {
+		if match := statementTableRegexp.FindStringSubmatch(query); match != nil {
+			table = match[1]
+		}
+	}
+
+	return operation, table
+}
+
+func observeStatementDuration(query string, startTime time.Time, err error) {
+	// The driver asks database/sql to fall back to a prepared statement, which is recorded instead.
+	if errors.Is(err, driver.ErrSkip) {
+		return
+	}
+
+	operation, table := getStatementLabels(query)
+	metrics.DatabaseQueryDurationSeconds.WithLabelValues(operation, table).Observe(time.Since(startTime).Seconds())
+}
+
+// metricsDriver records the duration of every statement run through the connections it opens, so
+// that data accessors do not have to record their own.
+type metricsDriver struct {
+	driver driver.Driver
+}
+
+func newMetricsDriver(driverName string) (driver.Driver, error) {
+	// database/sql only exposes registered drivers through a database handle, which does not
+	// connect until it is used.
+	db, err := sql.Open(driverName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	defer db.Close()
+	return metricsDriver{driver: db.Driver()}, nil
+}
+
+func (d metricsDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsConn{conn: conn}, nil
+}
+
+func (d metricsDriver) OpenConnector(name string) (driver.Connector, error) {
+	if driverContext, ok := d.driver.(driver.DriverContext); ok {
+		connector, err := driverContext.OpenConnector(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return metricsConnector{connector: connector, driver: d}, nil
+	}
+
+	return metricsConnector{connector: dsnConnector{name: name, driver: d.driver}, driver: d}, nil
+}
+
+// dsnConnector opens connections of drivers that do not have connectors of their own.
+type dsnConnector struct {
+	name   string
+	driver driver.Driver
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.name)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type metricsConnector struct {
+	connector driver.Connector
+	driver    metricsDriver
+}
+
+func (c metricsConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsConn{conn: conn}, nil
+}
+
+func (c metricsConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// metricsConn keeps every optional interface of the connection it wraps available, by falling back
+// to what database/sql does when a connection does not implement one.
+type metricsConn struct {
+	conn driver.Conn
+}
+
+func (c metricsConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c metricsConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var (
+		stmt driver.Stmt
+		err  error
+	)
+	if connPrepareContext, ok := c.conn.(driver.ConnPrepareContext); ok {
+		stmt, err = connPrepareContext.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return metricsStmt{stmt: stmt, query: query}, nil
+}
+
+func (c metricsConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c metricsConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c metricsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if connBeginTx, ok := c.conn.(driver.ConnBeginTx); ok {
+		return connBeginTx.BeginTx(ctx, opts)
+	}
+
+	//nolint:staticcheck // Only used by drivers that do not support BeginTx.
+	return c.conn.Begin()
+}
+
+func (c metricsConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execerContext, ok := c.conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	startTime := time.Now()
+	result, err := execerContext.ExecContext(ctx, query, args)
+	observeStatementDuration(query, startTime, err)
+	return result, err
+}
+
+func (c metricsConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryerContext, ok := c.conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	startTime := time.Now()
+	rows, err := queryerContext.QueryContext(ctx, query, args)
+	observeStatementDuration(query, startTime, err)
+	return rows, err
+}
+
+func (c metricsConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+
+	return nil
+}
+
+func (c metricsConn) ResetSession(ctx context.Context) error {
+	if sessionResetter, ok := c.conn.(driver.SessionResetter); ok {
+		return sessionResetter.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+func (c metricsConn) IsValid() bool {
+	if validator, ok := c.conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+
+	return true
+}
+
+func (c metricsConn) CheckNamedValue(namedValue *driver.NamedValue) error {
+	if namedValueChecker, ok := c.conn.(driver.NamedValueChecker); ok {
+		return namedValueChecker.CheckNamedValue(namedValue)
+	}
+
+	return driver.ErrSkip
+}
+
+type metricsStmt struct {
+	stmt  driver.Stmt
+	query string
+}
+
+func (s metricsStmt) Close() error {
+	return s.stmt.Close()
+}
+
+func (s metricsStmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+//nolint:staticcheck // Required by driver.Stmt, database/sql calls ExecContext instead.
+func (s metricsStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.stmt.Exec(args)
+}
+
+//nolint:staticcheck // Required by driver.Stmt, database/sql calls QueryContext instead.
+func (s metricsStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.stmt.Query(args)
+}
+
+func (s metricsStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	startTime := time.Now()
+	var (
+		result driver.Result
+		err    error
+	)
+	if stmtExecContext, ok := s.stmt.(driver.StmtExecContext); ok {
+		result, err = stmtExecContext.ExecContext(ctx, args)
+	} else {
+		var valueList []driver.Value
+		if valueList, err = namedValueListToValueList(args); err == nil {
+			//nolint:staticcheck // Only used by drivers that do not support ExecContext.
+			result, err = s.stmt.Exec(valueList)
+		}
+	}
+
+	observeStatementDuration(s.query, startTime, err)
+	return result, err
+}
+
+func (s metricsStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	startTime := time.Now()
+	var (
+		rows driver.Rows
+		err  error
+	)
+	if stmtQueryContext, ok := s.stmt.(driver.StmtQueryContext); ok {
+		rows, err = stmtQueryContext.QueryContext(ctx, args)
+	} else {
+		var valueList []driver.Value
+		if valueList, err = namedValueListToValueList(args); err == nil {
+			//nolint:staticcheck // Only used by drivers that do not support QueryContext.
+			rows, err = s.stmt.Query(valueList)
+		}
+	}
+
+	observeStatementDuration(s.query, startTime, err)
+	return rows, err
+}
+
+func (s metricsStmt) CheckNamedValue(namedValue *driver.NamedValue) error {
+	if namedValueChecker, ok := s.stmt.(driver.NamedValueChecker); ok {
+		return namedValueChecker.CheckNamedValue(namedValue)
+	}
+
+	return driver.ErrSkip
+}
+
+func namedValueListToValueList(namedValueList []driver.NamedValue) ([]driver.Value, error) {
+	valueList := make([]driver.Value, len(namedValueList))
+	for i, namedValue := range namedValueList {
+		if namedValue.Name != "" {
+			return nil, errors.New("driver does not support named parameters")
+		}
+
+		valueList[i] = namedValue.Value
+	}
+
+	return valueList, nil
+}
+
+var (
+	_ driver.DriverContext      = metricsDriver{}
+	_ driver.ConnPrepareContext = metricsConn{}
+	_ driver.ConnBeginTx        = metricsConn{}
+	_ driver.ExecerContext      = metricsConn{}
+	_ driver.QueryerContext     = metricsConn{}
+	_ driver.Pinger             = metricsConn{}
+	_ driver.SessionResetter    = metricsConn{}
+	_ driver.Validator          = metricsConn{}
+	_ driver.NamedValueChecker  = metricsConn{}
+	_ driver.StmtExecContext    = metricsStmt{}
+	_ driver.StmtQueryContext   = metricsStmt{}
+	_ driver.NamedValueChecker  = metricsStmt{}
+)
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS blobs (
+    sha256 CHAR(64) PRIMARY KEY,
+    size BIGINT UNSIGNED NOT NULL,
+    reference_count BIGINT UNSIGNED NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS blob_references (
+    file_path VARCHAR(256) PRIMARY KEY,
+    blob_sha256 CHAR(64) NOT NULL,
+    FOREIGN KEY (blob_sha256) REFERENCES blobs(sha256)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS blob_references;
+DROP TABLE IF EXISTS blobs;
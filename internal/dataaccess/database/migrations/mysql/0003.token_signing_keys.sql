@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE token_public_keys
+    ADD COLUMN encrypted_private_key VARBINARY(4096) NULL,
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN activated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX token_public_keys_activated_at_idx ON token_public_keys (activated_at);
+
+-- +migrate Down
+DROP INDEX token_public_keys_activated_at_idx ON token_public_keys;
+
+ALTER TABLE token_public_keys
+    DROP COLUMN activated_at,
+    DROP COLUMN created_at,
+    DROP COLUMN encrypted_private_key;
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(256) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    secret_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL,
+    UNIQUE KEY api_keys_secret_hash_idx (secret_hash),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,32 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS organizations (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    organization_name VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY organizations_organization_name_idx (organization_name)
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id BIGINT UNSIGNED NOT NULL,
+    account_id BIGINT UNSIGNED NOT NULL,
+    role SMALLINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, account_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+ALTER TABLE download_tasks
+    ADD COLUMN organization_id BIGINT UNSIGNED NULL,
+    ADD COLUMN file_size BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    ADD CONSTRAINT download_tasks_organization_id_fk FOREIGN KEY (organization_id) REFERENCES organizations(id);
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP FOREIGN KEY download_tasks_organization_id_fk,
+    DROP COLUMN file_size,
+    DROP COLUMN organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+
+DROP TABLE IF EXISTS organizations;
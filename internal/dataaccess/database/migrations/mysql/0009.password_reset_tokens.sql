@@ -0,0 +1,18 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN email VARCHAR(256) NULL;
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_tokens;
+
+ALTER TABLE accounts
+    DROP COLUMN email;
@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_two_factors (
+    account_id BIGINT UNSIGNED PRIMARY KEY,
+    encrypted_secret VARBINARY(256) NOT NULL,
+    enabled_at DATETIME NULL,
+    last_used_time_step BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+CREATE TABLE IF NOT EXISTS account_two_factor_recovery_codes (
+    code_hash CHAR(64) PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at DATETIME NULL,
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_two_factor_recovery_codes;
+
+DROP TABLE IF EXISTS account_two_factors;
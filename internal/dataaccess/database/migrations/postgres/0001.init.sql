@@ -0,0 +1,30 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS accounts (
+    id BIGSERIAL PRIMARY KEY,
+    account_name VARCHAR(256)
+);
+
+CREATE TABLE IF NOT EXISTS account_passwords (
+    account_id BIGINT PRIMARY KEY REFERENCES accounts(id),
+    hash VARCHAR(128)
+);
+
+CREATE TABLE IF NOT EXISTS token_public_keys (
+    id BIGSERIAL PRIMARY KEY,
+    public_key TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS download_tasks (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT REFERENCES accounts(id),
+    download_type SMALLINT NOT NULL,
+    url TEXT NOT NULL,
+    download_status SMALLINT NOT NULL,
+    metadata JSONB NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS download_tasks;
+DROP TABLE IF EXISTS token_public_keys;
+DROP TABLE IF EXISTS account_passwords;
+DROP TABLE IF EXISTS accounts;
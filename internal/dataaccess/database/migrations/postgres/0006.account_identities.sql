@@ -0,0 +1,12 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_identities (
+    id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    issuer VARCHAR(512) NOT NULL,
+    subject VARCHAR(256) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT account_identities_issuer_subject_idx UNIQUE (issuer, subject)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_identities;
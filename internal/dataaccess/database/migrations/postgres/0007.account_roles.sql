@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN role SMALLINT NOT NULL DEFAULT 1,
+    ADD COLUMN disabled_at TIMESTAMPTZ NULL;
+
+CREATE INDEX download_tasks_download_status_idx ON download_tasks (download_status);
+
+-- +migrate Down
+DROP INDEX IF EXISTS download_tasks_download_status_idx;
+
+ALTER TABLE accounts
+    DROP COLUMN disabled_at,
+    DROP COLUMN role;
@@ -0,0 +1,30 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS organizations (
+    id BIGSERIAL PRIMARY KEY,
+    organization_name VARCHAR(256) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT organizations_organization_name_idx UNIQUE (organization_name)
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id BIGINT NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    role SMALLINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, account_id)
+);
+
+ALTER TABLE download_tasks
+    ADD COLUMN organization_id BIGINT NULL,
+    ADD COLUMN file_size BIGINT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT download_tasks_organization_id_fk FOREIGN KEY (organization_id) REFERENCES organizations(id);
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP CONSTRAINT download_tasks_organization_id_fk,
+    DROP COLUMN file_size,
+    DROP COLUMN organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN email VARCHAR(256) NULL;
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_tokens;
+
+ALTER TABLE accounts
+    DROP COLUMN email;
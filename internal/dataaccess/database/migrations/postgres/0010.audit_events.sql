@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type SMALLINT NOT NULL,
+    account_name VARCHAR(256) NOT NULL DEFAULT '',
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    actor_account_id BIGINT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_events;
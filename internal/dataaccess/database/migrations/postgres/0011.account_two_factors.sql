@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_two_factors (
+    account_id BIGINT PRIMARY KEY REFERENCES accounts(id),
+    encrypted_secret BYTEA NOT NULL,
+    enabled_at TIMESTAMPTZ NULL,
+    last_used_time_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS account_two_factor_recovery_codes (
+    code_hash CHAR(64) PRIMARY KEY,
+    account_id BIGINT NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    used_at TIMESTAMPTZ NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_two_factor_recovery_codes;
+
+DROP TABLE IF EXISTS account_two_factors;
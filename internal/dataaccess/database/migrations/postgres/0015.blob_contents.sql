@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE blobs
+    ADD COLUMN content_sha256 CHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN codec VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN master_key_id VARCHAR(256) NOT NULL DEFAULT '',
+    ADD COLUMN data_key VARCHAR(256) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE blobs
+    DROP COLUMN data_key,
+    DROP COLUMN master_key_id,
+    DROP COLUMN codec,
+    DROP COLUMN content_sha256;
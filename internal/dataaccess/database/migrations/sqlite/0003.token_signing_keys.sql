@@ -0,0 +1,27 @@
+-- +migrate Up
+-- SQLite cannot add columns defaulting to CURRENT_TIMESTAMP, so the table is rebuilt instead.
+CREATE TABLE token_public_keys_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    public_key TEXT NOT NULL,
+    encrypted_private_key BLOB NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    activated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO token_public_keys_new (id, public_key)
+    SELECT id, public_key FROM token_public_keys;
+
+DROP TABLE token_public_keys;
+
+ALTER TABLE token_public_keys_new RENAME TO token_public_keys;
+
+CREATE INDEX token_public_keys_activated_at_idx ON token_public_keys (activated_at);
+
+-- +migrate Down
+DROP INDEX IF EXISTS token_public_keys_activated_at_idx;
+
+ALTER TABLE token_public_keys DROP COLUMN activated_at;
+
+ALTER TABLE token_public_keys DROP COLUMN created_at;
+
+ALTER TABLE token_public_keys DROP COLUMN encrypted_private_key;
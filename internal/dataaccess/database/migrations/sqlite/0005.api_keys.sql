@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS api_keys (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_id INTEGER NOT NULL REFERENCES accounts(id),
+    name VARCHAR(256) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    secret_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL
+);
+
+CREATE UNIQUE INDEX api_keys_secret_hash_idx ON api_keys (secret_hash);
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
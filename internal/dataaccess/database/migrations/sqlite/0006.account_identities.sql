@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_identities (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_id INTEGER NOT NULL REFERENCES accounts(id),
+    issuer VARCHAR(512) NOT NULL,
+    subject VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX account_identities_issuer_subject_idx ON account_identities (issuer, subject);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_identities;
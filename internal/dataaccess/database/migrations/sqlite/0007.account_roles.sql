@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN role SMALLINT NOT NULL DEFAULT 1;
+
+ALTER TABLE accounts ADD COLUMN disabled_at DATETIME NULL;
+
+CREATE INDEX download_tasks_download_status_idx ON download_tasks (download_status);
+
+-- +migrate Down
+DROP INDEX IF EXISTS download_tasks_download_status_idx;
+
+ALTER TABLE accounts DROP COLUMN disabled_at;
+
+ALTER TABLE accounts DROP COLUMN role;
@@ -0,0 +1,44 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS organizations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    organization_name VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX organizations_organization_name_idx ON organizations (organization_name);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id INTEGER NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id),
+    role SMALLINT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, account_id)
+);
+
+ALTER TABLE download_tasks ADD COLUMN organization_id INTEGER NULL REFERENCES organizations(id);
+
+ALTER TABLE download_tasks ADD COLUMN file_size BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+-- SQLite cannot drop columns referencing another table, so the table is rebuilt instead.
+CREATE TABLE download_tasks_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    account_id INTEGER REFERENCES accounts(id),
+    download_type SMALLINT NOT NULL,
+    url TEXT NOT NULL,
+    download_status SMALLINT NOT NULL,
+    metadata TEXT NOT NULL
+);
+
+INSERT INTO download_tasks_old (id, account_id, download_type, url, download_status, metadata)
+    SELECT id, account_id, download_type, url, download_status, metadata FROM download_tasks;
+
+DROP TABLE download_tasks;
+
+ALTER TABLE download_tasks_old RENAME TO download_tasks;
+
+CREATE INDEX download_tasks_download_status_idx ON download_tasks (download_status);
+
+DROP TABLE IF EXISTS organization_members;
+
+DROP TABLE IF EXISTS organizations;
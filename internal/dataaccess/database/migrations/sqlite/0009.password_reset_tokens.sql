@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN email VARCHAR(256) NULL;
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    token_hash CHAR(64) PRIMARY KEY,
+    account_id INTEGER NOT NULL REFERENCES accounts(id),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS password_reset_tokens;
+
+ALTER TABLE accounts DROP COLUMN email;
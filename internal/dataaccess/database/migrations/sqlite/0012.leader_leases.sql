@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS leader_leases (
+    name VARCHAR(64) PRIMARY KEY,
+    holder_id VARCHAR(256) NOT NULL,
+    expires_at DATETIME NOT NULL
+);
+
+INSERT INTO leader_leases (name, holder_id, expires_at) VALUES ('scheduler', '', '1970-01-01 00:00:00');
+
+-- +migrate Down
+DROP TABLE IF EXISTS leader_leases;
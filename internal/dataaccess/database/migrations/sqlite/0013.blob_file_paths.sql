@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE blobs ADD COLUMN file_path VARCHAR(256) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE blobs DROP COLUMN file_path;
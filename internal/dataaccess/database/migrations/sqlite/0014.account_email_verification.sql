@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN email_verified_at DATETIME NULL;
+
+CREATE UNIQUE INDEX accounts_account_name_idx ON accounts (account_name);
+
+CREATE INDEX accounts_email_idx ON accounts (email);
+
+-- +migrate Down
+DROP INDEX IF EXISTS accounts_email_idx;
+
+DROP INDEX IF EXISTS accounts_account_name_idx;
+
+ALTER TABLE accounts DROP COLUMN email_verified_at;
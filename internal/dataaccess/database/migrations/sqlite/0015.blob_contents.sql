@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE blobs ADD COLUMN content_sha256 CHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE blobs ADD COLUMN codec VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE blobs ADD COLUMN master_key_id VARCHAR(256) NOT NULL DEFAULT '';
+ALTER TABLE blobs ADD COLUMN data_key VARCHAR(256) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE blobs DROP COLUMN data_key;
+ALTER TABLE blobs DROP COLUMN master_key_id;
+ALTER TABLE blobs DROP COLUMN codec;
+ALTER TABLE blobs DROP COLUMN content_sha256;
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"embed"
 
+	"github.com/hoangdv99/morgana/internal/configs"
 	"github.com/hoangdv99/morgana/internal/utils"
 	migrate "github.com/rubenv/sql-migrate"
 	"go.uber.org/zap"
@@ -13,6 +14,10 @@ import (
 var (
 	//go:embed migrations/mysql/*
 	migrationDirectoryMySQL embed.FS
+	//go:embed migrations/postgres/*
+	migrationDirectoryPostgreSQL embed.FS
+	//go:embed migrations/sqlite/*
+	migrationDirectorySQLite embed.FS
 )
 
 type Migrator interface {
@@ -20,25 +25,57 @@ type Migrator interface {
 	Down(ctx context.Context) error
 }
 
+// migrator runs the migrations of the configured database type. Every database type has its own
+// migration directory, which have to be kept in step with each other.
 type migrator struct {
-	db     *sql.DB
-	logger *zap.Logger
+	db             *sql.DB
+	databaseConfig configs.Database
+	logger         *zap.Logger
 }
 
-func NewMigrator(db *sql.DB, logger *zap.Logger) Migrator {
+func NewMigrator(db *sql.DB, databaseConfig configs.Database, logger *zap.Logger) Migrator {
 	return &migrator{
-		db:     db,
-		logger: logger,
+		db:             db,
+		databaseConfig: databaseConfig,
+		logger:         logger,
+	}
+}
+
+func (m migrator) getDialectAndMigrationSource() (string, migrate.MigrationSource, error) {
+	databaseType, err := getDatabaseType(m.databaseConfig)
+	if err != nil {
+		return "", nil, err
+	}
+
+	switch databaseType {
+	case configs.DatabaseTypePostgreSQL:
+		return "postgres", migrate.EmbedFileSystemMigrationSource{
+			FileSystem: migrationDirectoryPostgreSQL,
+			Root:       "migrations/postgres",
+		}, nil
+	case configs.DatabaseTypeSQLite:
+		return "sqlite3", migrate.EmbedFileSystemMigrationSource{
+			FileSystem: migrationDirectorySQLite,
+			Root:       "migrations/sqlite",
+		}, nil
+	default:
+		return "mysql", migrate.EmbedFileSystemMigrationSource{
+			FileSystem: migrationDirectoryMySQL,
+			Root:       "migrations/mysql",
+		}, nil
 	}
 }
 
 func (m migrator) migrate(ctx context.Context, direction migrate.MigrationDirection) error {
 	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.Int("direction", int(direction)))
 
-	migrationCount, err := migrate.ExecContext(ctx, m.db, "mysql", migrate.EmbedFileSystemMigrationSource{
-		FileSystem: migrationDirectoryMySQL,
-		Root:       "migrations/mysql",
-	}, direction)
+	dialect, migrationSource, err := m.getDialectAndMigrationSource()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get migration source")
+		return err
+	}
+
+	migrationCount, err := migrate.ExecContext(ctx, m.db, dialect, migrationSource, direction)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to execute migrations")
 		return err
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameOrganizations = goqu.T("organizations")
+
+	ErrOrganizationNotFound = status.Error(codes.NotFound, "organization not found")
+)
+
+const (
+	ColNameOrganizationsID               = "id"
+	ColNameOrganizationsOrganizationName = "organization_name"
+)
+
+type Organization struct {
+	ID               uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OrganizationName string `db:"organization_name"`
+}
+
+type OrganizationDataAccessor interface {
+	CreateOrganization(ctx context.Context, organization Organization) (uint64, error)
+	GetOrganizationByID(ctx context.Context, id uint64) (Organization, error)
+	GetOrganizationByIDWithXLock(ctx context.Context, id uint64) (Organization, error)
+	GetOrganizationByOrganizationName(ctx context.Context, organizationName string) (Organization, error)
+	WithDatabase(database Database) OrganizationDataAccessor
+}
+
+type organizationDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewOrganizationDataAccessor(database *goqu.Database, logger *zap.Logger) OrganizationDataAccessor {
+	return &organizationDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (o organizationDataAccessor) CreateOrganization(ctx context.Context, organization Organization) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Any("organization", organization))
+
+	insertDataset := o.database.
+		Insert(TabNameOrganizations).
+		Rows(organization)
+	id, err := insertReturningID(ctx, o.database, insertDataset)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create organization")
+		return 0, status.Error(codes.Internal, "failed to create organization")
+	}
+
+	return id, nil
+}
+
+func (o organizationDataAccessor) GetOrganizationByID(ctx context.Context, id uint64) (Organization, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("id", id))
+
+	organization := Organization{}
+	found, err := o.database.
+		Select().
+		From(TabNameOrganizations).
+		Where(goqu.Ex{ColNameOrganizationsID: id}).
+		ScanStructContext(ctx, &organization)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization by id")
+		return Organization{}, status.Error(codes.Internal, "failed to get organization by id")
+	}
+
+	if !found {
+		return Organization{}, ErrOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+func (o organizationDataAccessor) GetOrganizationByIDWithXLock(ctx context.Context, id uint64) (Organization, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("id", id))
+
+	organization := Organization{}
+	found, err := o.database.
+		Select().
+		From(TabNameOrganizations).
+		Where(goqu.Ex{ColNameOrganizationsID: id}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &organization)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization by id with x lock")
+		return Organization{}, status.Error(codes.Internal, "failed to get organization by id with x lock")
+	}
+
+	if !found {
+		return Organization{}, ErrOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+func (o organizationDataAccessor) GetOrganizationByOrganizationName(ctx context.Context, organizationName string) (Organization, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("organization_name", organizationName))
+
+	organization := Organization{}
+	found, err := o.database.
+		Select().
+		From(TabNameOrganizations).
+		Where(goqu.Ex{ColNameOrganizationsOrganizationName: organizationName}).
+		ScanStructContext(ctx, &organization)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization by organization name")
+		return Organization{}, status.Error(codes.Internal, "failed to get organization by organization name")
+	}
+
+	if !found {
+		return Organization{}, ErrOrganizationNotFound
+	}
+
+	return organization, nil
+}
+
+func (o organizationDataAccessor) WithDatabase(database Database) OrganizationDataAccessor {
+	return &organizationDataAccessor{
+		database: database,
+		logger:   o.logger,
+	}
+}
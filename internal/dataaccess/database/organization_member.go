@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameOrganizationMembers = goqu.T("organization_members")
+
+	ErrOrganizationMemberNotFound = status.Error(codes.NotFound, "organization member not found")
+)
+
+const (
+	ColNameOrganizationMembersOrganizationID = "organization_id"
+	ColNameOrganizationMembersAccountID      = "account_id"
+	ColNameOrganizationMembersRole           = "role"
+)
+
+type OrganizationMember struct {
+	OrganizationID uint64                   `db:"organization_id" goqu:"skipupdate"`
+	AccountID      uint64                   `db:"account_id" goqu:"skipupdate"`
+	Role           morgana.OrganizationRole `db:"role"`
+}
+
+type OrganizationMemberDataAccessor interface {
+	CreateOrganizationMember(ctx context.Context, organizationMember OrganizationMember) error
+	GetOrganizationMember(ctx context.Context, organizationID, accountID uint64) (OrganizationMember, error)
+	GetOrganizationMemberListOfOrganization(ctx context.Context, organizationID uint64) ([]OrganizationMember, error)
+	GetOrganizationMemberListOfAccount(ctx context.Context, accountID uint64) ([]OrganizationMember, error)
+	UpdateOrganizationMember(ctx context.Context, organizationMember OrganizationMember) error
+	DeleteOrganizationMember(ctx context.Context, organizationID, accountID uint64) error
+	WithDatabase(database Database) OrganizationMemberDataAccessor
+}
+
+type organizationMemberDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewOrganizationMemberDataAccessor(database *goqu.Database, logger *zap.Logger) OrganizationMemberDataAccessor {
+	return &organizationMemberDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (o organizationMemberDataAccessor) CreateOrganizationMember(ctx context.Context, organizationMember OrganizationMember) error {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Any("organization_member", organizationMember))
+
+	_, err := o.database.
+		Insert(TabNameOrganizationMembers).
+		Rows(organizationMember).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create organization member")
+		return status.Error(codes.Internal, "failed to create organization member")
+	}
+
+	return nil
+}
+
+func (o organizationMemberDataAccessor) GetOrganizationMember(
+	ctx context.Context,
+	organizationID uint64,
+	accountID uint64,
+) (OrganizationMember, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).
+		With(zap.Uint64("organization_id", organizationID)).
+		With(zap.Uint64("account_id", accountID))
+
+	organizationMember := OrganizationMember{}
+	found, err := o.database.
+		Select().
+		From(TabNameOrganizationMembers).
+		Where(goqu.Ex{
+			ColNameOrganizationMembersOrganizationID: organizationID,
+			ColNameOrganizationMembersAccountID:      accountID,
+		}).
+		ScanStructContext(ctx, &organizationMember)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization member")
+		return OrganizationMember{}, status.Error(codes.Internal, "failed to get organization member")
+	}
+
+	if !found {
+		return OrganizationMember{}, ErrOrganizationMemberNotFound
+	}
+
+	return organizationMember, nil
+}
+
+func (o organizationMemberDataAccessor) GetOrganizationMemberListOfOrganization(
+	ctx context.Context,
+	organizationID uint64,
+) ([]OrganizationMember, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("organization_id", organizationID))
+
+	organizationMemberList := make([]OrganizationMember, 0)
+	err := o.database.
+		Select().
+		From(TabNameOrganizationMembers).
+		Where(goqu.Ex{ColNameOrganizationMembersOrganizationID: organizationID}).
+		Order(goqu.C(ColNameOrganizationMembersAccountID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &organizationMemberList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization member list of organization")
+		return nil, status.Error(codes.Internal, "failed to get organization member list of organization")
+	}
+
+	return organizationMemberList, nil
+}
+
+func (o organizationMemberDataAccessor) GetOrganizationMemberListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+) ([]OrganizationMember, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64("account_id", accountID))
+
+	organizationMemberList := make([]OrganizationMember, 0)
+	err := o.database.
+		Select().
+		From(TabNameOrganizationMembers).
+		Where(goqu.Ex{ColNameOrganizationMembersAccountID: accountID}).
+		Order(goqu.C(ColNameOrganizationMembersOrganizationID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &organizationMemberList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get organization member list of account")
+		return nil, status.Error(codes.Internal, "failed to get organization member list of account")
+	}
+
+	return organizationMemberList, nil
+}
+
+func (o organizationMemberDataAccessor) UpdateOrganizationMember(ctx context.Context, organizationMember OrganizationMember) error {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Any("organization_member", organizationMember))
+
+	_, err := o.database.
+		Update(TabNameOrganizationMembers).
+		Set(organizationMember).
+		Where(goqu.Ex{
+			ColNameOrganizationMembersOrganizationID: organizationMember.OrganizationID,
+			ColNameOrganizationMembersAccountID:      organizationMember.AccountID,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update organization member")
+		return status.Error(codes.Internal, "failed to update organization member")
+	}
+
+	return nil
+}
+
+func (o organizationMemberDataAccessor) DeleteOrganizationMember(ctx context.Context, organizationID, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, o.logger).
+		With(zap.Uint64("organization_id", organizationID)).
+		With(zap.Uint64("account_id", accountID))
+
+	_, err := o.database.
+		Delete(TabNameOrganizationMembers).
+		Where(goqu.Ex{
+			ColNameOrganizationMembersOrganizationID: organizationID,
+			ColNameOrganizationMembersAccountID:      accountID,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete organization member")
+		return status.Error(codes.Internal, "failed to delete organization member")
+	}
+
+	return nil
+}
+
+func (o organizationMemberDataAccessor) WithDatabase(database Database) OrganizationMemberDataAccessor {
+	return &organizationMemberDataAccessor{
+		database: database,
+		logger:   o.logger,
+	}
+}
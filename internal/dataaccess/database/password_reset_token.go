@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNamePasswordResetTokens = goqu.T("password_reset_tokens")
+
+	ErrPasswordResetTokenNotFound = status.Error(codes.NotFound, "password reset token not found")
+)
+
+const (
+	ColNamePasswordResetTokensTokenHash = "token_hash"
+	ColNamePasswordResetTokensAccountID = "account_id"
+	ColNamePasswordResetTokensExpiresAt = "expires_at"
+	ColNamePasswordResetTokensUsedAt    = "used_at"
+)
+
+// PasswordResetToken is a token sent to the email of an account to let it set a new password,
+// identified by the hex encoded sha256 of the token.
+type PasswordResetToken struct {
+	TokenHash string       `db:"token_hash" goqu:"skipupdate"`
+	AccountID uint64       `db:"account_id" goqu:"skipupdate"`
+	ExpiresAt time.Time    `db:"expires_at" goqu:"skipupdate"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+type PasswordResetTokenDataAccessor interface {
+	CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error
+	GetPasswordResetTokenWithXLock(ctx context.Context, tokenHash string) (PasswordResetToken, error)
+	UpdatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error
+	WithDatabase(database Database) PasswordResetTokenDataAccessor
+}
+
+type passwordResetTokenDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewPasswordResetTokenDataAccessor(database *goqu.Database, logger *zap.Logger) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (p passwordResetTokenDataAccessor) CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("account_id", passwordResetToken.AccountID))
+
+	_, err := p.database.
+		Insert(TabNamePasswordResetTokens).
+		Rows(passwordResetToken).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create password reset token")
+		return status.Error(codes.Internal, "failed to create password reset token")
+	}
+
+	return nil
+}
+
+func (p passwordResetTokenDataAccessor) GetPasswordResetTokenWithXLock(ctx context.Context, tokenHash string) (PasswordResetToken, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	passwordResetToken := PasswordResetToken{}
+	found, err := p.database.
+		Select().
+		From(TabNamePasswordResetTokens).
+		Where(goqu.Ex{ColNamePasswordResetTokensTokenHash: tokenHash}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &passwordResetToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get password reset token with x lock")
+		return PasswordResetToken{}, status.Error(codes.Internal, "failed to get password reset token with x lock")
+	}
+
+	if !found {
+		return PasswordResetToken{}, ErrPasswordResetTokenNotFound
+	}
+
+	return passwordResetToken, nil
+}
+
+func (p passwordResetTokenDataAccessor) UpdatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("account_id", passwordResetToken.AccountID))
+
+	_, err := p.database.
+		Update(TabNamePasswordResetTokens).
+		Set(passwordResetToken).
+		Where(goqu.Ex{ColNamePasswordResetTokensTokenHash: passwordResetToken.TokenHash}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update password reset token")
+		return status.Error(codes.Internal, "failed to update password reset token")
+	}
+
+	return nil
+}
+
+func (p passwordResetTokenDataAccessor) WithDatabase(database Database) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   p.logger,
+	}
+}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameRefreshTokens = goqu.T("refresh_tokens")
+
+	ErrRefreshTokenNotFound = status.Error(codes.NotFound, "refresh token not found")
+)
+
+const (
+	ColNameRefreshTokensTokenHash = "token_hash"
+	ColNameRefreshTokensSessionID = "session_id"
+	ColNameRefreshTokensUsedAt    = "used_at"
+)
+
+// RefreshToken is a refresh token issued for a session, identified by the hex encoded sha256 of
+// the token. Used tokens are kept until their session is deleted, so that their reuse can be
+// detected.
+type RefreshToken struct {
+	TokenHash string       `db:"token_hash" goqu:"skipupdate"`
+	SessionID uint64       `db:"session_id" goqu:"skipupdate"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+type RefreshTokenDataAccessor interface {
+	CreateRefreshToken(ctx context.Context, refreshToken RefreshToken) error
+	GetRefreshTokenWithXLock(ctx context.Context, tokenHash string) (RefreshToken, error)
+	UpdateRefreshToken(ctx context.Context, refreshToken RefreshToken) error
+	WithDatabase(database Database) RefreshTokenDataAccessor
+}
+
+type refreshTokenDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewRefreshTokenDataAccessor(database *goqu.Database, logger *zap.Logger) RefreshTokenDataAccessor {
+	return &refreshTokenDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (r refreshTokenDataAccessor) CreateRefreshToken(ctx context.Context, refreshToken RefreshToken) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", refreshToken.SessionID))
+
+	_, err := r.database.
+		Insert(TabNameRefreshTokens).
+		Rows(refreshToken).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create refresh token")
+		return status.Error(codes.Internal, "failed to create refresh token")
+	}
+
+	return nil
+}
+
+func (r refreshTokenDataAccessor) GetRefreshTokenWithXLock(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	refreshToken := RefreshToken{}
+	found, err := r.database.
+		Select().
+		From(TabNameRefreshTokens).
+		Where(goqu.Ex{ColNameRefreshTokensTokenHash: tokenHash}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &refreshToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get refresh token with x lock")
+		return RefreshToken{}, status.Error(codes.Internal, "failed to get refresh token with x lock")
+	}
+
+	if !found {
+		return RefreshToken{}, ErrRefreshTokenNotFound
+	}
+
+	return refreshToken, nil
+}
+
+func (r refreshTokenDataAccessor) UpdateRefreshToken(ctx context.Context, refreshToken RefreshToken) error {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Uint64("session_id", refreshToken.SessionID))
+
+	_, err := r.database.
+		Update(TabNameRefreshTokens).
+		Set(refreshToken).
+		Where(goqu.Ex{ColNameRefreshTokensTokenHash: refreshToken.TokenHash}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update refresh token")
+		return status.Error(codes.Internal, "failed to update refresh token")
+	}
+
+	return nil
+}
+
+func (r refreshTokenDataAccessor) WithDatabase(database Database) RefreshTokenDataAccessor {
+	return &refreshTokenDataAccessor{
+		database: database,
+		logger:   r.logger,
+	}
+}
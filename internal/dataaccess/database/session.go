@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameSessions = goqu.T("sessions")
+
+	ErrSessionNotFound = status.Error(codes.NotFound, "session not found")
+)
+
+const (
+	ColNameSessionsID          = "id"
+	ColNameSessionsAccountID   = "account_id"
+	ColNameSessionsCreatedAt   = "created_at"
+	ColNameSessionsRefreshedAt = "refreshed_at"
+	ColNameSessionsExpiresAt   = "expires_at"
+)
+
+type Session struct {
+	ID          uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountID   uint64    `db:"account_id" goqu:"skipupdate"`
+	CreatedAt   time.Time `db:"created_at" goqu:"skipinsert,skipupdate"`
+	RefreshedAt time.Time `db:"refreshed_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+type SessionDataAccessor interface {
+	CreateSession(ctx context.Context, session Session) (uint64, error)
+	GetSession(ctx context.Context, id uint64) (Session, error)
+	GetSessionWithXLock(ctx context.Context, id uint64) (Session, error)
+	GetUnexpiredSessionListOfAccount(ctx context.Context, accountID uint64) ([]Session, error)
+	UpdateSession(ctx context.Context, session Session) error
+	DeleteSession(ctx context.Context, id uint64) error
+	WithDatabase(database Database) SessionDataAccessor
+}
+
+type sessionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewSessionDataAccessor(database *goqu.Database, logger *zap.Logger) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (s sessionDataAccessor) CreateSession(ctx context.Context, session Session) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Any("session", session))
+
+	insertDataset := s.database.
+		Insert(TabNameSessions).
+		Rows(goqu.Record{
+			ColNameSessionsAccountID:   session.AccountID,
+			ColNameSessionsRefreshedAt: session.RefreshedAt,
+			ColNameSessionsExpiresAt:   session.ExpiresAt,
+		})
+	id, err := insertReturningID(ctx, s.database, insertDataset)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create session")
+		return 0, status.Error(codes.Internal, "failed to create session")
+	}
+
+	return id, nil
+}
+
+func (s sessionDataAccessor) GetSession(ctx context.Context, id uint64) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	session := Session{}
+	found, err := s.database.
+		Select().
+		From(TabNameSessions).
+		Where(goqu.Ex{ColNameSessionsID: id}).
+		ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session")
+		return Session{}, status.Error(codes.Internal, "failed to get session")
+	}
+
+	if !found {
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s sessionDataAccessor) GetSessionWithXLock(ctx context.Context, id uint64) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	session := Session{}
+	found, err := s.database.
+		Select().
+		From(TabNameSessions).
+		Where(goqu.Ex{ColNameSessionsID: id}).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session with x lock")
+		return Session{}, status.Error(codes.Internal, "failed to get session with x lock")
+	}
+
+	if !found {
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s sessionDataAccessor) GetUnexpiredSessionListOfAccount(ctx context.Context, accountID uint64) ([]Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	sessionList := make([]Session, 0)
+	err := s.database.
+		Select().
+		From(TabNameSessions).
+		Where(
+			goqu.C(ColNameSessionsAccountID).Eq(accountID),
+			goqu.C(ColNameSessionsExpiresAt).Gt(time.Now()),
+		).
+		Order(goqu.C(ColNameSessionsRefreshedAt).Desc()).
+		Executor().
+		ScanStructsContext(ctx, &sessionList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get unexpired session list of account")
+		return nil, status.Error(codes.Internal, "failed to get unexpired session list of account")
+	}
+
+	return sessionList, nil
+}
+
+func (s sessionDataAccessor) UpdateSession(ctx context.Context, session Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Any("session", session))
+
+	_, err := s.database.
+		Update(TabNameSessions).
+		Set(session).
+		Where(goqu.Ex{ColNameSessionsID: session.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update session")
+		return status.Error(codes.Internal, "failed to update session")
+	}
+
+	return nil
+}
+
+func (s sessionDataAccessor) DeleteSession(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	_, err := s.database.
+		Delete(TabNameSessions).
+		Where(goqu.Ex{ColNameSessionsID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete session")
+		return status.Error(codes.Internal, "failed to delete session")
+	}
+
+	return nil
+}
+
+func (s sessionDataAccessor) WithDatabase(database Database) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
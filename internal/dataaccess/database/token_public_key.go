@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/hoangdv99/morgana/internal/utils"
@@ -16,18 +17,29 @@ var (
 )
 
 const (
-	ColNameTokenPublicKeysID        = "id"
-	ColNameTokenPublicKeysPublicKey = "public_key"
+	ColNameTokenPublicKeysID                  = "id"
+	ColNameTokenPublicKeysPublicKey           = "public_key"
+	ColNameTokenPublicKeysEncryptedPrivateKey = "encrypted_private_key"
+	ColNameTokenPublicKeysCreatedAt           = "created_at"
+	ColNameTokenPublicKeysActivatedAt         = "activated_at"
 )
 
+// TokenPublicKey is a token signing key. EncryptedPrivateKey is empty for keys created before
+// signing keys were persisted, which can only be used to verify tokens.
 type TokenPublicKey struct {
-	ID        uint64 `db:"id"`
-	PublicKey string `db:"public_key" goqu:"skipinsert,skipupdate"`
+	ID                  uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	PublicKey           string    `db:"public_key" goqu:"skipupdate"`
+	EncryptedPrivateKey []byte    `db:"encrypted_private_key" goqu:"skipupdate"`
+	CreatedAt           time.Time `db:"created_at" goqu:"skipinsert,skipupdate"`
+	ActivatedAt         time.Time `db:"activated_at"`
 }
 
 type TokenPublicKeyDataAccessor interface {
 	CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error)
 	GetPublicKey(ctx context.Context, id uint64) (TokenPublicKey, error)
+	GetPublicKeyList(ctx context.Context) ([]TokenPublicKey, error)
+	GetPublicKeyListWithXLock(ctx context.Context) ([]TokenPublicKey, error)
+	DeletePublicKey(ctx context.Context, id uint64) error
 	WithDatabase(database Database) TokenPublicKeyDataAccessor
 }
 
@@ -48,25 +60,20 @@ func NewTokenPublicKeyDataAccessor(
 
 func (a tokenPublicKeyDataAccessor) CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger)
-	result, err := a.database.
+	insertDataset := a.database.
 		Insert(TabNameTokenPublicKeys).
 		Rows(goqu.Record{
-			ColNameTokenPublicKeysPublicKey: tokenPublicKey.PublicKey,
-		}).
-		Executor().
-		ExecContext(ctx)
+			ColNameTokenPublicKeysPublicKey:           tokenPublicKey.PublicKey,
+			ColNameTokenPublicKeysEncryptedPrivateKey: tokenPublicKey.EncryptedPrivateKey,
+			ColNameTokenPublicKeysActivatedAt:         tokenPublicKey.ActivatedAt,
+		})
+	id, err := insertReturningID(ctx, a.database, insertDataset)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create token public key")
 		return 0, status.Error(codes.Internal, "failed to create token public key")
 	}
 
-	lastInsertedID, err := result.LastInsertId()
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get last inserted id")
-		return 0, status.Error(codes.Internal, "failed to get last inserted id")
-	}
-
-	return uint64(lastInsertedID), nil
+	return id, nil
 }
 
 func (a tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64) (TokenPublicKey, error) {
@@ -91,6 +98,59 @@ func (a tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64)
 	return tokenPublicKey, nil
 }
 
+func (a tokenPublicKeyDataAccessor) GetPublicKeyList(ctx context.Context) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenPublicKeyList := make([]TokenPublicKey, 0)
+	err := a.database.
+		Select().
+		From(TabNameTokenPublicKeys).
+		Order(goqu.C(ColNameTokenPublicKeysActivatedAt).Asc(), goqu.C(ColNameTokenPublicKeysID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &tokenPublicKeyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get public key list")
+		return nil, status.Error(codes.Internal, "failed to get public key list")
+	}
+
+	return tokenPublicKeyList, nil
+}
+
+func (a tokenPublicKeyDataAccessor) GetPublicKeyListWithXLock(ctx context.Context) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenPublicKeyList := make([]TokenPublicKey, 0)
+	err := a.database.
+		Select().
+		From(TabNameTokenPublicKeys).
+		Order(goqu.C(ColNameTokenPublicKeysActivatedAt).Asc(), goqu.C(ColNameTokenPublicKeysID).Asc()).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructsContext(ctx, &tokenPublicKeyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get public key list with x lock")
+		return nil, status.Error(codes.Internal, "failed to get public key list with x lock")
+	}
+
+	return tokenPublicKeyList, nil
+}
+
+func (a tokenPublicKeyDataAccessor) DeletePublicKey(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	_, err := a.database.
+		Delete(TabNameTokenPublicKeys).
+		Where(goqu.Ex{ColNameTokenPublicKeysID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete public key")
+		return status.Error(codes.Internal, "failed to delete public key")
+	}
+
+	return nil
+}
+
 func (a tokenPublicKeyDataAccessor) WithDatabase(database Database) TokenPublicKeyDataAccessor {
 	a.database = database
 	return a
@@ -10,4 +10,17 @@ var WireSet = wire.NewSet(
 	NewAccountPasswordDataAccessor,
 	NewDownloadTaskDataAccessor,
 	NewTokenPublicKeyDataAccessor,
+	NewBlobDataAccessor,
+	NewBlobReferenceDataAccessor,
+	NewSessionDataAccessor,
+	NewRefreshTokenDataAccessor,
+	NewAPIKeyDataAccessor,
+	NewAccountIdentityDataAccessor,
+	NewOrganizationDataAccessor,
+	NewOrganizationMemberDataAccessor,
+	NewPasswordResetTokenDataAccessor,
+	NewAuditEventDataAccessor,
+	NewAccountTwoFactorDataAccessor,
+	NewAccountTwoFactorRecoveryCodeDataAccessor,
+	NewLeaderLeaseDataAccessor,
 )
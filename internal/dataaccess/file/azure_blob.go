@@ -0,0 +1,209 @@
+package file
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	azureBlobUploadBlockByteCount   = 4 * 1024 * 1024
+	azureBlobUploadConcurrency      = 4
+	azureBlobCopyStatusPollInterval = time.Second
+)
+
+// azureBlobClientWriteCloser streams the written data into a block blob upload through a pipe,
+// the same way s3ClientWriteCloser does.
+type azureBlobClientWriteCloser struct {
+	pipeWriter         *io.PipeWriter
+	uploadErrorChannel chan error
+}
+
+func newAzureBlobClientWriteCloser(
+	ctx context.Context,
+	azblobClient *azblob.Client,
+	logger *zap.Logger,
+	containerName,
+	blobName string,
+) io.WriteCloser {
+	logger = utils.LoggerWithContext(ctx, logger)
+	pipeReader, pipeWriter := io.Pipe()
+	writeCloser := &azureBlobClientWriteCloser{
+		pipeWriter:         pipeWriter,
+		uploadErrorChannel: make(chan error, 1),
+	}
+
+	go func() {
+		_, err := azblobClient.UploadStream(ctx, containerName, blobName, pipeReader, &azblob.UploadStreamOptions{
+			BlockSize:   azureBlobUploadBlockByteCount,
+			Concurrency: azureBlobUploadConcurrency,
+		})
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to upload azure blob")
+		}
+
+		pipeReader.CloseWithError(err)
+		writeCloser.uploadErrorChannel <- err
+	}()
+
+	return writeCloser
+}
+
+func (a *azureBlobClientWriteCloser) Close() error {
+	if err := a.pipeWriter.Close(); err != nil {
+		return err
+	}
+
+	if err := <-a.uploadErrorChannel; err != nil {
+		return status.Error(codes.Internal, "failed to upload azure blob")
+	}
+
+	return nil
+}
+
+func (a *azureBlobClientWriteCloser) Write(p []byte) (n int, err error) {
+	return a.pipeWriter.Write(p)
+}
+
+// AzureBlobClient stores files as block blobs in an Azure Blob Storage container. Address is the
+// blob service URL of the storage account, and Username and Password are its account name and key.
+type AzureBlobClient struct {
+	azblobClient *azblob.Client
+	container    string
+	logger       *zap.Logger
+}
+
+func NewAzureBlobClient(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (Client, error) {
+	return newAzureBlobClient(downloadConfig, logger)
+}
+
+func newAzureBlobClient(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (*AzureBlobClient, error) {
+	sharedKeyCredential, err := azblob.NewSharedKeyCredential(downloadConfig.Username, downloadConfig.Password)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create azure shared key credential")
+		return nil, err
+	}
+
+	azblobClient, err := azblob.NewClientWithSharedKeyCredential(downloadConfig.Address, sharedKeyCredential, nil)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create azure blob client")
+		return nil, err
+	}
+
+	return &AzureBlobClient{
+		azblobClient: azblobClient,
+		container:    downloadConfig.Bucket,
+		logger:       logger,
+	}, nil
+}
+
+func (a AzureBlobClient) getBlobClient(blobName string) *blob.Client {
+	return a.azblobClient.ServiceClient().NewContainerClient(a.container).NewBlobClient(blobName)
+}
+
+func (a AzureBlobClient) Read(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("file_path", filePath))
+
+	properties, err := a.getBlobClient(filePath).GetProperties(ctx, nil)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get azure blob properties")
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, status.Error(codes.NotFound, "azure blob not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get azure blob properties")
+	}
+
+	// Every range is conditioned on the ETag of the blob, so that a blob replaced in the middle of
+	// a read fails the read instead of returning a mix of both contents.
+	accessConditions := &blob.AccessConditions{
+		ModifiedAccessConditions: &blob.ModifiedAccessConditions{
+			IfMatch: properties.ETag,
+		},
+	}
+
+	return newRangedObjectReadCloser(ctx, *properties.ContentLength, func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+		response, err := a.azblobClient.DownloadStream(ctx, a.container, filePath, &azblob.DownloadStreamOptions{
+			Range: azblob.HTTPRange{
+				Offset: offset,
+				Count:  count,
+			},
+			AccessConditions: accessConditions,
+		})
+		if err != nil {
+			logger.With(zap.Int64("offset", offset)).With(zap.Error(err)).Error("failed to download azure blob range")
+			return nil, status.Error(codes.Internal, "failed to download azure blob range")
+		}
+
+		return response.Body, nil
+	}), nil
+}
+
+func (a AzureBlobClient) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
+	return newAzureBlobClientWriteCloser(ctx, a.azblobClient, a.logger, a.container, filePath), nil
+}
+
+func (a AzureBlobClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("file_path", filePath))
+
+	_, err := a.azblobClient.DeleteBlob(ctx, a.container, filePath, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		logger.With(zap.Error(err)).Error("failed to delete azure blob")
+		return status.Error(codes.Internal, "failed to delete azure blob")
+	}
+
+	return nil
+}
+
+func (a AzureBlobClient) move(ctx context.Context, sourceFilePath, destinationFilePath string) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("source_file_path", sourceFilePath)).
+		With(zap.String("destination_file_path", destinationFilePath))
+
+	destinationBlobClient := a.getBlobClient(destinationFilePath)
+	copyResponse, err := destinationBlobClient.StartCopyFromURL(ctx, a.getBlobClient(sourceFilePath).URL(), nil)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to start azure blob copy")
+		return status.Error(codes.Internal, "failed to start azure blob copy")
+	}
+
+	// Copies within a storage account usually complete synchronously, but the service is allowed
+	// to schedule them in the background.
+	copyStatus := copyResponse.CopyStatus
+	for copyStatus != nil && *copyStatus == blob.CopyStatusTypePending {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(azureBlobCopyStatusPollInterval):
+		}
+
+		properties, err := destinationBlobClient.GetProperties(ctx, nil)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to get azure blob copy status")
+			return status.Error(codes.Internal, "failed to get azure blob copy status")
+		}
+
+		copyStatus = properties.CopyStatus
+	}
+
+	if copyStatus != nil && *copyStatus != blob.CopyStatusTypeSuccess {
+		logger.With(zap.String("copy_status", string(*copyStatus))).Error("azure blob copy did not succeed")
+		return status.Error(codes.Internal, "failed to copy azure blob")
+	}
+
+	return a.Delete(ctx, sourceFilePath)
+}
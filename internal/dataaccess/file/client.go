@@ -9,7 +9,9 @@ import (
 	"os"
 	"path"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
 	"github.com/hoangdv99/morgana/internal/utils"
 	"github.com/minio/minio-go"
 	"go.uber.org/zap"
@@ -20,22 +22,56 @@ import (
 type Client interface {
 	Write(ctx context.Context, filePath string) (io.WriteCloser, error)
 	Read(ctx context.Context, filePath string) (io.ReadCloser, error)
+	Delete(ctx context.Context, filePath string) error
 }
 
-func NewClient(
+// storageClient is a Client that stores files directly in a storage backend, and can therefore
+// be used as the underlying storage of other Client implementations.
+type storageClient interface {
+	Client
+	move(ctx context.Context, sourceFilePath, destinationFilePath string) error
+}
+
+func newStorageClient(
 	downloadConfig configs.Download,
 	logger *zap.Logger,
-) (Client, error) {
+) (storageClient, error) {
 	switch downloadConfig.Mode {
 	case configs.DownloadModeLocal:
-		return NewLocalClient(downloadConfig, logger)
+		return newLocalClient(downloadConfig, logger)
 	case configs.DownloadModeS3:
-		return NewS3Client(downloadConfig, logger)
+		return newS3Client(downloadConfig, logger)
+	case configs.DownloadModeAzureBlob:
+		return newAzureBlobClient(downloadConfig, logger)
+	case configs.DownloadModeGCS:
+		return newGCSClient(downloadConfig, logger)
 	default:
 		return nil, fmt.Errorf("unsupported download mode: %s", downloadConfig.Mode)
 	}
 }
 
+func NewClient(
+	downloadConfig configs.Download,
+	goquDatabase *goqu.Database,
+	blobDataAccessor database.BlobDataAccessor,
+	blobReferenceDataAccessor database.BlobReferenceDataAccessor,
+	logger *zap.Logger,
+) (Client, error) {
+	storageClient, err := newStorageClient(downloadConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch downloadConfig.StorageLayout {
+	case configs.DownloadStorageLayoutPerTask, "":
+		return storageClient, nil
+	case configs.DownloadStorageLayoutContentAddressed:
+		return NewContentAddressedClient(storageClient, goquDatabase, blobDataAccessor, blobReferenceDataAccessor, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported download storage layout: %s", downloadConfig.StorageLayout)
+	}
+}
+
 type bufferedFileReader struct {
 	file           *os.File
 	bufferedReader io.Reader
@@ -62,6 +98,10 @@ type LocalClient struct {
 }
 
 func NewLocalClient(downloadConfig configs.Download, logger *zap.Logger) (Client, error) {
+	return newLocalClient(downloadConfig, logger)
+}
+
+func newLocalClient(downloadConfig configs.Download, logger *zap.Logger) (*LocalClient, error) {
 	err := os.Mkdir(downloadConfig.DownloadDirectory, os.ModeDir)
 	if err != nil {
 		if !errors.Is(err, os.ErrExist) {
@@ -101,56 +141,81 @@ func (l *LocalClient) Write(ctx context.Context, filePath string) (io.WriteClose
 	return file, nil
 }
 
-type s3ClientReadWriteCloser struct {
-	writtenData []byte
-	isClosed    bool
+func (l *LocalClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("file_path", filePath))
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	err := os.Remove(absolutePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.With(zap.Error(err)).Error("failed to delete file")
+		return status.Error(codes.Internal, "failed to delete file")
+	}
+
+	return nil
 }
 
-func newS3ClientReadWriteCloser(
+func (l *LocalClient) move(ctx context.Context, sourceFilePath, destinationFilePath string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("source_file_path", sourceFilePath)).
+		With(zap.String("destination_file_path", destinationFilePath))
+
+	err := os.Rename(path.Join(l.downloadDirectory, sourceFilePath), path.Join(l.downloadDirectory, destinationFilePath))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to move file")
+		return status.Error(codes.Internal, "failed to move file")
+	}
+
+	return nil
+}
+
+// s3ClientWriteCloser streams the written data into an object upload through a pipe. Close only
+// returns once the upload has finished, so the object is guaranteed to exist afterward.
+type s3ClientWriteCloser struct {
+	pipeWriter            *io.PipeWriter
+	putObjectErrorChannel chan error
+}
+
+func newS3ClientWriteCloser(
 	ctx context.Context,
 	minioClient *minio.Client,
 	logger *zap.Logger,
 	bucketName,
-	ObjectName string,
-) io.ReadWriteCloser {
+	objectName string,
+) io.WriteCloser {
 	logger = utils.LoggerWithContext(ctx, logger)
-	readWriteCloser := &s3ClientReadWriteCloser{
-		writtenData: make([]byte, 0),
-		isClosed:    false,
+	pipeReader, pipeWriter := io.Pipe()
+	writeCloser := &s3ClientWriteCloser{
+		pipeWriter:            pipeWriter,
+		putObjectErrorChannel: make(chan error, 1),
 	}
 
 	go func() {
-		_, err := minioClient.PutObjectWithContext(ctx, bucketName, ObjectName, readWriteCloser, -1, minio.PutObjectOptions{})
+		_, err := minioClient.PutObjectWithContext(ctx, bucketName, objectName, pipeReader, -1, minio.PutObjectOptions{})
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to put object")
 		}
-	}()
 
-	return readWriteCloser
-}
+		pipeReader.CloseWithError(err)
+		writeCloser.putObjectErrorChannel <- err
+	}()
 
-func (s *s3ClientReadWriteCloser) Close() error {
-	s.isClosed = true
-	return nil
+	return writeCloser
 }
 
-func (s *s3ClientReadWriteCloser) Read(p []byte) (n int, err error) {
-	if len(s.writtenData) > 0 {
-		writtenLength := copy(p, s.writtenData)
-		s.writtenData = s.writtenData[writtenLength:]
-		return writtenLength, nil
+func (s *s3ClientWriteCloser) Close() error {
+	if err := s.pipeWriter.Close(); err != nil {
+		return err
 	}
 
-	if s.isClosed {
-		return 0, io.EOF
+	if err := <-s.putObjectErrorChannel; err != nil {
+		return status.Error(codes.Internal, "failed to put s3 object")
 	}
 
-	return 0, nil
+	return nil
 }
 
-func (s *s3ClientReadWriteCloser) Write(p []byte) (n int, err error) {
-	s.writtenData = append(s.writtenData, p...)
-	return len(p), nil
+func (s *s3ClientWriteCloser) Write(p []byte) (n int, err error) {
+	return s.pipeWriter.Write(p)
 }
 
 type S3Client struct {
@@ -163,6 +228,13 @@ func NewS3Client(
 	downloadConfig configs.Download,
 	logger *zap.Logger,
 ) (Client, error) {
+	return newS3Client(downloadConfig, logger)
+}
+
+func newS3Client(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (*S3Client, error) {
 	minioClient, err := minio.New(downloadConfig.Address, downloadConfig.Username, downloadConfig.Password, false)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create minio client")
@@ -189,5 +261,41 @@ func (s S3Client) Read(ctx context.Context, filePath string) (io.ReadCloser, err
 }
 
 func (s S3Client) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
-	return newS3ClientReadWriteCloser(ctx, s.minioClient, s.logger, s.bucket, filePath), nil
+	return newS3ClientWriteCloser(ctx, s.minioClient, s.logger, s.bucket, filePath), nil
+}
+
+func (s S3Client) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("file_path", filePath))
+
+	err := s.minioClient.RemoveObject(s.bucket, filePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove s3 object")
+		return status.Error(codes.Internal, "failed to remove s3 object")
+	}
+
+	return nil
+}
+
+func (s S3Client) move(ctx context.Context, sourceFilePath, destinationFilePath string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).
+		With(zap.String("source_file_path", sourceFilePath)).
+		With(zap.String("destination_file_path", destinationFilePath))
+
+	destinationInfo, err := minio.NewDestinationInfo(s.bucket, destinationFilePath, nil, nil)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create s3 copy destination")
+		return status.Error(codes.Internal, "failed to create s3 copy destination")
+	}
+
+	// ComposeObject is used instead of CopyObject since it falls back to a multipart copy for
+	// objects larger than the single copy request limit.
+	err = s.minioClient.ComposeObject(destinationInfo, []minio.SourceInfo{
+		minio.NewSourceInfo(s.bucket, sourceFilePath, nil),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to copy s3 object")
+		return status.Error(codes.Internal, "failed to copy s3 object")
+	}
+
+	return s.Delete(ctx, sourceFilePath)
 }
@@ -0,0 +1,309 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/zstd"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Codec identifies how a stored file is compressed. Its values double as HTTP Content-Encoding
+// tokens.
+type Codec string
+
+const (
+	CodecNone Codec = "none"
+	CodecGzip Codec = "gzip"
+	CodecZstd Codec = "zstd"
+
+	compressionSniffByteCount = 512
+)
+
+// defaultSkippedMIMETypeList contains MIME types whose content is already compressed, so that
+// compressing it again would only cost CPU time. Entries ending with "/*" match a whole type.
+var defaultSkippedMIMETypeList = []string{
+	"application/zip",
+	"application/x-gzip",
+	"application/gzip",
+	"application/x-rar-compressed",
+	"application/zstd",
+	"application/x-xz",
+	"application/x-bzip2",
+	"application/x-7z-compressed",
+	"application/vnd.ms-fontobject",
+	"font/woff",
+	"font/woff2",
+	"image/*",
+	"audio/*",
+	"video/*",
+}
+
+// compressedFileMagicList complements http.DetectContentType, which does not recognize these
+// formats.
+var compressedFileMagicList = map[string][]byte{
+	"application/zstd":            {0x28, 0xB5, 0x2F, 0xFD},
+	"application/x-xz":            {0xFD, '7', 'z', 'X', 'Z', 0x00},
+	"application/x-bzip2":         {'B', 'Z', 'h'},
+	"application/x-7z-compressed": {'7', 'z', 0xBC, 0xAF, 0x27, 0x1C},
+}
+
+type CompressedWriteCloser interface {
+	io.WriteCloser
+	// Codec returns the codec the written content was stored with. It is only meaningful after
+	// Close has returned successfully.
+	Codec() Codec
+}
+
+type Compressor interface {
+	NewWriteCloser(writeCloser io.WriteCloser) CompressedWriteCloser
+	NewReadCloser(readCloser io.ReadCloser, codec Codec) (io.ReadCloser, error)
+}
+
+type compressor struct {
+	codec               configs.DownloadCompressionCodec
+	level               int
+	skippedMIMETypeList []string
+	logger              *zap.Logger
+}
+
+func NewCompressor(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (Compressor, error) {
+	compressionConfig := downloadConfig.Compression
+
+	switch compressionConfig.Codec {
+	case configs.DownloadCompressionCodecNone, "":
+	case configs.DownloadCompressionCodecGzip:
+		if _, err := gzip.NewWriterLevel(io.Discard, getGzipLevel(compressionConfig.Level)); err != nil {
+			return nil, fmt.Errorf("invalid gzip compression level: %w", err)
+		}
+	case configs.DownloadCompressionCodecZstd:
+	default:
+		return nil, fmt.Errorf("unsupported download compression codec: %s", compressionConfig.Codec)
+	}
+
+	skippedMIMETypeList := compressionConfig.SkippedMIMETypes
+	if skippedMIMETypeList == nil {
+		skippedMIMETypeList = defaultSkippedMIMETypeList
+	}
+
+	return &compressor{
+		codec:               compressionConfig.Codec,
+		level:               compressionConfig.Level,
+		skippedMIMETypeList: skippedMIMETypeList,
+		logger:              logger,
+	}, nil
+}
+
+func getGzipLevel(level int) int {
+	if level == 0 {
+		return gzip.DefaultCompression
+	}
+
+	return level
+}
+
+func getZstdEncoderLevel(level int) zstd.EncoderLevel {
+	if level == 0 {
+		return zstd.SpeedDefault
+	}
+
+	return zstd.EncoderLevelFromZstd(level)
+}
+
+func detectMIMEType(data []byte) string {
+	for mimeType, magic := range compressedFileMagicList {
+		if bytes.HasPrefix(data, magic) {
+			return mimeType
+		}
+	}
+
+	mimeType := http.DetectContentType(data)
+	if semicolonIndex := strings.IndexByte(mimeType, ';'); semicolonIndex != -1 {
+		mimeType = mimeType[:semicolonIndex]
+	}
+
+	return mimeType
+}
+
+func (c compressor) isMIMETypeSkipped(mimeType string) bool {
+	for _, skippedMIMEType := range c.skippedMIMETypeList {
+		if prefix, ok := strings.CutSuffix(skippedMIMEType, "*"); ok {
+			if strings.HasPrefix(mimeType, prefix) {
+				return true
+			}
+			continue
+		}
+
+		if mimeType == skippedMIMEType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c compressor) getCodec(data []byte) Codec {
+	if len(data) < compressionSniffByteCount {
+		return CodecNone
+	}
+
+	mimeType := detectMIMEType(data)
+	if c.isMIMETypeSkipped(mimeType) {
+		c.logger.With(zap.String("mime_type", mimeType)).Debug("skipping compression of already compressed content")
+		return CodecNone
+	}
+
+	switch c.codec {
+	case configs.DownloadCompressionCodecGzip:
+		return CodecGzip
+	case configs.DownloadCompressionCodecZstd:
+		return CodecZstd
+	default:
+		return CodecNone
+	}
+}
+
+func (c compressor) newEncoder(writer io.Writer, codec Codec) (io.WriteCloser, error) {
+	switch codec {
+	case CodecGzip:
+		return gzip.NewWriterLevel(writer, getGzipLevel(c.level))
+	case CodecZstd:
+		return zstd.NewWriter(writer, zstd.WithEncoderLevel(getZstdEncoderLevel(c.level)))
+	default:
+		return nil, fmt.Errorf("unsupported codec: %s", codec)
+	}
+}
+
+type compressedWriteCloser struct {
+	compressor  compressor
+	writeCloser io.WriteCloser
+	sniffBuffer []byte
+	writer      io.Writer
+	encoder     io.WriteCloser
+	codec       Codec
+}
+
+func (c compressor) NewWriteCloser(writeCloser io.WriteCloser) CompressedWriteCloser {
+	return &compressedWriteCloser{
+		compressor:  c,
+		writeCloser: writeCloser,
+		sniffBuffer: make([]byte, 0, compressionSniffByteCount),
+		codec:       CodecNone,
+	}
+}
+
+// startWriting picks the codec from the content buffered so far, then flushes that content.
+func (c *compressedWriteCloser) startWriting() error {
+	c.codec = c.compressor.getCodec(c.sniffBuffer)
+	c.writer = c.writeCloser
+	if c.codec != CodecNone {
+		encoder, err := c.compressor.newEncoder(c.writeCloser, c.codec)
+		if err != nil {
+			return err
+		}
+
+		c.encoder = encoder
+		c.writer = encoder
+	}
+
+	_, err := c.writer.Write(c.sniffBuffer)
+	c.sniffBuffer = nil
+	return err
+}
+
+func (c *compressedWriteCloser) Write(p []byte) (int, error) {
+	if c.writer != nil {
+		return c.writer.Write(p)
+	}
+
+	c.sniffBuffer = append(c.sniffBuffer, p...)
+	if len(c.sniffBuffer) < compressionSniffByteCount {
+		return len(p), nil
+	}
+
+	if err := c.startWriting(); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (c *compressedWriteCloser) Close() error {
+	if c.writer == nil {
+		if err := c.startWriting(); err != nil {
+			c.writeCloser.Close()
+			return err
+		}
+	}
+
+	if c.encoder != nil {
+		if err := c.encoder.Close(); err != nil {
+			c.writeCloser.Close()
+			return err
+		}
+	}
+
+	return c.writeCloser.Close()
+}
+
+func (c *compressedWriteCloser) Codec() Codec {
+	return c.codec
+}
+
+type decompressedReadCloser struct {
+	io.Reader
+	closeDecoder func()
+	readCloser   io.ReadCloser
+}
+
+func (d decompressedReadCloser) Close() error {
+	d.closeDecoder()
+	return d.readCloser.Close()
+}
+
+func (c compressor) NewReadCloser(readCloser io.ReadCloser, codec Codec) (io.ReadCloser, error) {
+	switch codec {
+	case CodecNone, "":
+		return readCloser, nil
+	case CodecGzip:
+		gzipReader, err := gzip.NewReader(readCloser)
+		if err != nil {
+			readCloser.Close()
+			c.logger.With(zap.Error(err)).Error("failed to create gzip reader")
+			return nil, status.Error(codes.Internal, "failed to create gzip reader")
+		}
+
+		return decompressedReadCloser{
+			Reader:       gzipReader,
+			closeDecoder: func() { gzipReader.Close() },
+			readCloser:   readCloser,
+		}, nil
+	case CodecZstd:
+		zstdDecoder, err := zstd.NewReader(readCloser, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			readCloser.Close()
+			c.logger.With(zap.Error(err)).Error("failed to create zstd reader")
+			return nil, status.Error(codes.Internal, "failed to create zstd reader")
+		}
+
+		return decompressedReadCloser{
+			Reader:       zstdDecoder,
+			closeDecoder: zstdDecoder.Close,
+			readCloser:   readCloser,
+		}, nil
+	default:
+		readCloser.Close()
+		c.logger.With(zap.String("codec", string(codec))).Error("unsupported codec")
+		return nil, status.Error(codes.Internal, "unsupported codec")
+	}
+}
@@ -0,0 +1,146 @@
+package file
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+)
+
+// bufferWriteCloser keeps what is written to it in memory, the way a storage client would store it.
+type bufferWriteCloser struct {
+	bytes.Buffer
+
+	closed bool
+}
+
+func (b *bufferWriteCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func newTestCompressor(t *testing.T, codec configs.DownloadCompressionCodec) Compressor {
+	t.Helper()
+
+	fileCompressor, err := NewCompressor(configs.Download{Compression: configs.DownloadCompression{Codec: codec}}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create compressor: %v", err)
+	}
+
+	return fileCompressor
+}
+
+// compressTestContent writes content in small pieces, the way downloads arrive, and returns what
+// was stored along with the codec it was stored with.
+func compressTestContent(t *testing.T, fileCompressor Compressor, content []byte) ([]byte, Codec) {
+	t.Helper()
+
+	storedFile := &bufferWriteCloser{}
+	writeCloser := fileCompressor.NewWriteCloser(storedFile)
+	for chunk := range slices.Chunk(content, 100) {
+		if _, err := writeCloser.Write(chunk); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+
+	if err := writeCloser.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+
+	if !storedFile.closed {
+		t.Fatalf("expected underlying writer to be closed")
+	}
+
+	return storedFile.Bytes(), writeCloser.Codec()
+}
+
+func decompressTestContent(t *testing.T, fileCompressor Compressor, storedContent []byte, codec Codec) []byte {
+	t.Helper()
+
+	readCloser, err := fileCompressor.NewReadCloser(io.NopCloser(bytes.NewReader(storedContent)), codec)
+	if err != nil {
+		t.Fatalf("failed to create read closer: %v", err)
+	}
+	defer readCloser.Close()
+
+	content, err := io.ReadAll(readCloser)
+	if err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+
+	return content
+}
+
+func TestCompressorRoundTrip(t *testing.T) {
+	content := []byte(strings.Repeat("compressible content\n", 1000))
+	for _, testCase := range []struct {
+		configCodec   configs.DownloadCompressionCodec
+		expectedCodec Codec
+	}{
+		{configCodec: configs.DownloadCompressionCodecGzip, expectedCodec: CodecGzip},
+		{configCodec: configs.DownloadCompressionCodecZstd, expectedCodec: CodecZstd},
+		{configCodec: configs.DownloadCompressionCodecNone, expectedCodec: CodecNone},
+	} {
+		t.Run(string(testCase.configCodec), func(t *testing.T) {
+			fileCompressor := newTestCompressor(t, testCase.configCodec)
+
+			storedContent, codec := compressTestContent(t, fileCompressor, content)
+			if codec != testCase.expectedCodec {
+				t.Fatalf("expected codec %s, got %s", testCase.expectedCodec, codec)
+			}
+
+			if codec != CodecNone && len(storedContent) >= len(content) {
+				t.Fatalf("expected content to be compressed, %d bytes are stored for %d", len(storedContent), len(content))
+			}
+
+			if !bytes.Equal(decompressTestContent(t, fileCompressor, storedContent, codec), content) {
+				t.Fatalf("decompressed content differs from the original")
+			}
+		})
+	}
+}
+
+func TestCompressorStoresSmallFilesAsIs(t *testing.T) {
+	fileCompressor := newTestCompressor(t, configs.DownloadCompressionCodecZstd)
+
+	for _, content := range [][]byte{nil, []byte("small file")} {
+		storedContent, codec := compressTestContent(t, fileCompressor, content)
+		if codec != CodecNone || !bytes.Equal(storedContent, content) {
+			t.Fatalf("expected %d bytes to be stored as is, got codec %s and %d bytes", len(content), codec, len(storedContent))
+		}
+	}
+}
+
+func TestCompressorSkipsCompressedContent(t *testing.T) {
+	gzipBuffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(gzipBuffer)
+	gzipWriter.Write([]byte(strings.Repeat("already compressed\n", 1000)))
+	gzipWriter.Close()
+
+	pngContent := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 1000)...)
+	zstdContent := append([]byte{0x28, 0xB5, 0x2F, 0xFD}, bytes.Repeat([]byte{0}, 1000)...)
+
+	fileCompressor := newTestCompressor(t, configs.DownloadCompressionCodecGzip)
+	for _, content := range [][]byte{gzipBuffer.Bytes(), pngContent, zstdContent} {
+		storedContent, codec := compressTestContent(t, fileCompressor, content)
+		if codec != CodecNone || !bytes.Equal(storedContent, content) {
+			t.Fatalf("expected already compressed content of type %s to be stored as is, got codec %s", detectMIMEType(content), codec)
+		}
+	}
+}
+
+func TestCompressorRejectsUnsupportedCodec(t *testing.T) {
+	if _, err := NewCompressor(configs.Download{Compression: configs.DownloadCompression{Codec: "lz4"}}, zap.NewNop()); err == nil {
+		t.Fatalf("expected unsupported compression codec to be rejected")
+	}
+
+	fileCompressor := newTestCompressor(t, configs.DownloadCompressionCodecGzip)
+	if _, err := fileCompressor.NewReadCloser(io.NopCloser(bytes.NewReader(nil)), "lz4"); err == nil {
+		t.Fatalf("expected file stored with an unsupported codec to be rejected")
+	}
+}
@@ -0,0 +1,452 @@
+package file
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	contentAddressedBlobFilePathPrefix   = "blob_"
+	contentAddressedUploadFilePathPrefix = "upload_"
+	filePathRandomByteCount              = 16
+	// acquireBlobAttemptCount bounds how many times a blob is looked up again after it was deleted
+	// by a concurrent transaction between failing to create it and locking it.
+	acquireBlobAttemptCount = 3
+)
+
+// StoredContent describes the content of a file and how it was stored.
+type StoredContent struct {
+	// SHA256 is the hex encoded sha256 of the content before it was compressed and encrypted.
+	SHA256         string
+	Codec          Codec
+	WrappedDataKey WrappedDataKey
+}
+
+// DeduplicatingWriteCloser is returned by the Write method of clients that store identical content
+// once. Content is encrypted with a random data key per upload, so they cannot tell identical
+// content apart from the bytes written to them, and are told what those bytes contain instead.
+type DeduplicatingWriteCloser interface {
+	io.WriteCloser
+	// SetStoredContentFunc sets the function Close calls, once everything was written, to get the
+	// content the written bytes hold. Without it, the written bytes are taken as the content.
+	SetStoredContentFunc(getStoredContent func() StoredContent)
+	// StoredContent returns how the content of the file is stored, once Close has returned
+	// successfully. When the same content was already stored the same way, the file shares that
+	// blob, and has to be read with the data key of the blob rather than the one it was written with.
+	StoredContent() StoredContent
+}
+
+// ContentAddressedClient stores every distinct file content once, under the sha256 of the content.
+// File paths are mapped to the blob holding their content, and blobs are reference counted so
+// that they are only removed from the underlying storage once no file path points to them anymore.
+type ContentAddressedClient struct {
+	storageClient             storageClient
+	goquDatabase              *goqu.Database
+	blobDataAccessor          database.BlobDataAccessor
+	blobReferenceDataAccessor database.BlobReferenceDataAccessor
+	logger                    *zap.Logger
+}
+
+func NewContentAddressedClient(
+	storageClient storageClient,
+	goquDatabase *goqu.Database,
+	blobDataAccessor database.BlobDataAccessor,
+	blobReferenceDataAccessor database.BlobReferenceDataAccessor,
+	logger *zap.Logger,
+) Client {
+	return &ContentAddressedClient{
+		storageClient:             storageClient,
+		goquDatabase:              goquDatabase,
+		blobDataAccessor:          blobDataAccessor,
+		blobReferenceDataAccessor: blobReferenceDataAccessor,
+		logger:                    logger,
+	}
+}
+
+// getBlobFilePath returns where the content of a blob is stored. Blobs stored before their path
+// was recorded are stored at a path derived from their sha256.
+func getBlobFilePath(blob database.Blob) string {
+	if blob.FilePath == "" {
+		return contentAddressedBlobFilePathPrefix + blob.SHA256
+	}
+
+	return blob.FilePath
+}
+
+// getBlobSHA256 returns the identifier of the blob holding content stored the given way. Content
+// stored as is is identified by its sha256, like the blobs stored before the content was recorded.
+// Otherwise the codec and the master key are part of the identifier, so that a blob is only shared
+// by uploads that can be read with its data key, and content encrypted after a master key rotation
+// gets a blob of its own.
+func getBlobSHA256(storedContent StoredContent) string {
+	if (storedContent.Codec == "" || storedContent.Codec == CodecNone) && storedContent.WrappedDataKey.IsEmpty() {
+		return storedContent.SHA256
+	}
+
+	blobHash := sha256.New()
+	fmt.Fprintf(
+		blobHash,
+		"%s\x00%s\x00%s",
+		storedContent.SHA256,
+		storedContent.Codec,
+		storedContent.WrappedDataKey.MasterKeyID,
+	)
+	return hex.EncodeToString(blobHash.Sum(nil))
+}
+
+func newBlob(blobSHA256 string, blobFilePath string, size uint64, storedContent StoredContent) database.Blob {
+	blob := database.Blob{
+		SHA256:         blobSHA256,
+		Size:           size,
+		ReferenceCount: 1,
+		FilePath:       blobFilePath,
+		ContentSHA256:  storedContent.SHA256,
+		Codec:          string(storedContent.Codec),
+	}
+
+	if !storedContent.WrappedDataKey.IsEmpty() {
+		blob.MasterKeyID = storedContent.WrappedDataKey.MasterKeyID
+		blob.DataKey = base64.StdEncoding.EncodeToString(storedContent.WrappedDataKey.Ciphertext)
+	}
+
+	return blob
+}
+
+// getBlobStoredContent returns how the content of a blob is stored. Blobs stored before it was
+// recorded hold their content as is.
+func getBlobStoredContent(blob database.Blob) (StoredContent, error) {
+	storedContent := StoredContent{
+		SHA256: blob.ContentSHA256,
+		Codec:  Codec(blob.Codec),
+	}
+
+	if storedContent.SHA256 == "" {
+		storedContent.SHA256 = blob.SHA256
+	}
+
+	if storedContent.Codec == "" {
+		storedContent.Codec = CodecNone
+	}
+
+	if blob.MasterKeyID == "" {
+		return storedContent, nil
+	}
+
+	dataKey, err := base64.StdEncoding.DecodeString(blob.DataKey)
+	if err != nil {
+		return StoredContent{}, status.Error(codes.Internal, "blob contains an invalid data key")
+	}
+
+	storedContent.WrappedDataKey = WrappedDataKey{
+		MasterKeyID: blob.MasterKeyID,
+		Ciphertext:  dataKey,
+	}
+	return storedContent, nil
+}
+
+func newRandomFilePathSuffix() (string, error) {
+	randomBytes := make([]byte, filePathRandomByteCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(randomBytes), nil
+}
+
+func newUploadFilePath() (string, error) {
+	suffix, err := newRandomFilePathSuffix()
+	if err != nil {
+		return "", err
+	}
+
+	return contentAddressedUploadFilePathPrefix + suffix, nil
+}
+
+// newBlobFilePath returns a path no other blob is stored at, so that concurrent uploads of the same
+// content, of which only one becomes the blob, do not overwrite or delete each other's file.
+func newBlobFilePath(sha256 string) (string, error) {
+	suffix, err := newRandomFilePathSuffix()
+	if err != nil {
+		return "", err
+	}
+
+	return contentAddressedBlobFilePathPrefix + sha256 + "_" + suffix, nil
+}
+
+type contentAddressedWriteCloser struct {
+	ctx              context.Context
+	client           *ContentAddressedClient
+	filePath         string
+	uploadFilePath   string
+	writeCloser      io.WriteCloser
+	hash             hash.Hash
+	size             uint64
+	getStoredContent func() StoredContent
+	storedContent    StoredContent
+}
+
+func (c *contentAddressedWriteCloser) Write(p []byte) (int, error) {
+	writtenByteCount, err := c.writeCloser.Write(p)
+	c.hash.Write(p[:writtenByteCount])
+	c.size += uint64(writtenByteCount)
+	return writtenByteCount, err
+}
+
+func (c *contentAddressedWriteCloser) Close() error {
+	if err := c.writeCloser.Close(); err != nil {
+		c.client.deleteStorageFile(c.ctx, c.uploadFilePath)
+		return err
+	}
+
+	storedContent := StoredContent{SHA256: hex.EncodeToString(c.hash.Sum(nil)), Codec: CodecNone}
+	if c.getStoredContent != nil {
+		storedContent = c.getStoredContent()
+	}
+
+	var err error
+	c.storedContent, err = c.client.commitUpload(c.ctx, c.filePath, c.uploadFilePath, storedContent, c.size)
+	return err
+}
+
+func (c *contentAddressedWriteCloser) SetStoredContentFunc(getStoredContent func() StoredContent) {
+	c.getStoredContent = getStoredContent
+}
+
+func (c *contentAddressedWriteCloser) StoredContent() StoredContent {
+	return c.storedContent
+}
+
+func (c *ContentAddressedClient) deleteStorageFile(ctx context.Context, storageFilePath string) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("storage_file_path", storageFilePath))
+
+	if err := c.storageClient.Delete(ctx, storageFilePath); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete storage file")
+	}
+}
+
+// releaseBlob drops one reference to a blob, and deletes the blob row when it was the last one. It
+// must be called inside a transaction, after the reference itself has been removed or repointed. It
+// returns the path of the deleted blob's content, which must only be deleted from the storage once
+// the transaction has committed, as the blob is still referenced if it rolls back.
+func (c *ContentAddressedClient) releaseBlob(ctx context.Context, td *goqu.TxDatabase, sha256 string) (string, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("sha256", sha256))
+
+	blob, err := c.blobDataAccessor.WithDatabase(td).GetBlobWithXLock(ctx, sha256)
+	if err != nil {
+		if errors.Is(err, database.ErrBlobNotFound) {
+			logger.Warn("released blob does not exist")
+			return "", nil
+		}
+		return "", err
+	}
+
+	if blob.ReferenceCount > 1 {
+		blob.ReferenceCount--
+		return "", c.blobDataAccessor.WithDatabase(td).UpdateBlob(ctx, blob)
+	}
+
+	err = c.blobDataAccessor.WithDatabase(td).DeleteBlob(ctx, sha256)
+	if err != nil {
+		return "", err
+	}
+
+	return getBlobFilePath(blob), nil
+}
+
+// acquireBlob adds one reference to a blob, creating it from the content stored at blobFilePath if
+// it does not exist yet. It returns the blob, and reports whether it was created, and with it took
+// ownership of the content at blobFilePath.
+func (c *ContentAddressedClient) acquireBlob(ctx context.Context, td *goqu.TxDatabase, newBlob database.Blob) (database.Blob, bool, error) {
+	for range acquireBlobAttemptCount {
+		created, err := c.blobDataAccessor.WithDatabase(td).CreateBlobIfNotExists(ctx, newBlob)
+		if err != nil || created {
+			return newBlob, created, err
+		}
+
+		blob, err := c.blobDataAccessor.WithDatabase(td).GetBlobWithXLock(ctx, newBlob.SHA256)
+		if err != nil {
+			if errors.Is(err, database.ErrBlobNotFound) {
+				continue
+			}
+			return database.Blob{}, false, err
+		}
+
+		blob.ReferenceCount++
+		return blob, false, c.blobDataAccessor.WithDatabase(td).UpdateBlob(ctx, blob)
+	}
+
+	return database.Blob{}, false, status.Error(codes.Aborted, "blob was concurrently deleted too many times")
+}
+
+// commitUpload points filePath to the blob of the uploaded content, and returns how the content of
+// that blob is stored.
+func (c *ContentAddressedClient) commitUpload(
+	ctx context.Context,
+	filePath string,
+	uploadFilePath string,
+	storedContent StoredContent,
+	size uint64,
+) (StoredContent, error) {
+	blobSHA256 := getBlobSHA256(storedContent)
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("file_path", filePath)).
+		With(zap.String("sha256", blobSHA256))
+
+	// The content is moved to its blob path before the transaction, so that no storage change has
+	// to be undone when the transaction rolls back; the moved file is deleted instead.
+	blobFilePath, err := newBlobFilePath(blobSHA256)
+	if err != nil {
+		c.deleteStorageFile(ctx, uploadFilePath)
+		logger.With(zap.Error(err)).Error("failed to generate blob file path")
+		return StoredContent{}, status.Error(codes.Internal, "failed to generate blob file path")
+	}
+
+	err = c.storageClient.move(ctx, uploadFilePath, blobFilePath)
+	if err != nil {
+		c.deleteStorageFile(ctx, uploadFilePath)
+		logger.With(zap.Error(err)).Error("failed to move upload file")
+		return StoredContent{}, err
+	}
+
+	blob := database.Blob{}
+	blobCreated := false
+	releasedBlobFilePath := ""
+	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		blobReference, err := c.blobReferenceDataAccessor.WithDatabase(td).GetBlobReferenceWithXLock(ctx, filePath)
+		blobReferenceExists := err == nil
+		if err != nil && !errors.Is(err, database.ErrBlobReferenceNotFound) {
+			return err
+		}
+
+		if blobReferenceExists && blobReference.BlobSHA256 == blobSHA256 {
+			blob, err = c.blobDataAccessor.WithDatabase(td).GetBlobWithXLock(ctx, blobSHA256)
+			return err
+		}
+
+		blob, blobCreated, err = c.acquireBlob(ctx, td, newBlob(blobSHA256, blobFilePath, size, storedContent))
+		if err != nil {
+			return err
+		}
+
+		if !blobReferenceExists {
+			return c.blobReferenceDataAccessor.WithDatabase(td).CreateBlobReference(ctx, database.BlobReference{
+				FilePath:   filePath,
+				BlobSHA256: blobSHA256,
+			})
+		}
+
+		previousBlobSHA256 := blobReference.BlobSHA256
+		blobReference.BlobSHA256 = blobSHA256
+		err = c.blobReferenceDataAccessor.WithDatabase(td).UpdateBlobReference(ctx, blobReference)
+		if err != nil {
+			return err
+		}
+
+		releasedBlobFilePath, err = c.releaseBlob(ctx, td, previousBlobSHA256)
+		return err
+	})
+
+	if txErr != nil || !blobCreated {
+		c.deleteStorageFile(ctx, blobFilePath)
+	}
+
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("failed to commit content addressed upload")
+		return StoredContent{}, txErr
+	}
+
+	if releasedBlobFilePath != "" {
+		c.deleteStorageFile(ctx, releasedBlobFilePath)
+	}
+
+	return getBlobStoredContent(blob)
+}
+
+func (c *ContentAddressedClient) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("file_path", filePath))
+
+	uploadFilePath, err := newUploadFilePath()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate upload file path")
+		return nil, status.Error(codes.Internal, "failed to generate upload file path")
+	}
+
+	writeCloser, err := c.storageClient.Write(ctx, uploadFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentAddressedWriteCloser{
+		ctx:            ctx,
+		client:         c,
+		filePath:       filePath,
+		uploadFilePath: uploadFilePath,
+		writeCloser:    writeCloser,
+		hash:           sha256.New(),
+	}, nil
+}
+
+func (c *ContentAddressedClient) Read(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	blobReference, err := c.blobReferenceDataAccessor.GetBlobReference(ctx, filePath)
+	if err != nil {
+		if errors.Is(err, database.ErrBlobReferenceNotFound) {
+			return nil, status.Error(codes.NotFound, "file not found")
+		}
+		return nil, err
+	}
+
+	blob, err := c.blobDataAccessor.GetBlob(ctx, blobReference.BlobSHA256)
+	if err != nil {
+		if errors.Is(err, database.ErrBlobNotFound) {
+			return nil, status.Error(codes.NotFound, "file not found")
+		}
+		return nil, err
+	}
+
+	return c.storageClient.Read(ctx, getBlobFilePath(blob))
+}
+
+func (c *ContentAddressedClient) Delete(ctx context.Context, filePath string) error {
+	releasedBlobFilePath := ""
+	err := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		blobReference, err := c.blobReferenceDataAccessor.WithDatabase(td).GetBlobReferenceWithXLock(ctx, filePath)
+		if err != nil {
+			if errors.Is(err, database.ErrBlobReferenceNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		err = c.blobReferenceDataAccessor.WithDatabase(td).DeleteBlobReference(ctx, filePath)
+		if err != nil {
+			return err
+		}
+
+		releasedBlobFilePath, err = c.releaseBlob(ctx, td, blobReference.BlobSHA256)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// The content is only deleted once no committed blob points to it anymore.
+	if releasedBlobFilePath != "" {
+		c.deleteStorageFile(ctx, releasedBlobFilePath)
+	}
+
+	return nil
+}
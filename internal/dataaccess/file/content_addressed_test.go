@@ -0,0 +1,157 @@
+package file
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+func newTestContentAddressedClient(t *testing.T) (Client, string) {
+	t.Helper()
+
+	directory := t.TempDir()
+	databaseConfig := configs.Database{
+		Type:     configs.DatabaseTypeSQLite,
+		Database: filepath.Join(directory, "morgana.db"),
+	}
+	logger := zap.NewNop()
+
+	db, cleanup, err := database.InitializeAndMigrateUpDB(databaseConfig, noop.NewTracerProvider(), logger)
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	goquDatabase, err := database.InitializeGoquDB(db, databaseConfig)
+	if err != nil {
+		t.Fatalf("failed to initialize goqu database: %v", err)
+	}
+
+	downloadDirectory := filepath.Join(directory, "downloads")
+	storageClient, err := newLocalClient(configs.Download{DownloadDirectory: downloadDirectory}, logger)
+	if err != nil {
+		t.Fatalf("failed to create local client: %v", err)
+	}
+
+	return NewContentAddressedClient(
+		storageClient,
+		goquDatabase,
+		database.NewBlobDataAccessor(goquDatabase, logger),
+		database.NewBlobReferenceDataAccessor(goquDatabase, logger),
+		logger,
+	), downloadDirectory
+}
+
+func writeTestFile(ctx context.Context, client Client, filePath string, content string) error {
+	writeCloser, err := client.Write(ctx, filePath)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.WriteString(writeCloser, content); err != nil {
+		writeCloser.Close()
+		return err
+	}
+
+	return writeCloser.Close()
+}
+
+func getStoredFileCount(t *testing.T, directory string) int {
+	t.Helper()
+
+	entryList, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatalf("failed to read directory: %v", err)
+	}
+
+	return len(entryList)
+}
+
+func TestContentAddressedClientConcurrentUploadsOfSameContent(t *testing.T) {
+	ctx := context.Background()
+	client, downloadDirectory := newTestContentAddressedClient(t)
+
+	filePathList := []string{"a", "b", "c", "d"}
+	errList := make([]error, len(filePathList))
+	var waitGroup sync.WaitGroup
+	for i, filePath := range filePathList {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			errList[i] = writeTestFile(ctx, client, filePath, "same content")
+		}()
+	}
+	waitGroup.Wait()
+
+	for i, err := range errList {
+		if err != nil {
+			t.Fatalf("failed to write %s: %v", filePathList[i], err)
+		}
+	}
+
+	if storedFileCount := getStoredFileCount(t, downloadDirectory); storedFileCount != 1 {
+		t.Fatalf("expected the content to be stored once, got %d files", storedFileCount)
+	}
+
+	for i, filePath := range filePathList {
+		readCloser, err := client.Read(ctx, filePath)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", filePath, err)
+		}
+
+		content, err := io.ReadAll(readCloser)
+		readCloser.Close()
+		if err != nil || string(content) != "same content" {
+			t.Fatalf("unexpected content of %s: %q, %v", filePath, content, err)
+		}
+
+		if err = client.Delete(ctx, filePath); err != nil {
+			t.Fatalf("failed to delete %s: %v", filePath, err)
+		}
+
+		expectedStoredFileCount := 1
+		if i == len(filePathList)-1 {
+			expectedStoredFileCount = 0
+		}
+
+		if storedFileCount := getStoredFileCount(t, downloadDirectory); storedFileCount != expectedStoredFileCount {
+			t.Fatalf("expected %d stored files after deleting %s, got %d", expectedStoredFileCount, filePath, storedFileCount)
+		}
+	}
+}
+
+func TestContentAddressedClientOverwrite(t *testing.T) {
+	ctx := context.Background()
+	client, downloadDirectory := newTestContentAddressedClient(t)
+
+	if err := writeTestFile(ctx, client, "a", "first content"); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if err := writeTestFile(ctx, client, "a", "second content"); err != nil {
+		t.Fatalf("failed to overwrite file: %v", err)
+	}
+
+	if storedFileCount := getStoredFileCount(t, downloadDirectory); storedFileCount != 1 {
+		t.Fatalf("expected the replaced content to be deleted, got %d files", storedFileCount)
+	}
+
+	readCloser, err := client.Read(ctx, "a")
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	defer readCloser.Close()
+
+	content, err := io.ReadAll(readCloser)
+	if err != nil || string(content) != "second content" {
+		t.Fatalf("unexpected content: %q, %v", content, err)
+	}
+}
@@ -0,0 +1,407 @@
+package file
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	encryptionKeyByteCount = 32
+	// Every chunk is sealed separately, so that files can be encrypted and decrypted as streams
+	// without holding them in memory.
+	encryptionChunkPlaintextByteCount = 64 * 1024
+)
+
+var (
+	ErrMasterKeyNotFound = status.Error(codes.Internal, "master key not found")
+)
+
+// WrappedDataKey is a per-file data key, encrypted with the master key identified by MasterKeyID.
+type WrappedDataKey struct {
+	MasterKeyID string
+	Ciphertext  []byte
+}
+
+func (w WrappedDataKey) IsEmpty() bool {
+	return w.MasterKeyID == ""
+}
+
+type EncryptedWriteCloser interface {
+	io.WriteCloser
+	// WrappedDataKey returns the wrapped key the written content was encrypted with. It is empty if
+	// encryption is disabled and the content was stored in plaintext.
+	WrappedDataKey() WrappedDataKey
+}
+
+type Encryptor interface {
+	NewWriteCloser(writeCloser io.WriteCloser) (EncryptedWriteCloser, error)
+	NewReadCloser(readCloser io.ReadCloser, wrappedDataKey WrappedDataKey) (io.ReadCloser, error)
+	// RewrapDataKey wraps a data key again with the active master key. It reports false if the data
+	// key is already wrapped with the active master key, or if encryption is disabled.
+	RewrapDataKey(wrappedDataKey WrappedDataKey) (WrappedDataKey, bool, error)
+	// CanRewrapDataKeys reports whether any data key can be wrapped again at all, which requires
+	// encryption to be enabled and a master key besides the active one to be configured.
+	CanRewrapDataKeys() bool
+	// NeedsRewrapDataKey reports whether RewrapDataKey would wrap the data key again.
+	NeedsRewrapDataKey(wrappedDataKey WrappedDataKey) bool
+}
+
+type encryptor struct {
+	enabled           bool
+	activeMasterKeyID string
+	masterKeyAEADMap  map[string]cipher.AEAD
+	logger            *zap.Logger
+}
+
+func getMasterKey(masterKeyConfig configs.DownloadEncryptionMasterKey) ([]byte, error) {
+	encodedKey := masterKeyConfig.Key
+	if masterKeyConfig.KeyFile != "" {
+		keyFileBytes, err := os.ReadFile(masterKeyConfig.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read master key file %s: %w", masterKeyConfig.KeyFile, err)
+		}
+
+		encodedKey = string(keyFileBytes)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode master key %s: %w", masterKeyConfig.ID, err)
+	}
+
+	if len(key) != encryptionKeyByteCount {
+		return nil, fmt.Errorf("master key %s must be %d bytes long", masterKeyConfig.ID, encryptionKeyByteCount)
+	}
+
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func NewEncryptor(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (Encryptor, error) {
+	encryptionConfig := downloadConfig.Encryption
+
+	masterKeyAEADMap := make(map[string]cipher.AEAD)
+	for _, masterKeyConfig := range encryptionConfig.MasterKeys {
+		if masterKeyConfig.ID == "" {
+			return nil, errors.New("master key id must not be empty")
+		}
+
+		if _, ok := masterKeyAEADMap[masterKeyConfig.ID]; ok {
+			return nil, fmt.Errorf("duplicate master key id: %s", masterKeyConfig.ID)
+		}
+
+		masterKey, err := getMasterKey(masterKeyConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		masterKeyAEADMap[masterKeyConfig.ID], err = newAEAD(masterKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if encryptionConfig.Enabled {
+		if _, ok := masterKeyAEADMap[encryptionConfig.ActiveMasterKeyID]; !ok {
+			return nil, fmt.Errorf("active master key %s is not configured", encryptionConfig.ActiveMasterKeyID)
+		}
+	}
+
+	return &encryptor{
+		enabled:           encryptionConfig.Enabled,
+		activeMasterKeyID: encryptionConfig.ActiveMasterKeyID,
+		masterKeyAEADMap:  masterKeyAEADMap,
+		logger:            logger,
+	}, nil
+}
+
+func (e encryptor) wrapDataKey(dataKey []byte) (WrappedDataKey, error) {
+	masterKeyAEAD := e.masterKeyAEADMap[e.activeMasterKeyID]
+
+	nonce := make([]byte, masterKeyAEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return WrappedDataKey{}, err
+	}
+
+	// The master key ID is authenticated, so that a wrapped data key cannot be passed off as
+	// belonging to another master key.
+	return WrappedDataKey{
+		MasterKeyID: e.activeMasterKeyID,
+		Ciphertext:  masterKeyAEAD.Seal(nonce, nonce, dataKey, []byte(e.activeMasterKeyID)),
+	}, nil
+}
+
+func (e encryptor) unwrapDataKey(wrappedDataKey WrappedDataKey) ([]byte, error) {
+	logger := e.logger.With(zap.String("master_key_id", wrappedDataKey.MasterKeyID))
+
+	masterKeyAEAD, ok := e.masterKeyAEADMap[wrappedDataKey.MasterKeyID]
+	if !ok {
+		logger.Error("master key of wrapped data key is not configured")
+		return nil, ErrMasterKeyNotFound
+	}
+
+	nonceSize := masterKeyAEAD.NonceSize()
+	if len(wrappedDataKey.Ciphertext) < nonceSize {
+		logger.Error("wrapped data key is too short")
+		return nil, status.Error(codes.Internal, "failed to unwrap data key")
+	}
+
+	dataKey, err := masterKeyAEAD.Open(
+		nil,
+		wrappedDataKey.Ciphertext[:nonceSize],
+		wrappedDataKey.Ciphertext[nonceSize:],
+		[]byte(wrappedDataKey.MasterKeyID),
+	)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to unwrap data key")
+		return nil, status.Error(codes.Internal, "failed to unwrap data key")
+	}
+
+	return dataKey, nil
+}
+
+func (e encryptor) CanRewrapDataKeys() bool {
+	return e.enabled && len(e.masterKeyAEADMap) > 1
+}
+
+func (e encryptor) NeedsRewrapDataKey(wrappedDataKey WrappedDataKey) bool {
+	return e.enabled && !wrappedDataKey.IsEmpty() && wrappedDataKey.MasterKeyID != e.activeMasterKeyID
+}
+
+func (e encryptor) RewrapDataKey(wrappedDataKey WrappedDataKey) (WrappedDataKey, bool, error) {
+	if !e.NeedsRewrapDataKey(wrappedDataKey) {
+		return wrappedDataKey, false, nil
+	}
+
+	dataKey, err := e.unwrapDataKey(wrappedDataKey)
+	if err != nil {
+		return WrappedDataKey{}, false, err
+	}
+
+	rewrappedDataKey, err := e.wrapDataKey(dataKey)
+	if err != nil {
+		e.logger.With(zap.Error(err)).Error("failed to wrap data key")
+		return WrappedDataKey{}, false, status.Error(codes.Internal, "failed to wrap data key")
+	}
+
+	return rewrappedDataKey, true, nil
+}
+
+// getChunkNonce derives the nonce of a chunk from its index and whether it is the last one. Data
+// keys are never reused across files, so the nonces only have to be unique within a file, while
+// binding them to the chunk position detects reordered, dropped and truncated chunks.
+func getChunkNonce(nonceSize int, chunkIndex uint64, isLastChunk bool) []byte {
+	nonce := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonce[nonceSize-9:nonceSize-1], chunkIndex)
+	if isLastChunk {
+		nonce[nonceSize-1] = 1
+	}
+
+	return nonce
+}
+
+type plaintextWriteCloser struct {
+	io.WriteCloser
+}
+
+func (plaintextWriteCloser) WrappedDataKey() WrappedDataKey {
+	return WrappedDataKey{}
+}
+
+type encryptedWriteCloser struct {
+	writeCloser    io.WriteCloser
+	aead           cipher.AEAD
+	wrappedDataKey WrappedDataKey
+	buffer         []byte
+	chunkIndex     uint64
+}
+
+func (e encryptor) NewWriteCloser(writeCloser io.WriteCloser) (EncryptedWriteCloser, error) {
+	if !e.enabled {
+		return plaintextWriteCloser{WriteCloser: writeCloser}, nil
+	}
+
+	dataKey := make([]byte, encryptionKeyByteCount)
+	if _, err := rand.Read(dataKey); err != nil {
+		e.logger.With(zap.Error(err)).Error("failed to generate data key")
+		return nil, status.Error(codes.Internal, "failed to generate data key")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		e.logger.With(zap.Error(err)).Error("failed to create data key cipher")
+		return nil, status.Error(codes.Internal, "failed to create data key cipher")
+	}
+
+	wrappedDataKey, err := e.wrapDataKey(dataKey)
+	if err != nil {
+		e.logger.With(zap.Error(err)).Error("failed to wrap data key")
+		return nil, status.Error(codes.Internal, "failed to wrap data key")
+	}
+
+	return &encryptedWriteCloser{
+		writeCloser:    writeCloser,
+		aead:           aead,
+		wrappedDataKey: wrappedDataKey,
+		buffer:         make([]byte, 0, encryptionChunkPlaintextByteCount),
+	}, nil
+}
+
+func (e *encryptedWriteCloser) writeChunk(isLastChunk bool) error {
+	nonce := getChunkNonce(e.aead.NonceSize(), e.chunkIndex, isLastChunk)
+	_, err := e.writeCloser.Write(e.aead.Seal(nil, nonce, e.buffer, nil))
+	if err != nil {
+		return err
+	}
+
+	e.buffer = e.buffer[:0]
+	e.chunkIndex++
+	return nil
+}
+
+func (e *encryptedWriteCloser) Write(p []byte) (int, error) {
+	writtenByteCount := 0
+	for len(p) > 0 {
+		// A full chunk is only written out once more data arrives, since the last chunk has to be
+		// marked as such.
+		if len(e.buffer) == encryptionChunkPlaintextByteCount {
+			if err := e.writeChunk(false); err != nil {
+				return writtenByteCount, err
+			}
+		}
+
+		copiedByteCount := min(len(p), encryptionChunkPlaintextByteCount-len(e.buffer))
+		e.buffer = append(e.buffer, p[:copiedByteCount]...)
+		p = p[copiedByteCount:]
+		writtenByteCount += copiedByteCount
+	}
+
+	return writtenByteCount, nil
+}
+
+func (e *encryptedWriteCloser) Close() error {
+	if err := e.writeChunk(true); err != nil {
+		e.writeCloser.Close()
+		return err
+	}
+
+	return e.writeCloser.Close()
+}
+
+func (e *encryptedWriteCloser) WrappedDataKey() WrappedDataKey {
+	return e.wrappedDataKey
+}
+
+type decryptedReadCloser struct {
+	readCloser     io.ReadCloser
+	bufferedReader *bufio.Reader
+	aead           cipher.AEAD
+	ciphertext     []byte
+	plaintext      []byte
+	chunkIndex     uint64
+	isDone         bool
+	logger         *zap.Logger
+}
+
+func (e encryptor) NewReadCloser(readCloser io.ReadCloser, wrappedDataKey WrappedDataKey) (io.ReadCloser, error) {
+	if wrappedDataKey.IsEmpty() {
+		return readCloser, nil
+	}
+
+	dataKey, err := e.unwrapDataKey(wrappedDataKey)
+	if err != nil {
+		readCloser.Close()
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		readCloser.Close()
+		e.logger.With(zap.Error(err)).Error("failed to create data key cipher")
+		return nil, status.Error(codes.Internal, "failed to create data key cipher")
+	}
+
+	return &decryptedReadCloser{
+		readCloser:     readCloser,
+		bufferedReader: bufio.NewReader(readCloser),
+		aead:           aead,
+		ciphertext:     make([]byte, encryptionChunkPlaintextByteCount+aead.Overhead()),
+		logger:         e.logger,
+	}, nil
+}
+
+func (d *decryptedReadCloser) readChunk() error {
+	readByteCount, err := io.ReadFull(d.bufferedReader, d.ciphertext)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	isLastChunk := errors.Is(err, io.ErrUnexpectedEOF)
+	if !isLastChunk {
+		if _, peekErr := d.bufferedReader.Peek(1); peekErr != nil {
+			if !errors.Is(peekErr, io.EOF) {
+				return peekErr
+			}
+			isLastChunk = true
+		}
+	}
+
+	nonce := getChunkNonce(d.aead.NonceSize(), d.chunkIndex, isLastChunk)
+	d.plaintext, err = d.aead.Open(d.ciphertext[:0], nonce, d.ciphertext[:readByteCount], nil)
+	if err != nil {
+		d.logger.With(zap.Uint64("chunk_index", d.chunkIndex)).With(zap.Error(err)).Error("failed to decrypt file chunk")
+		return status.Error(codes.DataLoss, "failed to decrypt file")
+	}
+
+	d.chunkIndex++
+	d.isDone = isLastChunk
+	return nil
+}
+
+func (d *decryptedReadCloser) Read(p []byte) (int, error) {
+	for len(d.plaintext) == 0 {
+		if d.isDone {
+			return 0, io.EOF
+		}
+
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	readByteCount := copy(p, d.plaintext)
+	d.plaintext = d.plaintext[readByteCount:]
+	return readByteCount, nil
+}
+
+func (d *decryptedReadCloser) Close() error {
+	return d.readCloser.Close()
+}
@@ -0,0 +1,189 @@
+package file
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+)
+
+const testEncryptedChunkByteCount = encryptionChunkPlaintextByteCount + 16
+
+func newTestMasterKey(t *testing.T, id string) configs.DownloadEncryptionMasterKey {
+	t.Helper()
+
+	masterKey := make([]byte, encryptionKeyByteCount)
+	if _, err := rand.Read(masterKey); err != nil {
+		t.Fatalf("failed to generate master key: %v", err)
+	}
+
+	return configs.DownloadEncryptionMasterKey{ID: id, Key: base64.StdEncoding.EncodeToString(masterKey)}
+}
+
+func newTestEncryptor(t *testing.T, activeMasterKeyID string, masterKeyList ...configs.DownloadEncryptionMasterKey) Encryptor {
+	t.Helper()
+
+	fileEncryptor, err := NewEncryptor(configs.Download{Encryption: configs.DownloadEncryption{
+		Enabled:           true,
+		ActiveMasterKeyID: activeMasterKeyID,
+		MasterKeys:        masterKeyList,
+	}}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create encryptor: %v", err)
+	}
+
+	return fileEncryptor
+}
+
+func newTestContent(t *testing.T, byteCount int) []byte {
+	t.Helper()
+
+	content := make([]byte, byteCount)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("failed to generate content: %v", err)
+	}
+
+	return content
+}
+
+func encryptTestContent(t *testing.T, fileEncryptor Encryptor, content []byte) ([]byte, WrappedDataKey) {
+	t.Helper()
+
+	storedFile := &bufferWriteCloser{}
+	writeCloser, err := fileEncryptor.NewWriteCloser(storedFile)
+	if err != nil {
+		t.Fatalf("failed to create write closer: %v", err)
+	}
+
+	if _, err = writeCloser.Write(content); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+
+	if err = writeCloser.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+
+	return storedFile.Bytes(), writeCloser.WrappedDataKey()
+}
+
+func decryptTestContent(fileEncryptor Encryptor, storedContent []byte, wrappedDataKey WrappedDataKey) ([]byte, error) {
+	readCloser, err := fileEncryptor.NewReadCloser(io.NopCloser(bytes.NewReader(storedContent)), wrappedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	return io.ReadAll(readCloser)
+}
+
+func TestEncryptorRoundTrip(t *testing.T) {
+	fileEncryptor := newTestEncryptor(t, "active", newTestMasterKey(t, "active"))
+
+	for _, byteCount := range []int{0, 1, encryptionChunkPlaintextByteCount, encryptionChunkPlaintextByteCount + 1} {
+		content := newTestContent(t, byteCount)
+		storedContent, wrappedDataKey := encryptTestContent(t, fileEncryptor, content)
+		if wrappedDataKey.MasterKeyID != "active" {
+			t.Fatalf("expected data key to be wrapped with the active master key, got %s", wrappedDataKey.MasterKeyID)
+		}
+
+		if byteCount > 0 && bytes.Contains(storedContent, content) {
+			t.Fatalf("expected %d bytes to be stored encrypted", byteCount)
+		}
+
+		decryptedContent, err := decryptTestContent(fileEncryptor, storedContent, wrappedDataKey)
+		if err != nil || !bytes.Equal(decryptedContent, content) {
+			t.Fatalf("failed to decrypt %d bytes: %v", byteCount, err)
+		}
+	}
+}
+
+func TestEncryptorDetectsTampering(t *testing.T) {
+	fileEncryptor := newTestEncryptor(t, "active", newTestMasterKey(t, "active"))
+	content := newTestContent(t, 2*encryptionChunkPlaintextByteCount+10)
+	storedContent, wrappedDataKey := encryptTestContent(t, fileEncryptor, content)
+	if len(storedContent) != 2*testEncryptedChunkByteCount+10+16 {
+		t.Fatalf("unexpected encrypted file size %d", len(storedContent))
+	}
+
+	firstChunk := storedContent[:testEncryptedChunkByteCount]
+	secondChunk := storedContent[testEncryptedChunkByteCount : 2*testEncryptedChunkByteCount]
+	lastChunk := storedContent[2*testEncryptedChunkByteCount:]
+
+	flippedBitContent := bytes.Clone(storedContent)
+	flippedBitContent[testEncryptedChunkByteCount+100] ^= 1
+
+	for name, tamperedContent := range map[string][]byte{
+		// The second chunk is not marked as the last one, so the file cannot end there.
+		"truncated":   bytes.Clone(storedContent[:2*testEncryptedChunkByteCount]),
+		"reordered":   bytes.Join([][]byte{secondChunk, firstChunk, lastChunk}, nil),
+		"flipped bit": flippedBitContent,
+		"empty":       nil,
+	} {
+		if _, err := decryptTestContent(fileEncryptor, tamperedContent, wrappedDataKey); err == nil {
+			t.Errorf("expected %s file to fail decryption", name)
+		}
+	}
+}
+
+func TestEncryptorRejectsDataKeyOfAnotherMasterKey(t *testing.T) {
+	fileEncryptor := newTestEncryptor(t, "active", newTestMasterKey(t, "active"), newTestMasterKey(t, "other"))
+	content := newTestContent(t, 100)
+	storedContent, wrappedDataKey := encryptTestContent(t, fileEncryptor, content)
+
+	// The master key ID is authenticated along with the data key.
+	for _, masterKeyID := range []string{"other", "missing"} {
+		tamperedWrappedDataKey := WrappedDataKey{MasterKeyID: masterKeyID, Ciphertext: wrappedDataKey.Ciphertext}
+		if _, err := decryptTestContent(fileEncryptor, storedContent, tamperedWrappedDataKey); err == nil {
+			t.Fatalf("expected data key passed off as wrapped with master key %s to be rejected", masterKeyID)
+		}
+	}
+}
+
+func TestEncryptorRewrapKeepsFilesReadable(t *testing.T) {
+	oldMasterKey, newMasterKey := newTestMasterKey(t, "old"), newTestMasterKey(t, "new")
+	oldEncryptor := newTestEncryptor(t, "old", oldMasterKey)
+	content := newTestContent(t, encryptionChunkPlaintextByteCount+1)
+	storedContent, wrappedDataKey := encryptTestContent(t, oldEncryptor, content)
+
+	rotatedEncryptor := newTestEncryptor(t, "new", oldMasterKey, newMasterKey)
+	if !rotatedEncryptor.CanRewrapDataKeys() || !rotatedEncryptor.NeedsRewrapDataKey(wrappedDataKey) {
+		t.Fatalf("expected data key wrapped with the old master key to need a rewrap")
+	}
+
+	rewrappedDataKey, rewrapped, err := rotatedEncryptor.RewrapDataKey(wrappedDataKey)
+	if err != nil || !rewrapped || rewrappedDataKey.MasterKeyID != "new" {
+		t.Fatalf("failed to rewrap data key: %+v, %t, %v", rewrappedDataKey, rewrapped, err)
+	}
+
+	if _, rewrapped, _ = rotatedEncryptor.RewrapDataKey(rewrappedDataKey); rewrapped {
+		t.Fatalf("expected data key wrapped with the active master key not to be rewrapped")
+	}
+
+	// Once every data key is rewrapped, the old master key can be removed.
+	newEncryptor := newTestEncryptor(t, "new", newMasterKey)
+	decryptedContent, err := decryptTestContent(newEncryptor, storedContent, rewrappedDataKey)
+	if err != nil || !bytes.Equal(decryptedContent, content) {
+		t.Fatalf("failed to decrypt file after rewrapping its data key: %v", err)
+	}
+
+	if _, err = decryptTestContent(newEncryptor, storedContent, wrappedDataKey); err == nil {
+		t.Fatalf("expected data key wrapped with the removed master key to be rejected")
+	}
+}
+
+func TestEncryptorDisabled(t *testing.T) {
+	fileEncryptor, err := NewEncryptor(configs.Download{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create encryptor: %v", err)
+	}
+
+	content := newTestContent(t, 100)
+	storedContent, wrappedDataKey := encryptTestContent(t, fileEncryptor, content)
+	if !wrappedDataKey.IsEmpty() || !bytes.Equal(storedContent, content) {
+		t.Fatalf("expected content to be stored in plaintext when encryption is disabled")
+	}
+}
@@ -0,0 +1,146 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	gcsUploadChunkByteCount = 8 * 1024 * 1024
+)
+
+// GCSClient stores files as objects in a Google Cloud Storage bucket. Address overrides the JSON
+// API endpoint, which is mostly useful to point the client at an emulator such as
+// fake-gcs-server; in that case requests are sent without authentication unless a credentials
+// file is configured.
+type GCSClient struct {
+	bucketHandle *storage.BucketHandle
+	logger       *zap.Logger
+}
+
+func NewGCSClient(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (Client, error) {
+	return newGCSClient(downloadConfig, logger)
+}
+
+func newGCSClient(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (*GCSClient, error) {
+	clientOptionList := make([]option.ClientOption, 0)
+	if downloadConfig.Address != "" {
+		clientOptionList = append(clientOptionList, option.WithEndpoint(downloadConfig.Address))
+	}
+
+	switch {
+	case downloadConfig.CredentialsFile != "":
+		clientOptionList = append(clientOptionList, option.WithCredentialsFile(downloadConfig.CredentialsFile))
+	case downloadConfig.Address != "":
+		clientOptionList = append(clientOptionList, option.WithoutAuthentication())
+	}
+
+	storageClient, err := storage.NewClient(context.Background(), clientOptionList...)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create gcs client")
+		return nil, err
+	}
+
+	return &GCSClient{
+		bucketHandle: storageClient.Bucket(downloadConfig.Bucket),
+		logger:       logger,
+	}, nil
+}
+
+func (g GCSClient) Read(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	logger := utils.LoggerWithContext(ctx, g.logger).With(zap.String("file_path", filePath))
+
+	objectAttrs, err := g.bucketHandle.Object(filePath).Attrs(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get gcs object attributes")
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, status.Error(codes.NotFound, "gcs object not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get gcs object attributes")
+	}
+
+	// Every range is read from the same object generation, so that an object replaced in the
+	// middle of a read fails the read instead of returning a mix of both contents.
+	objectHandle := g.bucketHandle.Object(filePath).Generation(objectAttrs.Generation)
+
+	return newRangedObjectReadCloser(ctx, objectAttrs.Size, func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+		reader, err := objectHandle.NewRangeReader(ctx, offset, count)
+		if err != nil {
+			logger.With(zap.Int64("offset", offset)).With(zap.Error(err)).Error("failed to read gcs object range")
+			return nil, status.Error(codes.Internal, "failed to read gcs object range")
+		}
+
+		return reader, nil
+	}), nil
+}
+
+// gcsClientWriteCloser wraps a resumable upload, which sends the written data in chunks while it
+// is being written.
+type gcsClientWriteCloser struct {
+	writer *storage.Writer
+	logger *zap.Logger
+}
+
+func (g gcsClientWriteCloser) Write(p []byte) (int, error) {
+	return g.writer.Write(p)
+}
+
+func (g gcsClientWriteCloser) Close() error {
+	if err := g.writer.Close(); err != nil {
+		g.logger.With(zap.Error(err)).Error("failed to upload gcs object")
+		return status.Error(codes.Internal, "failed to upload gcs object")
+	}
+
+	return nil
+}
+
+func (g GCSClient) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
+	writer := g.bucketHandle.Object(filePath).NewWriter(ctx)
+	writer.ChunkSize = gcsUploadChunkByteCount
+
+	return gcsClientWriteCloser{
+		writer: writer,
+		logger: utils.LoggerWithContext(ctx, g.logger).With(zap.String("file_path", filePath)),
+	}, nil
+}
+
+func (g GCSClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, g.logger).With(zap.String("file_path", filePath))
+
+	err := g.bucketHandle.Object(filePath).Delete(ctx)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		logger.With(zap.Error(err)).Error("failed to delete gcs object")
+		return status.Error(codes.Internal, "failed to delete gcs object")
+	}
+
+	return nil
+}
+
+func (g GCSClient) move(ctx context.Context, sourceFilePath, destinationFilePath string) error {
+	logger := utils.LoggerWithContext(ctx, g.logger).
+		With(zap.String("source_file_path", sourceFilePath)).
+		With(zap.String("destination_file_path", destinationFilePath))
+
+	_, err := g.bucketHandle.Object(destinationFilePath).CopierFrom(g.bucketHandle.Object(sourceFilePath)).Run(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to copy gcs object")
+		return status.Error(codes.Internal, "failed to copy gcs object")
+	}
+
+	return g.Delete(ctx, sourceFilePath)
+}
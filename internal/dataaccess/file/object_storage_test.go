@@ -0,0 +1,179 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The object storage clients are tested against the emulators of deployments/docker-compose.dev.yaml.
+// The tests are skipped unless the address of the emulator is set, for example:
+//
+//	MORGANA_TEST_AZURITE_ADDRESS=http://127.0.0.1:10000/devstoreaccount1
+//	MORGANA_TEST_FAKE_GCS_SERVER_ADDRESS=http://127.0.0.1:4443/storage/v1/
+const (
+	azuriteAddressEnvName       = "MORGANA_TEST_AZURITE_ADDRESS"
+	fakeGCSServerAddressEnvName = "MORGANA_TEST_FAKE_GCS_SERVER_ADDRESS"
+
+	// Azurite accepts the well-known development storage account.
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+func getTestAddress(t *testing.T, envName string) string {
+	t.Helper()
+
+	address := os.Getenv(envName)
+	if address == "" {
+		t.Skipf("%s is not set", envName)
+	}
+
+	return address
+}
+
+func getTestBucketName() string {
+	return fmt.Sprintf("morgana-test-%d", time.Now().UnixNano())
+}
+
+func writeTestObject(ctx context.Context, client Client, filePath string, content []byte) error {
+	writeCloser, err := client.Write(ctx, filePath)
+	if err != nil {
+		return err
+	}
+
+	if _, err = writeCloser.Write(content); err != nil {
+		writeCloser.Close()
+		return err
+	}
+
+	return writeCloser.Close()
+}
+
+func readTestObject(ctx context.Context, client Client, filePath string) ([]byte, error) {
+	readCloser, err := client.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer readCloser.Close()
+
+	return io.ReadAll(readCloser)
+}
+
+// testStorageClient writes an object spanning several upload chunks and read ranges, then moves and
+// deletes it.
+func testStorageClient(t *testing.T, client storageClient) {
+	ctx := context.Background()
+
+	content := make([]byte, objectReadRangeByteCount+1234)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatalf("failed to generate content: %v", err)
+	}
+
+	if err := writeTestObject(ctx, client, "source", content); err != nil {
+		t.Fatalf("failed to write object: %v", err)
+	}
+
+	readContent, err := readTestObject(ctx, client, "source")
+	if err != nil {
+		t.Fatalf("failed to read object: %v", err)
+	}
+
+	if !bytes.Equal(readContent, content) {
+		t.Fatalf("read %d bytes that do not match the %d written bytes", len(readContent), len(content))
+	}
+
+	if err = client.move(ctx, "source", "destination"); err != nil {
+		t.Fatalf("failed to move object: %v", err)
+	}
+
+	if _, err = client.Read(ctx, "source"); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected moved object to be not found, got %v", err)
+	}
+
+	readContent, err = readTestObject(ctx, client, "destination")
+	if err != nil {
+		t.Fatalf("failed to read moved object: %v", err)
+	}
+
+	if !bytes.Equal(readContent, content) {
+		t.Fatalf("moved object does not match the written content")
+	}
+
+	if err = client.Delete(ctx, "destination"); err != nil {
+		t.Fatalf("failed to delete object: %v", err)
+	}
+
+	if _, err = client.Read(ctx, "destination"); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected deleted object to be not found, got %v", err)
+	}
+
+	if err = client.Delete(ctx, "destination"); err != nil {
+		t.Fatalf("expected deleting a missing object to succeed, got %v", err)
+	}
+}
+
+func TestAzureBlobClient(t *testing.T) {
+	downloadConfig := configs.Download{
+		Mode:     configs.DownloadModeAzureBlob,
+		Address:  getTestAddress(t, azuriteAddressEnvName),
+		Bucket:   getTestBucketName(),
+		Username: azuriteAccountName,
+		Password: azuriteAccountKey,
+	}
+
+	client, err := newAzureBlobClient(downloadConfig, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create azure blob client: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err = client.azblobClient.CreateContainer(ctx, downloadConfig.Bucket, nil); err != nil &&
+		!bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		t.Fatalf("failed to create container: %v", err)
+	}
+
+	t.Cleanup(func() {
+		client.azblobClient.DeleteContainer(context.Background(), downloadConfig.Bucket, nil)
+	})
+
+	testStorageClient(t, client)
+}
+
+func TestGCSClient(t *testing.T) {
+	downloadConfig := configs.Download{
+		Mode:    configs.DownloadModeGCS,
+		Address: getTestAddress(t, fakeGCSServerAddressEnvName),
+		Bucket:  getTestBucketName(),
+	}
+
+	client, err := newGCSClient(downloadConfig, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create gcs client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err = client.bucketHandle.Create(ctx, "morgana-test", nil); err != nil {
+		t.Fatalf("failed to create bucket: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := client.bucketHandle.Delete(context.Background()); err != nil && !errors.Is(err, storage.ErrBucketNotExist) {
+			t.Logf("failed to delete bucket: %v", err)
+		}
+	})
+
+	testStorageClient(t, client)
+}
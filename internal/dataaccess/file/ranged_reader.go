@@ -0,0 +1,86 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+const (
+	objectReadRangeByteCount = 8 * 1024 * 1024
+)
+
+type readObjectRangeFunc func(ctx context.Context, offset, count int64) (io.ReadCloser, error)
+
+// rangedObjectReadCloser reads an object as a sequence of ranged requests instead of a single
+// request for the whole object, so that a long running read does not depend on one connection
+// staying healthy, and every request only ever buffers a bounded part of the object.
+type rangedObjectReadCloser struct {
+	ctx                context.Context
+	readObjectRange    readObjectRangeFunc
+	objectSize         int64
+	offset             int64
+	rangeReadCloser    io.ReadCloser
+	rangeReadByteCount int64
+}
+
+func newRangedObjectReadCloser(
+	ctx context.Context,
+	objectSize int64,
+	readObjectRange readObjectRangeFunc,
+) io.ReadCloser {
+	return &rangedObjectReadCloser{
+		ctx:             ctx,
+		readObjectRange: readObjectRange,
+		objectSize:      objectSize,
+	}
+}
+
+func (r *rangedObjectReadCloser) Read(p []byte) (int, error) {
+	for {
+		if r.rangeReadCloser == nil {
+			if r.offset >= r.objectSize {
+				return 0, io.EOF
+			}
+
+			rangeReadCloser, err := r.readObjectRange(r.ctx, r.offset, min(objectReadRangeByteCount, r.objectSize-r.offset))
+			if err != nil {
+				return 0, err
+			}
+
+			r.rangeReadCloser = rangeReadCloser
+			r.rangeReadByteCount = 0
+		}
+
+		readByteCount, err := r.rangeReadCloser.Read(p)
+		r.offset += int64(readByteCount)
+		r.rangeReadByteCount += int64(readByteCount)
+		if err == nil {
+			return readByteCount, nil
+		}
+
+		if !errors.Is(err, io.EOF) {
+			return readByteCount, err
+		}
+
+		r.rangeReadCloser.Close()
+		r.rangeReadCloser = nil
+		if r.rangeReadByteCount == 0 && r.offset < r.objectSize {
+			return readByteCount, io.ErrUnexpectedEOF
+		}
+
+		if readByteCount > 0 {
+			return readByteCount, nil
+		}
+	}
+}
+
+func (r *rangedObjectReadCloser) Close() error {
+	if r.rangeReadCloser == nil {
+		return nil
+	}
+
+	err := r.rangeReadCloser.Close()
+	r.rangeReadCloser = nil
+	return err
+}
@@ -3,120 +3,79 @@ package consumer
 import (
 	"context"
 	"fmt"
-	"os"
-	"os/signal"
+	"time"
 
-	"github.com/IBM/sarama"
 	"github.com/hoangdv99/morgana/internal/configs"
-	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+const (
+	setupRetryBaseDelay = time.Second
+	setupRetryMaxDelay  = 30 * time.Second
+)
+
 type HandlerFunc func(ctx context.Context, queueName string, payload []byte) error
 
+// Consumer delivers the messages of every queue with a registered handler to that handler. A
+// message is only acknowledged once its handler returns without an error, and is delivered again
+// otherwise.
 type Consumer interface {
 	RegisterHandler(queueName string, handleFunc HandlerFunc)
+	// Start consumes messages until ctx is canceled, which is also passed to the handlers, and
+	// returns once the messages being handled at that time are done.
 	Start(ctx context.Context) error
 }
-type consumer struct {
-	saramaConsumer            sarama.ConsumerGroup
-	queueNameToHandlerFuncMap map[string]HandlerFunc
-	logger                    *zap.Logger
-}
-
-type consumerHandler struct {
-	handlerFunc       HandlerFunc
-	exitSignalChannel chan os.Signal
-}
-
-func newConsumerHanler(
-	handlerFunc HandlerFunc,
-	exitSignalChannel chan os.Signal,
-) *consumerHandler {
-	return &consumerHandler{
-		handlerFunc:       handlerFunc,
-		exitSignalChannel: exitSignalChannel,
-	}
-}
-
-func (h consumerHandler) Setup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case message, ok := <-claim.Messages():
-			if !ok {
-				session.Commit()
-				return nil
-			}
-
-			if err := h.handlerFunc(session.Context(), message.Topic, message.Value); err != nil {
-				return err
-			}
-
-		case <-h.exitSignalChannel:
-			session.Commit()
-			break
-		}
-	}
-}
-
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.ClientID = mqConfig.ClientID
-	saramaConfig.Metadata.Full = true
-
-	return saramaConfig
-}
 
 func NewConsumer(
 	mqConfig configs.MQ,
+	inMemoryBroker inmemory.Broker,
+	tracerProvider trace.TracerProvider,
 	logger *zap.Logger,
 ) (Consumer, error) {
-	saramaConsumer, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.ClientID, newSaramaConfig(mqConfig))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sarama consumer: %w", err)
+	switch mqConfig.Type {
+	case configs.MQTypeKafka, "":
+		return NewKafkaConsumer(mqConfig, tracerProvider, logger)
+	case configs.MQTypeInMemory:
+		return NewInMemoryConsumer(inMemoryBroker, logger), nil
+	case configs.MQTypeRedis:
+		return NewRedisConsumer(mqConfig, logger)
+	case configs.MQTypeNATS:
+		return NewNATSConsumer(mqConfig, logger)
+	default:
+		return nil, fmt.Errorf("unsupported mq type: %s", mqConfig.Type)
 	}
-
-	return &consumer{
-		saramaConsumer:            saramaConsumer,
-		logger:                    logger,
-		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
-	}, nil
 }
 
-func (c *consumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
-	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
-}
+// retrySetup calls setupFunc until it succeeds, so that a broker that is briefly unreachable when
+// the consumer starts does not stop a queue from being consumed. The delay between attempts
+// doubles with every failure, up to maxDelay. It returns the error of ctx if ctx is done first.
+func retrySetup(
+	ctx context.Context,
+	baseDelay time.Duration,
+	maxDelay time.Duration,
+	logger *zap.Logger,
+	setupFunc func(ctx context.Context) error,
+) error {
+	delay := baseDelay
+	for {
+		err := setupFunc(ctx)
+		if err == nil {
+			return nil
+		}
 
-func (c consumer) Start(ctx context.Context) error {
-	logger := utils.LoggerWithContext(ctx, c.logger)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 
-	exitSignalChannel := make(chan os.Signal, 1)
-	signal.Notify(exitSignalChannel, os.Interrupt)
+		logger.With(zap.Error(err)).With(zap.Duration("delay", delay)).Error("failed to set up queue, will retry")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
 
-	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
-		go func(queueName string, handlerFunc HandlerFunc) {
-			err := c.saramaConsumer.Consume(
-				context.Background(),
-				[]string{queueName},
-				newConsumerHanler(handlerFunc, exitSignalChannel),
-			)
-			if err != nil {
-				logger.
-					With(zap.String("queue_name", queueName)).
-					With(zap.Error(err)).
-					Error("failed to consume message from queue")
-			}
-		}(queueName, handlerFunc)
+		delay = min(2*delay, maxDelay)
 	}
-	<-exitSignalChannel
-
-	return nil
 }
@@ -0,0 +1,146 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	testDeliveryTimeout = 30 * time.Second
+	// testRedeliveryWaitTime is how long messages are watched for once they were handled, to check
+	// that they are not delivered again.
+	testRedeliveryWaitTime = time.Second
+)
+
+func getTestQueueName() string {
+	return fmt.Sprintf("morgana-test-%d", time.Now().UnixNano())
+}
+
+// testConsumerRedeliversFailedMessages checks the contract every consumer keeps: a message whose
+// handler fails is delivered again, a message that was handled is not, and Start returns once ctx
+// is done.
+func testConsumerRedeliversFailedMessages(
+	t *testing.T,
+	consumer Consumer,
+	produce func(ctx context.Context, queueName string, payload []byte) error,
+) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queueName := getTestQueueName()
+
+	var mutex sync.Mutex
+	payloadToDeliveryCountMap := make(map[string]int)
+	handledChannel := make(chan struct{}, 1)
+	consumer.RegisterHandler(queueName, func(_ context.Context, handlerQueueName string, payload []byte) error {
+		if handlerQueueName != queueName {
+			t.Errorf("expected a message of queue %q, got %q", queueName, handlerQueueName)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		payloadToDeliveryCountMap[string(payload)]++
+		select {
+		case handledChannel <- struct{}{}:
+		default:
+		}
+
+		if string(payload) == "failing" && payloadToDeliveryCountMap["failing"] == 1 {
+			return errors.New("handler failed")
+		}
+
+		return nil
+	})
+
+	startErrChannel := make(chan error, 1)
+	go func() {
+		startErrChannel <- consumer.Start(ctx)
+	}()
+
+	for _, payload := range []string{"failing", "succeeding"} {
+		if err := produce(ctx, queueName, []byte(payload)); err != nil {
+			t.Fatalf("failed to produce message: %v", err)
+		}
+	}
+
+	getDeliveryCount := func(payload string) int {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return payloadToDeliveryCountMap[payload]
+	}
+
+	deliveryTimer := time.NewTimer(testDeliveryTimeout)
+	defer deliveryTimer.Stop()
+	for getDeliveryCount("failing") < 2 || getDeliveryCount("succeeding") < 1 {
+		select {
+		case <-handledChannel:
+		case <-deliveryTimer.C:
+			t.Fatalf("timed out waiting for messages, the failed message was delivered %d times and the other %d times",
+				getDeliveryCount("failing"), getDeliveryCount("succeeding"))
+		}
+	}
+
+	time.Sleep(testRedeliveryWaitTime)
+	failingDeliveryCount, succeedingDeliveryCount := getDeliveryCount("failing"), getDeliveryCount("succeeding")
+	if failingDeliveryCount != 2 || succeedingDeliveryCount != 1 {
+		t.Fatalf("expected the failed message to be delivered twice and the other once, got %d and %d",
+			failingDeliveryCount, succeedingDeliveryCount)
+	}
+
+	cancel()
+	select {
+	case err := <-startErrChannel:
+		if err != nil {
+			t.Fatalf("expected the consumer to stop without an error, got %v", err)
+		}
+	case <-time.After(testDeliveryTimeout):
+		t.Fatal("timed out waiting for the consumer to stop")
+	}
+}
+
+func TestRetrySetupRetriesUntilSuccess(t *testing.T) {
+	attemptCount := 0
+	attemptTimeList := make([]time.Time, 0)
+	err := retrySetup(context.Background(), 10*time.Millisecond, 20*time.Millisecond, zap.NewNop(), func(context.Context) error {
+		attemptCount++
+		attemptTimeList = append(attemptTimeList, time.Now())
+		if attemptCount < 4 {
+			return errors.New("broker unreachable")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected setup to succeed, got %v", err)
+	}
+
+	if attemptCount != 4 {
+		t.Fatalf("expected 4 attempts, got %d", attemptCount)
+	}
+
+	for i, expectedDelay := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond} {
+		if delay := attemptTimeList[i+1].Sub(attemptTimeList[i]); delay < expectedDelay {
+			t.Fatalf("expected a delay of at least %s before attempt %d, got %s", expectedDelay, i+2, delay)
+		}
+	}
+}
+
+func TestRetrySetupStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := retrySetup(ctx, 10*time.Millisecond, 10*time.Millisecond, zap.NewNop(), func(context.Context) error {
+		return errors.New("broker unreachable")
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package consumer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	inMemoryRedeliveryDelay = time.Second
+)
+
+type inMemoryConsumer struct {
+	broker                    inmemory.Broker
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	logger                    *zap.Logger
+}
+
+func NewInMemoryConsumer(
+	broker inmemory.Broker,
+	logger *zap.Logger,
+) Consumer {
+	return &inMemoryConsumer{
+		broker:                    broker,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+		logger:                    logger,
+	}
+}
+
+func (c *inMemoryConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c inMemoryConsumer) consumeQueue(ctx context.Context, queueName string, handlerFunc HandlerFunc) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	for {
+		payload, err := c.broker.Receive(ctx, queueName)
+		if err != nil {
+			return
+		}
+
+		err = handlerFunc(ctx, queueName, payload)
+		metrics.MQConsumerLag.WithLabelValues(queueName, "").Set(float64(c.broker.Len(queueName)))
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to handle message, will redeliver it")
+
+			// The message is put back at the end of the queue after a delay, so that a message that
+			// keeps failing does not hold up the ones behind it.
+			time.AfterFunc(inMemoryRedeliveryDelay, func() {
+				c.broker.Publish(queueName, payload)
+			})
+		}
+	}
+}
+
+func (c inMemoryConsumer) Start(ctx context.Context) error {
+	var waitGroup sync.WaitGroup
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			c.consumeQueue(ctx, queueName, handlerFunc)
+		}()
+	}
+
+	waitGroup.Wait()
+	return nil
+}
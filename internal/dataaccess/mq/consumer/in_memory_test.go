@@ -0,0 +1,20 @@
+package consumer
+
+import (
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"go.uber.org/zap"
+)
+
+func TestInMemoryConsumerRedeliversFailedMessages(t *testing.T) {
+	broker := inmemory.NewBroker()
+	logger := zap.NewNop()
+
+	testConsumerRedeliversFailedMessages(
+		t,
+		NewInMemoryConsumer(broker, logger),
+		producer.NewInMemoryClient(broker, logger).Produce,
+	)
+}
@@ -0,0 +1,326 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/kafka"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	DeadLetterQueueNameSuffix = ".dlq"
+
+	DeadLetterHeaderError             = "x-error"
+	DeadLetterHeaderOriginalTopic     = "x-original-topic"
+	DeadLetterHeaderOriginalPartition = "x-original-partition"
+	DeadLetterHeaderOriginalOffset    = "x-original-offset"
+	DeadLetterHeaderAttemptCount      = "x-attempt-count"
+	DeadLetterHeaderFailedAt          = "x-failed-at"
+
+	kafkaConsumeErrorRetryDelay = time.Second
+)
+
+func GetDeadLetterQueueName(queueName string) string {
+	return queueName + DeadLetterQueueNameSuffix
+}
+
+// getKeyEncoder keeps messages without a key keyless when they are produced again, so that they
+// are still spread across partitions instead of all hashing to the partition of the empty key.
+func getKeyEncoder(key []byte) sarama.Encoder {
+	if key == nil {
+		return nil
+	}
+
+	return sarama.ByteEncoder(key)
+}
+
+type kafkaHandlerRetry struct {
+	maxAttemptCount int
+	baseDelay       time.Duration
+	maxDelay        time.Duration
+}
+
+func newKafkaHandlerRetry(handlerRetryConfig configs.MQHandlerRetry) (kafkaHandlerRetry, error) {
+	baseDelay, err := handlerRetryConfig.GetBaseDelayDuration()
+	if err != nil {
+		return kafkaHandlerRetry{}, fmt.Errorf("invalid handler retry base delay: %w", err)
+	}
+
+	maxDelay, err := handlerRetryConfig.GetMaxDelayDuration()
+	if err != nil {
+		return kafkaHandlerRetry{}, fmt.Errorf("invalid handler retry max delay: %w", err)
+	}
+
+	return kafkaHandlerRetry{
+		maxAttemptCount: max(handlerRetryConfig.MaxAttemptCount, 1),
+		baseDelay:       baseDelay,
+		maxDelay:        maxDelay,
+	}, nil
+}
+
+// getDelay returns how long to wait before the attempt after the given one. The delay doubles
+// with every failed attempt, up to the max delay.
+func (r kafkaHandlerRetry) getDelay(attemptCount int) time.Duration {
+	delay := r.baseDelay
+	for i := 1; i < attemptCount && delay < r.maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.maxDelay)
+}
+
+type kafkaConsumer struct {
+	mqConfig                  configs.MQ
+	saramaConsumer            sarama.ConsumerGroup
+	saramaDeadLetterProducer  sarama.SyncProducer
+	handlerRetry              kafkaHandlerRetry
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	tracerProvider            trace.TracerProvider
+	logger                    *zap.Logger
+}
+
+// consumerHandler handles the messages of all claimed partitions. A message is only marked as
+// consumed once it was handled, or moved to the dead letter queue after its handler kept failing,
+// so that messages that were not are consumed again by the next session.
+type consumerHandler struct {
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	deadLetterProducer        sarama.SyncProducer
+	handlerRetry              kafkaHandlerRetry
+	groupID                   string
+	tracerProvider            trace.TracerProvider
+	logger                    *zap.Logger
+}
+
+func (h consumerHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h consumerHandler) produceDeadLetterMessage(
+	message *sarama.ConsumerMessage,
+	attemptCount int,
+	handlerErr error,
+) error {
+	_, _, err := h.deadLetterProducer.SendMessage(&sarama.ProducerMessage{
+		Topic: GetDeadLetterQueueName(message.Topic),
+		Key:   getKeyEncoder(message.Key),
+		Value: sarama.ByteEncoder(message.Value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(DeadLetterHeaderError), Value: []byte(handlerErr.Error())},
+			{Key: []byte(DeadLetterHeaderOriginalTopic), Value: []byte(message.Topic)},
+			{Key: []byte(DeadLetterHeaderOriginalPartition), Value: []byte(strconv.FormatInt(int64(message.Partition), 10))},
+			{Key: []byte(DeadLetterHeaderOriginalOffset), Value: []byte(strconv.FormatInt(message.Offset, 10))},
+			{Key: []byte(DeadLetterHeaderAttemptCount), Value: []byte(strconv.Itoa(attemptCount))},
+			{Key: []byte(DeadLetterHeaderFailedAt), Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+		},
+	})
+	return err
+}
+
+func (h consumerHandler) handleMessage(ctx context.Context, message *sarama.ConsumerMessage) error {
+	ctx, span := kafka.StartConsumerSpan(ctx, h.tracerProvider, h.groupID, message)
+	defer span.End()
+
+	logger := utils.LoggerWithContext(ctx, h.logger).
+		With(zap.String("queue_name", message.Topic)).
+		With(zap.Int32("partition", message.Partition)).
+		With(zap.Int64("offset", message.Offset))
+
+	handlerFunc, ok := h.queueNameToHandlerFuncMap[message.Topic]
+	if !ok {
+		return fmt.Errorf("no handler registered for queue %s", message.Topic)
+	}
+
+	var err error
+	attemptCount := 0
+	for {
+		attemptCount++
+		err = handlerFunc(ctx, message.Topic, message.Value)
+		if err == nil {
+			return nil
+		}
+
+		if attemptCount >= h.handlerRetry.maxAttemptCount {
+			break
+		}
+
+		delay := h.handlerRetry.getDelay(attemptCount)
+		logger.
+			With(zap.Int("attempt_count", attemptCount)).
+			With(zap.Duration("delay", delay)).
+			With(zap.Error(err)).
+			Warn("failed to handle message, will retry")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	span.SetStatus(codes.Error, err.Error())
+	logger = logger.With(zap.Int("attempt_count", attemptCount)).With(zap.Error(err))
+	if deadLetterErr := h.produceDeadLetterMessage(message, attemptCount, err); deadLetterErr != nil {
+		logger.With(zap.NamedError("dead_letter_error", deadLetterErr)).Error("failed to move message to dead letter queue")
+		return deadLetterErr
+	}
+
+	logger.Error("failed to handle message, moved it to dead letter queue")
+	return nil
+}
+
+func (h consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	partition := strconv.FormatInt(int64(claim.Partition()), 10)
+	// The partition may be assigned to another member after a rebalance, which then reports its lag.
+	defer metrics.MQConsumerLag.DeleteLabelValues(claim.Topic(), partition)
+
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			if err := h.handleMessage(session.Context(), message); err != nil {
+				return err
+			}
+
+			session.MarkMessage(message, "")
+			metrics.MQConsumerLag.
+				WithLabelValues(claim.Topic(), partition).
+				Set(float64(claim.HighWaterMarkOffset() - message.Offset - 1))
+
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newSaramaConfig(mqConfig configs.MQ) (*sarama.Config, error) {
+	return kafka.NewSaramaConfig(mqConfig)
+}
+
+func newSaramaDeadLetterProducerConfig(mqConfig configs.MQ) (*sarama.Config, error) {
+	saramaConfig, err := newSaramaConfig(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = kafka.ApplySaramaProducerConfig(saramaConfig, mqConfig.Producer); err != nil {
+		return nil, err
+	}
+
+	return saramaConfig, nil
+}
+
+func NewKafkaConsumer(
+	mqConfig configs.MQ,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+) (Consumer, error) {
+	handlerRetry, err := newKafkaHandlerRetry(mqConfig.HandlerRetry)
+	if err != nil {
+		return nil, err
+	}
+
+	saramaConfig, err := newSaramaConfig(mqConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	saramaDeadLetterProducerConfig, err := newSaramaDeadLetterProducerConfig(mqConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	saramaConsumer, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.GetGroupID(), saramaConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sarama consumer: %w", err)
+	}
+
+	saramaDeadLetterProducer, err := sarama.NewSyncProducer(mqConfig.Addresses, saramaDeadLetterProducerConfig)
+	if err != nil {
+		saramaConsumer.Close()
+		return nil, fmt.Errorf("failed to create sarama dead letter producer: %w", err)
+	}
+
+	return &kafkaConsumer{
+		mqConfig:                  mqConfig,
+		saramaConsumer:            saramaConsumer,
+		saramaDeadLetterProducer:  saramaDeadLetterProducer,
+		handlerRetry:              handlerRetry,
+		tracerProvider:            tracerProvider,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *kafkaConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c kafkaConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	queueNameList := make([]string, 0, len(c.queueNameToHandlerFuncMap))
+	topicNameList := make([]string, 0, 2*len(c.queueNameToHandlerFuncMap))
+	for queueName := range c.queueNameToHandlerFuncMap {
+		queueNameList = append(queueNameList, queueName)
+		topicNameList = append(topicNameList, queueName, GetDeadLetterQueueName(queueName))
+	}
+
+	if err := kafka.CreateTopicList(c.mqConfig, topicNameList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create kafka topics")
+		return err
+	}
+
+	handler := consumerHandler{
+		queueNameToHandlerFuncMap: c.queueNameToHandlerFuncMap,
+		deadLetterProducer:        c.saramaDeadLetterProducer,
+		handlerRetry:              c.handlerRetry,
+		groupID:                   c.mqConfig.GetGroupID(),
+		tracerProvider:            c.tracerProvider,
+		logger:                    c.logger,
+	}
+
+	// Consume returns whenever the group is rebalanced, and has to be called again to rejoin it.
+	for len(queueNameList) > 0 && ctx.Err() == nil {
+		err := c.saramaConsumer.Consume(ctx, queueNameList, handler)
+		if err == nil || errors.Is(err, context.Canceled) {
+			continue
+		}
+
+		logger.
+			With(zap.Strings("queue_name_list", queueNameList)).
+			With(zap.Error(err)).
+			Error("failed to consume message from queue")
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(kafkaConsumeErrorRetryDelay):
+		}
+	}
+
+	<-ctx.Done()
+	if err := c.saramaConsumer.Close(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to close sarama consumer")
+	}
+
+	return c.saramaDeadLetterProducer.Close()
+}
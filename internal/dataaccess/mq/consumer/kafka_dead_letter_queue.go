@@ -0,0 +1,273 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// The replay progress of every dead letter queue is stored as the offsets of this consumer
+	// group, so that replayed messages are neither listed nor replayed again.
+	deadLetterQueueReplayGroupIDSuffix = "-dlq-replay"
+	// Reading a partition stops early when no message arrives for this long, which happens when
+	// the last offsets of the partition are not messages, such as transaction markers.
+	deadLetterQueueReadTimeout = 10 * time.Second
+)
+
+type DeadLetterMessage struct {
+	Partition int32             `json:"partition"`
+	Offset    int64             `json:"offset"`
+	Timestamp time.Time         `json:"timestamp"`
+	Headers   map[string]string `json:"headers"`
+	Payload   string            `json:"payload"`
+}
+
+// DeadLetterQueue inspects and replays the messages that Kafka consumers moved to the dead letter
+// queue of a queue after their handler kept failing.
+type DeadLetterQueue interface {
+	// GetMessageList returns up to limit messages of the dead letter queue that were not replayed
+	// yet, oldest first within every partition.
+	GetMessageList(ctx context.Context, queueName string, limit int) ([]DeadLetterMessage, error)
+	// Replay produces up to limit messages of the dead letter queue that were not replayed yet
+	// back to their original queue, and returns how many messages were replayed.
+	Replay(ctx context.Context, queueName string, limit int) (int, error)
+}
+
+type kafkaDeadLetterQueue struct {
+	saramaClient   sarama.Client
+	replayGroupID  string
+	saramaProducer sarama.SyncProducer
+	logger         *zap.Logger
+}
+
+func NewDeadLetterQueue(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (DeadLetterQueue, func(), error) {
+	if mqConfig.Type != configs.MQTypeKafka && mqConfig.Type != "" {
+		return nil, nil, fmt.Errorf("dead letter queues are not supported by mq type: %s", mqConfig.Type)
+	}
+
+	saramaConfig, err := newSaramaDeadLetterProducerConfig(mqConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	saramaClient, err := sarama.NewClient(mqConfig.Addresses, saramaConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sarama client: %w", err)
+	}
+
+	saramaProducer, err := sarama.NewSyncProducerFromClient(saramaClient)
+	if err != nil {
+		saramaClient.Close()
+		return nil, nil, fmt.Errorf("failed to create sarama sync producer: %w", err)
+	}
+
+	cleanup := func() {
+		saramaProducer.Close()
+		saramaClient.Close()
+	}
+
+	return &kafkaDeadLetterQueue{
+		saramaClient:   saramaClient,
+		replayGroupID:  mqConfig.GetGroupID() + deadLetterQueueReplayGroupIDSuffix,
+		saramaProducer: saramaProducer,
+		logger:         logger,
+	}, cleanup, nil
+}
+
+// forEachPendingMessage calls handleFunc with up to limit messages of the dead letter queue of a
+// queue that were not replayed yet. When markReplayed is set, messages are recorded as replayed
+// once handleFunc returns without an error.
+func (d kafkaDeadLetterQueue) forEachPendingMessage(
+	ctx context.Context,
+	queueName string,
+	limit int,
+	markReplayed bool,
+	handleFunc func(message *sarama.ConsumerMessage) error,
+) error {
+	deadLetterQueueName := GetDeadLetterQueueName(queueName)
+	partitionList, err := d.saramaClient.Partitions(deadLetterQueueName)
+	if err != nil {
+		return fmt.Errorf("failed to get partitions of dead letter queue: %w", err)
+	}
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(d.replayGroupID, d.saramaClient)
+	if err != nil {
+		return fmt.Errorf("failed to create offset manager: %w", err)
+	}
+	defer offsetManager.Close()
+
+	saramaConsumer, err := sarama.NewConsumerFromClient(d.saramaClient)
+	if err != nil {
+		return fmt.Errorf("failed to create sarama consumer: %w", err)
+	}
+	defer saramaConsumer.Close()
+
+	messageCount := 0
+	for _, partition := range partitionList {
+		if messageCount >= limit {
+			break
+		}
+
+		partitionMessageCount, err := d.forEachPendingPartitionMessage(
+			ctx,
+			offsetManager,
+			saramaConsumer,
+			deadLetterQueueName,
+			partition,
+			limit-messageCount,
+			markReplayed,
+			handleFunc,
+		)
+		messageCount += partitionMessageCount
+		if err != nil {
+			offsetManager.Commit()
+			return err
+		}
+	}
+
+	offsetManager.Commit()
+	return nil
+}
+
+func (d kafkaDeadLetterQueue) forEachPendingPartitionMessage(
+	ctx context.Context,
+	offsetManager sarama.OffsetManager,
+	saramaConsumer sarama.Consumer,
+	deadLetterQueueName string,
+	partition int32,
+	limit int,
+	markReplayed bool,
+	handleFunc func(message *sarama.ConsumerMessage) error,
+) (int, error) {
+	partitionOffsetManager, err := offsetManager.ManagePartition(deadLetterQueueName, partition)
+	if err != nil {
+		return 0, fmt.Errorf("failed to manage partition offset: %w", err)
+	}
+	defer partitionOffsetManager.Close()
+
+	oldestOffset, err := d.saramaClient.GetOffset(deadLetterQueueName, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get oldest offset: %w", err)
+	}
+
+	newestOffset, err := d.saramaClient.GetOffset(deadLetterQueueName, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get newest offset: %w", err)
+	}
+
+	// Messages that were not replayed before the retention of the topic expired them are gone.
+	nextOffset, _ := partitionOffsetManager.NextOffset()
+	nextOffset = max(nextOffset, oldestOffset)
+	if nextOffset >= newestOffset {
+		return 0, nil
+	}
+
+	partitionConsumer, err := saramaConsumer.ConsumePartition(deadLetterQueueName, partition, nextOffset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to consume partition: %w", err)
+	}
+	defer partitionConsumer.Close()
+
+	messageCount := 0
+	for messageCount < limit && nextOffset < newestOffset {
+		select {
+		case <-ctx.Done():
+			return messageCount, ctx.Err()
+		case <-time.After(deadLetterQueueReadTimeout):
+			return messageCount, nil
+		case consumerErr := <-partitionConsumer.Errors():
+			return messageCount, consumerErr
+		case message := <-partitionConsumer.Messages():
+			if err = handleFunc(message); err != nil {
+				return messageCount, err
+			}
+
+			if markReplayed {
+				partitionOffsetManager.MarkOffset(message.Offset+1, "")
+			}
+
+			nextOffset = message.Offset + 1
+			messageCount++
+		}
+	}
+
+	return messageCount, nil
+}
+
+func (d kafkaDeadLetterQueue) GetMessageList(ctx context.Context, queueName string, limit int) ([]DeadLetterMessage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", queueName))
+
+	messageList := make([]DeadLetterMessage, 0)
+	err := d.forEachPendingMessage(ctx, queueName, limit, false, func(message *sarama.ConsumerMessage) error {
+		headerMap := make(map[string]string)
+		for _, header := range message.Headers {
+			headerMap[string(header.Key)] = string(header.Value)
+		}
+
+		messageList = append(messageList, DeadLetterMessage{
+			Partition: message.Partition,
+			Offset:    message.Offset,
+			Timestamp: message.Timestamp,
+			Headers:   headerMap,
+			Payload:   string(message.Value),
+		})
+		return nil
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get dead letter message list")
+		return nil, err
+	}
+
+	return messageList, nil
+}
+
+func getDeadLetterMessageOriginalTopic(message *sarama.ConsumerMessage) string {
+	for _, header := range message.Headers {
+		if string(header.Key) == DeadLetterHeaderOriginalTopic && len(header.Value) > 0 {
+			return string(header.Value)
+		}
+	}
+
+	return strings.TrimSuffix(message.Topic, DeadLetterQueueNameSuffix)
+}
+
+func (d kafkaDeadLetterQueue) Replay(ctx context.Context, queueName string, limit int) (int, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", queueName))
+
+	replayedMessageCount := 0
+	err := d.forEachPendingMessage(ctx, queueName, limit, true, func(message *sarama.ConsumerMessage) error {
+		_, _, err := d.saramaProducer.SendMessage(&sarama.ProducerMessage{
+			Topic: getDeadLetterMessageOriginalTopic(message),
+			Key:   getKeyEncoder(message.Key),
+			Value: sarama.ByteEncoder(message.Value),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to replay message at offset %d of partition %d: %w", message.Offset, message.Partition, err)
+		}
+
+		replayedMessageCount++
+		return nil
+	})
+	if err != nil {
+		logger.
+			With(zap.Int("replayed_message_count", replayedMessageCount)).
+			With(zap.Error(err)).
+			Error("failed to replay dead letter messages")
+		return replayedMessageCount, err
+	}
+
+	logger.With(zap.Int("replayed_message_count", replayedMessageCount)).Info("replayed dead letter messages")
+	return replayedMessageCount, nil
+}
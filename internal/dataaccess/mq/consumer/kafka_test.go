@@ -0,0 +1,45 @@
+package consumer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+)
+
+func TestKafkaHandlerRetryDefaults(t *testing.T) {
+	// Configs written before handler retries existed have no handler_retry section.
+	handlerRetry, err := newKafkaHandlerRetry(configs.MQHandlerRetry{})
+	if err != nil {
+		t.Fatalf("failed to create handler retry without config: %v", err)
+	}
+
+	if handlerRetry.maxAttemptCount != 1 || handlerRetry.baseDelay != time.Second || handlerRetry.maxDelay != 30*time.Second {
+		t.Fatalf("unexpected default handler retry %+v", handlerRetry)
+	}
+}
+
+func TestKafkaHandlerRetryDelay(t *testing.T) {
+	handlerRetry, err := newKafkaHandlerRetry(configs.MQHandlerRetry{
+		MaxAttemptCount: 5,
+		BaseDelay:       "1s",
+		MaxDelay:        "5s",
+	})
+	if err != nil {
+		t.Fatalf("failed to create handler retry: %v", err)
+	}
+
+	for attemptCount, expectedDelay := range []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if attemptCount == 0 {
+			continue
+		}
+
+		if delay := handlerRetry.getDelay(attemptCount); delay != expectedDelay {
+			t.Fatalf("expected delay %s after attempt %d, got %s", expectedDelay, attemptCount, delay)
+		}
+	}
+
+	if _, err = newKafkaHandlerRetry(configs.MQHandlerRetry{BaseDelay: "soon"}); err == nil {
+		t.Fatalf("expected an invalid base delay to be rejected")
+	}
+}
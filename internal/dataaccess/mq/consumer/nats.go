@@ -0,0 +1,137 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+const (
+	natsRedeliveryDelay = time.Second
+	// Messages whose handler takes longer than this are delivered again.
+	natsAckWait = 5 * time.Minute
+)
+
+// natsConsumer reads the JetStream stream of every queue through a durable consumer named after
+// the group ID, so that each message is handled by one of the processes sharing the consumer.
+type natsConsumer struct {
+	natsConnection            *nats.Conn
+	jetStream                 jetstream.JetStream
+	durableName               string
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	logger                    *zap.Logger
+}
+
+func NewNATSConsumer(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Consumer, error) {
+	natsConnection, err := producer.NewNATSConnection(mqConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	jetStream, err := jetstream.New(natsConnection)
+	if err != nil {
+		natsConnection.Close()
+		return nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	return &natsConsumer{
+		natsConnection:            natsConnection,
+		jetStream:                 jetStream,
+		durableName:               mqConfig.GetGroupID(),
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+		logger:                    logger,
+	}, nil
+}
+
+func (c *natsConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c natsConsumer) consumeQueue(
+	ctx context.Context,
+	queueName string,
+	handlerFunc HandlerFunc,
+) (jetstream.ConsumeContext, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	if err := producer.CreateOrUpdateNATSStream(ctx, c.jetStream, queueName); err != nil {
+		return nil, fmt.Errorf("failed to create nats stream: %w", err)
+	}
+
+	jetStreamConsumer, err := c.jetStream.CreateOrUpdateConsumer(ctx, producer.GetNATSStreamName(queueName), jetstream.ConsumerConfig{
+		Durable:       c.durableName,
+		FilterSubject: queueName,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       natsAckWait,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nats consumer: %w", err)
+	}
+
+	return jetStreamConsumer.Consume(func(message jetstream.Msg) {
+		// Every stream has a single subject, so its messages are not split into partitions.
+		if metadata, err := message.Metadata(); err == nil {
+			metrics.MQConsumerLag.WithLabelValues(queueName, "").Set(float64(metadata.NumPending))
+		}
+
+		if err := handlerFunc(ctx, queueName, message.Data()); err != nil {
+			logger.With(zap.Error(err)).Error("failed to handle message, will redeliver it")
+			if err = message.NakWithDelay(natsRedeliveryDelay); err != nil {
+				logger.With(zap.Error(err)).Error("failed to negatively acknowledge message")
+			}
+
+			return
+		}
+
+		if err := message.Ack(); err != nil {
+			logger.With(zap.Error(err)).Error("failed to acknowledge message")
+		}
+	})
+}
+
+// consumeQueueUntilDone consumes a queue until ctx is done, and returns once the messages being
+// handled at that time are done.
+func (c natsConsumer) consumeQueueUntilDone(ctx context.Context, queueName string, handlerFunc HandlerFunc) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	var consumeContext jetstream.ConsumeContext
+	if err := retrySetup(ctx, setupRetryBaseDelay, setupRetryMaxDelay, logger, func(ctx context.Context) error {
+		var err error
+		consumeContext, err = c.consumeQueue(ctx, queueName, handlerFunc)
+		return err
+	}); err != nil {
+		return
+	}
+
+	<-ctx.Done()
+
+	// Messages are only stopped from being fetched, so the ones being handled are waited for.
+	consumeContext.Stop()
+	<-consumeContext.Closed()
+}
+
+func (c natsConsumer) Start(ctx context.Context) error {
+	var waitGroup sync.WaitGroup
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			c.consumeQueueUntilDone(ctx, queueName, handlerFunc)
+		}()
+	}
+
+	waitGroup.Wait()
+	return c.natsConnection.Drain()
+}
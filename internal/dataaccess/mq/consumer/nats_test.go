@@ -0,0 +1,31 @@
+package consumer
+
+import (
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"go.uber.org/zap"
+)
+
+func TestNATSConsumerRedeliversFailedMessages(t *testing.T) {
+	logger := zap.NewNop()
+	mqConfig := configs.MQ{
+		Type:      configs.MQTypeNATS,
+		Addresses: []string{getTestAddress(t, natsAddressEnvName)},
+		ClientID:  "morgana-test",
+	}
+
+	consumer, err := NewNATSConsumer(mqConfig, logger)
+	if err != nil {
+		t.Fatalf("failed to create consumer: %v", err)
+	}
+
+	producerClient, cleanup, err := producer.NewNATSClient(mqConfig, logger)
+	if err != nil {
+		t.Fatalf("failed to create producer: %v", err)
+	}
+	defer cleanup()
+
+	testConsumerRedeliversFailedMessages(t, consumer, producerClient.Produce)
+}
@@ -0,0 +1,250 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	redisReadMessageCount = 16
+	redisReadBlockTimeout = 5 * time.Second
+	// Messages that stay unacknowledged for this long, because their handler failed or their
+	// consumer stopped, are claimed and delivered again.
+	redisRedeliveryMinIdleTime = 30 * time.Second
+	redisReadErrorRetryDelay   = time.Second
+)
+
+// redisConsumer reads the Redis stream of every queue through a consumer group named after the
+// group ID, so that each message is handled by one of the consumers in the group.
+type redisConsumer struct {
+	redisClient               *redis.Client
+	groupName                 string
+	consumerName              string
+	redeliveryMinIdleTime     time.Duration
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	logger                    *zap.Logger
+}
+
+func NewRedisConsumer(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Consumer, error) {
+	if len(mqConfig.Addresses) == 0 {
+		return nil, errors.New("no redis address configured")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	return &redisConsumer{
+		redisClient: redis.NewClient(&redis.Options{
+			Addr:     mqConfig.Addresses[0],
+			Username: mqConfig.Username,
+			Password: mqConfig.Password,
+		}),
+		groupName:                 mqConfig.GetGroupID(),
+		consumerName:              fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		redeliveryMinIdleTime:     redisRedeliveryMinIdleTime,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+		logger:                    logger,
+	}, nil
+}
+
+func (c *redisConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c redisConsumer) createGroup(ctx context.Context, queueName string) error {
+	// The group starts from the beginning of the stream, so that messages produced before the
+	// group was first created are not skipped.
+	err := c.redisClient.XGroupCreateMkStream(ctx, queueName, c.groupName, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	return nil
+}
+
+func (c redisConsumer) handleMessage(
+	ctx context.Context,
+	queueName string,
+	handlerFunc HandlerFunc,
+	message redis.XMessage,
+) {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.String("message_id", message.ID))
+
+	payload, ok := message.Values[producer.RedisStreamPayloadFieldName].(string)
+	if !ok {
+		logger.Error("message has no payload, will acknowledge it")
+	} else if err := handlerFunc(ctx, queueName, []byte(payload)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to handle message, will redeliver it")
+		return
+	}
+
+	if err := c.redisClient.XAck(ctx, queueName, c.groupName, message.ID).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to acknowledge message")
+	}
+}
+
+func (c redisConsumer) readMessageList(ctx context.Context, queueName string) ([]redis.XMessage, error) {
+	claimedMessageList, _, err := c.redisClient.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   queueName,
+		Group:    c.groupName,
+		MinIdle:  c.redeliveryMinIdleTime,
+		Start:    "0-0",
+		Count:    redisReadMessageCount,
+		Consumer: c.consumerName,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(claimedMessageList) > 0 {
+		return claimedMessageList, nil
+	}
+
+	streamList, err := c.redisClient.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.groupName,
+		Consumer: c.consumerName,
+		Streams:  []string{queueName, ">"},
+		Count:    redisReadMessageCount,
+		Block:    redisReadBlockTimeout,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	messageList := make([]redis.XMessage, 0)
+	for _, stream := range streamList {
+		messageList = append(messageList, stream.Messages...)
+	}
+
+	return messageList, nil
+}
+
+// getLag returns the number of messages of the stream not delivered to the group yet. Redis only
+// reports it from version 7.0, and not at all while it cannot be determined from the stream alone,
+// such as after entries in the middle of the stream were deleted.
+func (c redisConsumer) getLag(ctx context.Context, queueName string) (int64, bool, error) {
+	groupList, err := c.redisClient.Do(ctx, "XINFO", "GROUPS", queueName).Slice()
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, group := range groupList {
+		fieldList, ok := group.([]interface{})
+		if !ok {
+			continue
+		}
+
+		fieldMap := make(map[string]interface{})
+		for i := 0; i+1 < len(fieldList); i += 2 {
+			if fieldName, ok := fieldList[i].(string); ok {
+				fieldMap[fieldName] = fieldList[i+1]
+			}
+		}
+
+		if fieldMap["name"] != c.groupName {
+			continue
+		}
+
+		lag, ok := fieldMap["lag"].(int64)
+		return lag, ok, nil
+	}
+
+	return 0, false, nil
+}
+
+func (c redisConsumer) reportLag(ctx context.Context, queueName string) {
+	lag, ok, err := c.getLag(ctx, queueName)
+	if err != nil {
+		if ctx.Err() == nil {
+			utils.LoggerWithContext(ctx, c.logger).
+				With(zap.String("queue_name", queueName)).
+				With(zap.Error(err)).
+				Warn("failed to get consumer lag")
+		}
+
+		return
+	}
+
+	if ok {
+		metrics.MQConsumerLag.WithLabelValues(queueName, "").Set(float64(lag))
+	}
+}
+
+func (c redisConsumer) consumeQueue(ctx context.Context, queueName string, handlerFunc HandlerFunc) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	if err := retrySetup(ctx, setupRetryBaseDelay, setupRetryMaxDelay, logger, func(ctx context.Context) error {
+		return c.createGroup(ctx, queueName)
+	}); err != nil {
+		return
+	}
+
+	for ctx.Err() == nil {
+		messageList, err := c.readMessageList(ctx, queueName)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			logger.With(zap.Error(err)).Error("failed to read messages from queue")
+
+			// The group is gone if Redis restarted without persistence, or if the stream was
+			// deleted, and has to be created again for messages to be read.
+			if strings.HasPrefix(err.Error(), "NOGROUP") {
+				if err = c.createGroup(ctx, queueName); err != nil {
+					logger.With(zap.Error(err)).Error("failed to create redis consumer group")
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(redisReadErrorRetryDelay):
+			}
+
+			continue
+		}
+
+		for _, message := range messageList {
+			c.handleMessage(ctx, queueName, handlerFunc, message)
+		}
+
+		c.reportLag(ctx, queueName)
+	}
+}
+
+func (c redisConsumer) Start(ctx context.Context) error {
+	var waitGroup sync.WaitGroup
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			c.consumeQueue(ctx, queueName, handlerFunc)
+		}()
+	}
+
+	waitGroup.Wait()
+	return c.redisClient.Close()
+}
@@ -0,0 +1,89 @@
+package consumer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+// The Redis and NATS consumers are tested against the servers of deployments/docker-compose.dev.yaml.
+// The tests are skipped unless the address of the server is set, for example:
+//
+//	MORGANA_TEST_REDIS_ADDRESS=127.0.0.1:6379
+//	MORGANA_TEST_NATS_ADDRESS=nats://127.0.0.1:4222
+const (
+	redisAddressEnvName = "MORGANA_TEST_REDIS_ADDRESS"
+	natsAddressEnvName  = "MORGANA_TEST_NATS_ADDRESS"
+)
+
+func getTestAddress(t *testing.T, envName string) string {
+	t.Helper()
+
+	address := os.Getenv(envName)
+	if address == "" {
+		t.Skipf("%s is not set", envName)
+	}
+
+	return address
+}
+
+func TestRedisConsumerRedeliversFailedMessages(t *testing.T) {
+	logger := zap.NewNop()
+	mqConfig := configs.MQ{
+		Type:      configs.MQTypeRedis,
+		Addresses: []string{getTestAddress(t, redisAddressEnvName)},
+		ClientID:  "morgana-test",
+	}
+
+	consumer, err := NewRedisConsumer(mqConfig, logger)
+	if err != nil {
+		t.Fatalf("failed to create consumer: %v", err)
+	}
+
+	// Failed messages are claimed by XAUTOCLAIM once they have been idle for this long.
+	consumer.(*redisConsumer).redeliveryMinIdleTime = 100 * time.Millisecond
+
+	producerClient, cleanup, err := producer.NewRedisClient(mqConfig, noop.NewTracerProvider(), logger)
+	if err != nil {
+		t.Fatalf("failed to create producer: %v", err)
+	}
+	defer cleanup()
+
+	testConsumerRedeliversFailedMessages(t, consumer, producerClient.Produce)
+}
+
+func TestRedisConsumerStopsWhileBrokerIsUnreachable(t *testing.T) {
+	// Nothing listens on the port, so creating the consumer group keeps being retried.
+	consumer, err := NewRedisConsumer(configs.MQ{Addresses: []string{"127.0.0.1:1"}}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create consumer: %v", err)
+	}
+
+	consumer.RegisterHandler(getTestQueueName(), func(context.Context, string, []byte) error {
+		t.Error("expected no message to be handled")
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	startErrChannel := make(chan error, 1)
+	go func() {
+		startErrChannel <- consumer.Start(ctx)
+	}()
+
+	select {
+	case err = <-startErrChannel:
+		if err != nil {
+			t.Fatalf("expected the consumer to stop without an error, got %v", err)
+		}
+	case <-time.After(testDeliveryTimeout):
+		t.Fatal("timed out waiting for the consumer to stop")
+	}
+}
@@ -0,0 +1,120 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+)
+
+// Broker passes messages between the producer and the consumer of the in-memory queue type. A
+// single Broker has to be shared by both for messages to be delivered.
+type Broker interface {
+	Publish(queueName string, payload []byte)
+	// Receive blocks until a message is available in the queue, or the context is done.
+	Receive(ctx context.Context, queueName string) ([]byte, error)
+	// Len returns the number of messages in the queue that have not been received yet.
+	Len(queueName string) int
+}
+
+type queue struct {
+	mutex       sync.Mutex
+	payloadList [][]byte
+	// notEmptyChannel is signaled whenever a message is added, so that a waiting receiver can
+	// pick it up.
+	notEmptyChannel chan struct{}
+}
+
+func newQueue() *queue {
+	return &queue{
+		payloadList:     make([][]byte, 0),
+		notEmptyChannel: make(chan struct{}, 1),
+	}
+}
+
+func (q *queue) signalNotEmpty() {
+	select {
+	case q.notEmptyChannel <- struct{}{}:
+	default:
+	}
+}
+
+func (q *queue) push(payload []byte) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.payloadList = append(q.payloadList, payload)
+	q.signalNotEmpty()
+}
+
+func (q *queue) len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return len(q.payloadList)
+}
+
+func (q *queue) pop() ([]byte, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if len(q.payloadList) == 0 {
+		return nil, false
+	}
+
+	payload := q.payloadList[0]
+	q.payloadList[0] = nil
+	q.payloadList = q.payloadList[1:]
+
+	// Other receivers may be waiting for the messages left in the queue.
+	if len(q.payloadList) > 0 {
+		q.signalNotEmpty()
+	}
+
+	return payload, true
+}
+
+type broker struct {
+	mutex               sync.Mutex
+	queueNameToQueueMap map[string]*queue
+}
+
+func NewBroker() Broker {
+	return &broker{
+		queueNameToQueueMap: make(map[string]*queue),
+	}
+}
+
+func (b *broker) getQueue(queueName string) *queue {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	q, ok := b.queueNameToQueueMap[queueName]
+	if !ok {
+		q = newQueue()
+		b.queueNameToQueueMap[queueName] = q
+	}
+
+	return q
+}
+
+func (b *broker) Publish(queueName string, payload []byte) {
+	b.getQueue(queueName).push(payload)
+}
+
+func (b *broker) Len(queueName string) int {
+	return b.getQueue(queueName).len()
+}
+
+func (b *broker) Receive(ctx context.Context, queueName string) ([]byte, error) {
+	q := b.getQueue(queueName)
+	for {
+		if payload, ok := q.pop(); ok {
+			return payload, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-q.notEmptyChannel:
+		}
+	}
+}
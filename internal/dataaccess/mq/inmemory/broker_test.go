@@ -0,0 +1,117 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBrokerDeliversMessagesInOrder(t *testing.T) {
+	broker := NewBroker()
+	for _, payload := range []string{"first", "second"} {
+		broker.Publish("queue", []byte(payload))
+	}
+
+	if length := broker.Len("queue"); length != 2 {
+		t.Fatalf("expected 2 messages in the queue, got %d", length)
+	}
+
+	if length := broker.Len("other-queue"); length != 0 {
+		t.Fatalf("expected no message in another queue, got %d", length)
+	}
+
+	for _, expectedPayload := range []string{"first", "second"} {
+		payload, err := broker.Receive(context.Background(), "queue")
+		if err != nil {
+			t.Fatalf("failed to receive message: %v", err)
+		}
+
+		if string(payload) != expectedPayload {
+			t.Fatalf("expected message %q, got %q", expectedPayload, payload)
+		}
+	}
+
+	if length := broker.Len("queue"); length != 0 {
+		t.Fatalf("expected the received messages to leave the queue, got %d left", length)
+	}
+}
+
+func TestBrokerReceiveWaitsForMessage(t *testing.T) {
+	broker := NewBroker()
+
+	payloadChannel := make(chan []byte, 1)
+	go func() {
+		payload, err := broker.Receive(context.Background(), "queue")
+		if err != nil {
+			t.Errorf("failed to receive message: %v", err)
+		}
+
+		payloadChannel <- payload
+	}()
+
+	select {
+	case payload := <-payloadChannel:
+		t.Fatalf("expected Receive to wait for a message, got %q", payload)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	broker.Publish("queue", []byte("message"))
+
+	select {
+	case payload := <-payloadChannel:
+		if string(payload) != "message" {
+			t.Fatalf("expected the published message, got %q", payload)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the message")
+	}
+}
+
+func TestBrokerDeliversEachMessageToOneReceiver(t *testing.T) {
+	broker := NewBroker()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	const messageCount = 100
+	payloadChannel := make(chan string, messageCount)
+	for range 4 {
+		go func() {
+			for {
+				payload, err := broker.Receive(ctx, "queue")
+				if err != nil {
+					return
+				}
+
+				payloadChannel <- string(payload)
+			}
+		}()
+	}
+
+	for i := range messageCount {
+		broker.Publish("queue", []byte{byte(i)})
+	}
+
+	receivedPayloadSet := make(map[string]bool)
+	for range messageCount {
+		select {
+		case payload := <-payloadChannel:
+			if receivedPayloadSet[payload] {
+				t.Fatalf("expected message %v to be received once", []byte(payload))
+			}
+
+			receivedPayloadSet[payload] = true
+		case <-ctx.Done():
+			t.Fatalf("timed out after receiving %d of %d messages", len(receivedPayloadSet), messageCount)
+		}
+	}
+}
+
+func TestBrokerReceiveStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := NewBroker().Receive(ctx, "queue"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the context error, got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package inmemory
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewBroker,
+)
@@ -0,0 +1,130 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/IBM/sarama"
+	"github.com/hoangdv99/morgana/internal/configs"
+)
+
+func newTLSConfig(tlsConfig configs.MQTLS) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: tlsConfig.InsecureSkipVerify, //nolint:gosec // Only skipped when explicitly configured, such as for test clusters
+	}
+
+	if tlsConfig.CAFile != "" {
+		caBytes, err := os.ReadFile(tlsConfig.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca file: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caBytes) {
+			return nil, errors.New("no certificate found in ca file")
+		}
+
+		config.RootCAs = certPool
+	}
+
+	if tlsConfig.CertFile != "" && tlsConfig.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(tlsConfig.CertFile, tlsConfig.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
+
+func applySASLConfig(saramaConfig *sarama.Config, mqConfig configs.MQ) error {
+	switch mqConfig.SASLMechanism {
+	case "":
+		return nil
+	case configs.MQSASLMechanismPlain:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case configs.MQSASLMechanismSCRAMSHA256:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMSHA256Client
+	case configs.MQSASLMechanismSCRAMSHA512:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMSHA512Client
+	default:
+		return fmt.Errorf("unsupported sasl mechanism: %s", mqConfig.SASLMechanism)
+	}
+
+	saramaConfig.Net.SASL.Enable = true
+	saramaConfig.Net.SASL.Handshake = true
+	saramaConfig.Net.SASL.User = mqConfig.Username
+	saramaConfig.Net.SASL.Password = mqConfig.Password
+	return nil
+}
+
+// NewSaramaConfig returns the sarama config shared by all Kafka clients, with the connection
+// settings of the mq config applied.
+func NewSaramaConfig(mqConfig configs.MQ) (*sarama.Config, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = mqConfig.ClientID
+	saramaConfig.Metadata.Full = true
+
+	if mqConfig.TLS.Enabled {
+		tlsConfig, err := newTLSConfig(mqConfig.TLS)
+		if err != nil {
+			return nil, err
+		}
+
+		saramaConfig.Net.TLS.Enable = true
+		saramaConfig.Net.TLS.Config = tlsConfig
+	}
+
+	if err := applySASLConfig(saramaConfig, mqConfig); err != nil {
+		return nil, err
+	}
+
+	return saramaConfig, nil
+}
+
+func getCompressionCodec(compression configs.MQCompression) (sarama.CompressionCodec, error) {
+	switch compression {
+	case configs.MQCompressionNone, "":
+		return sarama.CompressionNone, nil
+	case configs.MQCompressionGZIP:
+		return sarama.CompressionGZIP, nil
+	case configs.MQCompressionSnappy:
+		return sarama.CompressionSnappy, nil
+	case configs.MQCompressionLZ4:
+		return sarama.CompressionLZ4, nil
+	case configs.MQCompressionZSTD:
+		return sarama.CompressionZSTD, nil
+	default:
+		return sarama.CompressionNone, fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+// ApplySaramaProducerConfig sets up a sarama config for synchronous producers that wait for all
+// in-sync replicas to acknowledge every message.
+func ApplySaramaProducerConfig(saramaConfig *sarama.Config, producerConfig configs.MQProducer) error {
+	compressionCodec, err := getCompressionCodec(producerConfig.Compression)
+	if err != nil {
+		return err
+	}
+
+	saramaConfig.Producer.Retry.Max = 1
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	saramaConfig.Producer.Compression = compressionCodec
+
+	if producerConfig.Idempotent {
+		// Idempotence is only guaranteed with a single request in flight per connection.
+		saramaConfig.Producer.Idempotent = true
+		saramaConfig.Net.MaxOpenRequests = 1
+	}
+
+	return nil
+}
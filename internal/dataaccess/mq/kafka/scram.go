@@ -0,0 +1,41 @@
+package kafka
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// scramClient adapts a SCRAM conversation to the interface sarama authenticates with.
+type scramClient struct {
+	hashGeneratorFunc scram.HashGeneratorFcn
+	conversation      *scram.ClientConversation
+}
+
+func newSCRAMSHA256Client() sarama.SCRAMClient {
+	return &scramClient{hashGeneratorFunc: sha256.New}
+}
+
+func newSCRAMSHA512Client() sarama.SCRAMClient {
+	return &scramClient{hashGeneratorFunc: sha512.New}
+}
+
+func (s *scramClient) Begin(userName, password, authzID string) error {
+	client, err := s.hashGeneratorFunc.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+
+	s.conversation = client.NewConversation()
+	return nil
+}
+
+func (s *scramClient) Step(challenge string) (string, error) {
+	return s.conversation.Step(challenge)
+}
+
+func (s *scramClient) Done() bool {
+	return s.conversation.Done()
+}
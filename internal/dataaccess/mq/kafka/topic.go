@@ -0,0 +1,50 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/hoangdv99/morgana/internal/configs"
+)
+
+// CreateTopicList creates the topics in the list that do not exist yet, with the partition count
+// and replication factor of the topic config. It does nothing unless auto creation is enabled.
+func CreateTopicList(mqConfig configs.MQ, topicNameList []string) error {
+	if !mqConfig.Topic.AutoCreate || len(topicNameList) == 0 {
+		return nil
+	}
+
+	saramaConfig, err := NewSaramaConfig(mqConfig)
+	if err != nil {
+		return err
+	}
+
+	clusterAdmin, err := sarama.NewClusterAdmin(mqConfig.Addresses, saramaConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create sarama cluster admin: %w", err)
+	}
+	defer clusterAdmin.Close()
+
+	topicNameToTopicDetailMap, err := clusterAdmin.ListTopics()
+	if err != nil {
+		return fmt.Errorf("failed to list topics: %w", err)
+	}
+
+	for _, topicName := range topicNameList {
+		if _, ok := topicNameToTopicDetailMap[topicName]; ok {
+			continue
+		}
+
+		err = clusterAdmin.CreateTopic(topicName, &sarama.TopicDetail{
+			NumPartitions:     max(mqConfig.Topic.PartitionCount, 1),
+			ReplicationFactor: max(mqConfig.Topic.ReplicationFactor, 1),
+		}, false)
+		// Another client may have created the topic in the meantime.
+		if err != nil && !errors.Is(err, sarama.ErrTopicAlreadyExists) {
+			return fmt.Errorf("failed to create topic %s: %w", topicName, err)
+		}
+	}
+
+	return nil
+}
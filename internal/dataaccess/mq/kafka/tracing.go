@@ -0,0 +1,131 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/hoangdv99/morgana/internal/dataaccess/mq/kafka"
+)
+
+// producerMessageCarrier lets the trace context be written into the headers of messages to be
+// produced.
+type producerMessageCarrier struct {
+	message *sarama.ProducerMessage
+}
+
+func (c producerMessageCarrier) Get(key string) string {
+	for _, header := range c.message.Headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c producerMessageCarrier) Set(key string, value string) {
+	for i := range c.message.Headers {
+		if string(c.message.Headers[i].Key) == key {
+			c.message.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+
+	c.message.Headers = append(c.message.Headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c producerMessageCarrier) Keys() []string {
+	keyList := make([]string, 0, len(c.message.Headers))
+	for _, header := range c.message.Headers {
+		keyList = append(keyList, string(header.Key))
+	}
+
+	return keyList
+}
+
+// consumerMessageCarrier lets the trace context be read from the headers of consumed messages.
+type consumerMessageCarrier struct {
+	message *sarama.ConsumerMessage
+}
+
+func (c consumerMessageCarrier) Get(key string) string {
+	for _, header := range c.message.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c consumerMessageCarrier) Set(string, string) {}
+
+func (c consumerMessageCarrier) Keys() []string {
+	keyList := make([]string, 0, len(c.message.Headers))
+	for _, header := range c.message.Headers {
+		if header != nil {
+			keyList = append(keyList, string(header.Key))
+		}
+	}
+
+	return keyList
+}
+
+var (
+	_ propagation.TextMapCarrier = producerMessageCarrier{}
+	_ propagation.TextMapCarrier = consumerMessageCarrier{}
+)
+
+// StartProducerSpan starts the span of producing message, and writes its context into the
+// headers of message so that the consumer continues the trace. The caller must end the span.
+func StartProducerSpan(
+	ctx context.Context,
+	tracerProvider trace.TracerProvider,
+	message *sarama.ProducerMessage,
+) (context.Context, trace.Span) {
+	ctx, span := tracerProvider.Tracer(tracerName).Start(
+		ctx,
+		message.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(message.Topic),
+		),
+	)
+
+	otel.GetTextMapPropagator().Inject(ctx, producerMessageCarrier{message: message})
+	return ctx, span
+}
+
+// StartConsumerSpan starts the span of handling message, as a child of the span that produced it
+// if its headers carry one. The caller must end the span.
+func StartConsumerSpan(
+	ctx context.Context,
+	tracerProvider trace.TracerProvider,
+	groupID string,
+	message *sarama.ConsumerMessage,
+) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, consumerMessageCarrier{message: message})
+	return tracerProvider.Tracer(tracerName).Start(
+		ctx,
+		message.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(message.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(message.Partition), 10)),
+			semconv.MessagingKafkaMessageOffset(int(message.Offset)),
+			semconv.MessagingKafkaConsumerGroup(groupID),
+		),
+	)
+}
@@ -4,62 +4,32 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/IBM/sarama"
 	"github.com/hoangdv99/morgana/internal/configs"
-	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type Client interface {
 	Produce(ctx context.Context, queueName string, payload []byte) error
 }
 
-type client struct {
-	saramaSyncProducer sarama.SyncProducer
-	logger             *zap.Logger
-}
-
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.Producer.Retry.Max = 1
-	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
-	saramaConfig.Producer.Return.Successes = true
-	saramaConfig.ClientID = mqConfig.ClientID
-	saramaConfig.Metadata.Full = true
-
-	return saramaConfig
-}
-
 func NewClient(
 	mqConfig configs.MQ,
+	inMemoryBroker inmemory.Broker,
+	tracerProvider trace.TracerProvider,
 	logger *zap.Logger,
-) (Client, error) {
-	saramaSyncProducer, err := sarama.NewSyncProducer(mqConfig.Addresses, newSaramaConfig(mqConfig))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sarama sync producer: %w", err)
+) (Client, func(), error) {
+	switch mqConfig.Type {
+	case configs.MQTypeKafka, "":
+		return NewKafkaClient(mqConfig, tracerProvider, logger)
+	case configs.MQTypeInMemory:
+		return NewInMemoryClient(inMemoryBroker, logger), func() {}, nil
+	case configs.MQTypeRedis:
+		return NewRedisClient(mqConfig, tracerProvider, logger)
+	case configs.MQTypeNATS:
+		return NewNATSClient(mqConfig, logger)
+	default:
+		return nil, nil, fmt.Errorf("unsupported mq type: %s", mqConfig.Type)
 	}
-	return &client{
-		saramaSyncProducer: saramaSyncProducer,
-		logger:             logger,
-	}, err
-}
-
-func (c client) Produce(ctx context.Context, queueName string, payload []byte) error {
-	logger := utils.LoggerWithContext(ctx, c.logger).
-		With(zap.String("queue_name", queueName)).
-		With(zap.ByteString("payload", payload))
-
-	_, _, err := c.saramaSyncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: queueName,
-		Value: sarama.ByteEncoder(payload),
-	})
-
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to produce message")
-		return status.Error(codes.Internal, "failed to produce message")
-	}
-
-	return nil
 }
@@ -0,0 +1,28 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
+	"go.uber.org/zap"
+)
+
+type inMemoryClient struct {
+	broker inmemory.Broker
+	logger *zap.Logger
+}
+
+func NewInMemoryClient(
+	broker inmemory.Broker,
+	logger *zap.Logger,
+) Client {
+	return &inMemoryClient{
+		broker: broker,
+		logger: logger,
+	}
+}
+
+func (c inMemoryClient) Produce(_ context.Context, queueName string, payload []byte) error {
+	c.broker.Publish(queueName, payload)
+	return nil
+}
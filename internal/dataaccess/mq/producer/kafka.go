@@ -0,0 +1,102 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/kafka"
+	"github.com/hoangdv99/morgana/internal/utils"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type kafkaClient struct {
+	saramaSyncProducer sarama.SyncProducer
+	mqConfig           configs.MQ
+	// createdTopicQueueNameSet holds the queues whose topic was already created by this client.
+	createdTopicQueueNameSet *sync.Map
+	tracerProvider           trace.TracerProvider
+	logger                   *zap.Logger
+}
+
+func newSaramaConfig(mqConfig configs.MQ) (*sarama.Config, error) {
+	saramaConfig, err := kafka.NewSaramaConfig(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = kafka.ApplySaramaProducerConfig(saramaConfig, mqConfig.Producer); err != nil {
+		return nil, err
+	}
+
+	return saramaConfig, nil
+}
+
+func NewKafkaClient(
+	mqConfig configs.MQ,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+) (Client, func(), error) {
+	saramaConfig, err := newSaramaConfig(mqConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid kafka config: %w", err)
+	}
+
+	saramaSyncProducer, err := sarama.NewSyncProducer(mqConfig.Addresses, saramaConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sarama sync producer: %w", err)
+	}
+
+	cleanup := func() {
+		if closeErr := saramaSyncProducer.Close(); closeErr != nil {
+			logger.With(zap.Error(closeErr)).Error("failed to close sarama sync producer")
+		}
+	}
+
+	return &kafkaClient{
+		saramaSyncProducer:       saramaSyncProducer,
+		mqConfig:                 mqConfig,
+		createdTopicQueueNameSet: &sync.Map{},
+		tracerProvider:           tracerProvider,
+		logger:                   logger,
+	}, cleanup, nil
+}
+
+func (c kafkaClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	message := &sarama.ProducerMessage{
+		Topic: queueName,
+		Value: sarama.ByteEncoder(payload),
+	}
+
+	ctx, span := kafka.StartProducerSpan(ctx, c.tracerProvider, message)
+	defer span.End()
+
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	if _, ok := c.createdTopicQueueNameSet.Load(queueName); !ok {
+		if err := kafka.CreateTopicList(c.mqConfig, []string{queueName}); err != nil {
+			span.SetStatus(otelcodes.Error, err.Error())
+			logger.With(zap.Error(err)).Error("failed to create kafka topic")
+			return status.Error(codes.Internal, "failed to create kafka topic")
+		}
+
+		c.createdTopicQueueNameSet.Store(queueName, struct{}{})
+	}
+
+	_, _, err := c.saramaSyncProducer.SendMessage(message)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, err.Error())
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetNATSStreamName returns the name of the JetStream stream that stores the messages of a queue.
+// Every queue has its own stream, with the queue name as its only subject.
+func GetNATSStreamName(queueName string) string {
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_").Replace(queueName)
+}
+
+// CreateOrUpdateNATSStream makes sure that the stream of a queue exists, so that messages
+// published to the queue are persisted even before any consumer has subscribed to it.
+func CreateOrUpdateNATSStream(ctx context.Context, js jetstream.JetStream, queueName string) error {
+	_, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     GetNATSStreamName(queueName),
+		Subjects: []string{queueName},
+		Storage:  jetstream.FileStorage,
+	})
+	return err
+}
+
+// NewNATSConnection connects to the NATS servers in the addresses of the mq config.
+func NewNATSConnection(mqConfig configs.MQ) (*nats.Conn, error) {
+	optionList := []nats.Option{nats.Name(mqConfig.ClientID)}
+	if mqConfig.Username != "" {
+		optionList = append(optionList, nats.UserInfo(mqConfig.Username, mqConfig.Password))
+	}
+
+	return nats.Connect(strings.Join(mqConfig.Addresses, ","), optionList...)
+}
+
+type natsClient struct {
+	jetStream jetstream.JetStream
+	// createdStreamQueueNameSet holds the queues whose stream was already created by this client.
+	createdStreamQueueNameSet sync.Map
+	logger                    *zap.Logger
+}
+
+func NewNATSClient(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Client, func(), error) {
+	natsConnection, err := NewNATSConnection(mqConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	jetStream, err := jetstream.New(natsConnection)
+	if err != nil {
+		natsConnection.Close()
+		return nil, nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	cleanup := func() {
+		natsConnection.Close()
+	}
+
+	return &natsClient{
+		jetStream: jetStream,
+		logger:    logger,
+	}, cleanup, nil
+}
+
+func (c *natsClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	if _, ok := c.createdStreamQueueNameSet.Load(queueName); !ok {
+		if err := CreateOrUpdateNATSStream(ctx, c.jetStream, queueName); err != nil {
+			logger.With(zap.Error(err)).Error("failed to create nats stream")
+			return status.Error(codes.Internal, "failed to create nats stream")
+		}
+
+		c.createdStreamQueueNameSet.Store(queueName, struct{}{})
+	}
+
+	if _, err := c.jetStream.Publish(ctx, queueName, payload); err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
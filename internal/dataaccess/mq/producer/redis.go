@@ -0,0 +1,71 @@
+package producer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis/extra/redisotel/v8"
+	"github.com/go-redis/redis/v8"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RedisStreamPayloadFieldName is the stream entry field that messages are stored in.
+	RedisStreamPayloadFieldName = "payload"
+)
+
+// redisClient appends messages to a Redis stream named after the queue.
+type redisClient struct {
+	redisClient *redis.Client
+	logger      *zap.Logger
+}
+
+func NewRedisClient(
+	mqConfig configs.MQ,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+) (Client, func(), error) {
+	if len(mqConfig.Addresses) == 0 {
+		return nil, nil, errors.New("no redis address configured")
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     mqConfig.Addresses[0],
+		Username: mqConfig.Username,
+		Password: mqConfig.Password,
+	})
+	client.AddHook(redisotel.NewTracingHook(redisotel.WithTracerProvider(tracerProvider)))
+
+	cleanup := func() {
+		if closeErr := client.Close(); closeErr != nil {
+			logger.With(zap.Error(closeErr)).Error("failed to close redis client")
+		}
+	}
+
+	return &redisClient{
+		redisClient: client,
+		logger:      logger,
+	}, cleanup, nil
+}
+
+func (c redisClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	err := c.redisClient.XAdd(ctx, &redis.XAddArgs{
+		Stream: queueName,
+		Values: map[string]any{RedisStreamPayloadFieldName: payload},
+	}).Err()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
@@ -3,10 +3,12 @@ package mq
 import (
 	"github.com/google/wire"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/consumer"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
 )
 
 var WireSet = wire.NewSet(
+	inmemory.WireSet,
 	producer.WireSet,
 	consumer.WireSet,
 )
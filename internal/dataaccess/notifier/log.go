@@ -0,0 +1,30 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+// logNotifier writes notifications to the log instead of delivering them. Messages can contain
+// secrets such as password reset links, so it is only meant for local development.
+type logNotifier struct {
+	logger *zap.Logger
+}
+
+func NewLogNotifier(logger *zap.Logger) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+func (l logNotifier) Notify(ctx context.Context, message Message) error {
+	utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("recipient", message.Recipient)).
+		With(zap.String("subject", message.Subject)).
+		With(zap.String("body", message.Body)).
+		Info("notification")
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+)
+
+// Message is a plain text notification for a single recipient, addressed by email.
+type Message struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+func NewNotifier(notifierConfig configs.Notifier, logger *zap.Logger) (Notifier, error) {
+	switch notifierConfig.Type {
+	case configs.NotifierTypeLog:
+		return NewLogNotifier(logger), nil
+	case configs.NotifierTypeSMTP:
+		return NewSMTPNotifier(notifierConfig.SMTP, logger)
+	default:
+		return nil, fmt.Errorf("unsupported notifier type: %s", notifierConfig.Type)
+	}
+}
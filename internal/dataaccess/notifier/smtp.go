@@ -0,0 +1,154 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// smtpNotifier sends notifications as plain text emails. Locally, it can be pointed at the
+// mailpit container of the development docker compose file, which shows the emails it receives
+// on port 8025.
+type smtpNotifier struct {
+	smtpConfig  configs.SMTP
+	host        string
+	fromAddress *mail.Address
+	logger      *zap.Logger
+}
+
+func NewSMTPNotifier(smtpConfig configs.SMTP, logger *zap.Logger) (Notifier, error) {
+	host, _, err := net.SplitHostPort(smtpConfig.Address)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse smtp address")
+		return nil, err
+	}
+
+	fromAddress, err := mail.ParseAddress(smtpConfig.From)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse smtp from address")
+		return nil, err
+	}
+
+	return &smtpNotifier{
+		smtpConfig:  smtpConfig,
+		host:        host,
+		fromAddress: fromAddress,
+		logger:      logger,
+	}, nil
+}
+
+func (s smtpNotifier) getMessageBytes(recipientAddress *mail.Address, message Message) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+	fmt.Fprintf(buffer, "From: %s\r\n", s.fromAddress.String())
+	fmt.Fprintf(buffer, "To: %s\r\n", recipientAddress.String())
+	fmt.Fprintf(buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(buffer, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buffer.WriteString("\r\n")
+
+	bodyWriter := quotedprintable.NewWriter(buffer)
+	if _, err := bodyWriter.Write([]byte(message.Body)); err != nil {
+		return nil, err
+	}
+
+	if err := bodyWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (s smtpNotifier) send(ctx context.Context, recipientAddress *mail.Address, messageBytes []byte) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.smtpConfig.Address)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if s.smtpConfig.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.smtpConfig.Username, s.smtpConfig.Password, s.host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(s.fromAddress.Address); err != nil {
+		return err
+	}
+
+	if err = client.Rcpt(recipientAddress.Address); err != nil {
+		return err
+	}
+
+	dataWriter, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = dataWriter.Write(messageBytes); err != nil {
+		return err
+	}
+
+	if err = dataWriter.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s smtpNotifier) Notify(ctx context.Context, message Message) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.String("recipient", message.Recipient))
+
+	recipientAddress, err := mail.ParseAddress(message.Recipient)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse recipient address")
+		return status.Error(codes.InvalidArgument, "invalid recipient address")
+	}
+
+	messageBytes, err := s.getMessageBytes(recipientAddress, message)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to build email")
+		return status.Error(codes.Internal, "failed to build email")
+	}
+
+	err = s.send(ctx, recipientAddress, messageBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to send email")
+		return status.Error(codes.Internal, "failed to send email")
+	}
+
+	return nil
+}
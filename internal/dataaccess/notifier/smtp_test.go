@@ -0,0 +1,178 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+)
+
+// smtpSinkMessage is an email received by the SMTP sink.
+type smtpSinkMessage struct {
+	From      string
+	Recipient string
+	Data      []byte
+}
+
+// startSMTPSink starts an SMTP server that accepts every email without authentication, the way
+// the mailpit container of the development docker compose file does, and returns its address.
+func startSMTPSink(t *testing.T) (string, <-chan smtpSinkMessage) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messageChan := make(chan smtpSinkMessage, 1)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveSMTPSinkConn(conn, messageChan)
+		}
+	}()
+
+	return listener.Addr().String(), messageChan
+}
+
+func serveSMTPSinkConn(conn net.Conn, messageChan chan<- smtpSinkMessage) {
+	defer conn.Close()
+
+	textprotoConn := textproto.NewConn(conn)
+	if err := textprotoConn.PrintfLine("220 localhost SMTP sink"); err != nil {
+		return
+	}
+
+	message := smtpSinkMessage{}
+	for {
+		line, err := textprotoConn.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command, argument, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			err = textprotoConn.PrintfLine("250 localhost")
+		case "MAIL":
+			message.From = strings.Trim(strings.TrimPrefix(argument, "FROM:"), "<>")
+			err = textprotoConn.PrintfLine("250 OK")
+		case "RCPT":
+			message.Recipient = strings.Trim(strings.TrimPrefix(argument, "TO:"), "<>")
+			err = textprotoConn.PrintfLine("250 OK")
+		case "DATA":
+			if err = textprotoConn.PrintfLine("354 End data with <CR><LF>.<CR><LF>"); err != nil {
+				return
+			}
+
+			if message.Data, err = textprotoConn.ReadDotBytes(); err != nil {
+				return
+			}
+
+			messageChan <- message
+			err = textprotoConn.PrintfLine("250 OK")
+		case "QUIT":
+			textprotoConn.PrintfLine("221 Bye")
+			return
+		default:
+			err = textprotoConn.PrintfLine("502 Command not implemented")
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	smtpAddress, messageChan := startSMTPSink(t)
+
+	smtpNotifier, err := NewSMTPNotifier(configs.SMTP{
+		Address: smtpAddress,
+		From:    "Morgana <morgana@example.com>",
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create smtp notifier: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	body := "Open the following link to choose a new password:\n\n" +
+		"https://morgana.example.com/reset-password?token=" + strings.Repeat("a", 80) + "\n"
+	err = smtpNotifier.Notify(ctx, Message{
+		Recipient: "user@example.com",
+		Subject:   "Réinitialisez votre mot de passe",
+		Body:      body,
+	})
+	if err != nil {
+		t.Fatalf("failed to notify: %v", err)
+	}
+
+	var message smtpSinkMessage
+	select {
+	case message = <-messageChan:
+	case <-ctx.Done():
+		t.Fatalf("smtp sink did not receive the email")
+	}
+
+	if message.From != "morgana@example.com" || message.Recipient != "user@example.com" {
+		t.Fatalf("unexpected envelope from %s to %s", message.From, message.Recipient)
+	}
+
+	parsedMessage, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(message.Data))))
+	if err != nil {
+		t.Fatalf("failed to parse email: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsedMessage.Header.Get("Subject"))
+	if err != nil || subject != "Réinitialisez votre mot de passe" {
+		t.Fatalf("unexpected subject %q: %v", subject, err)
+	}
+
+	if to := parsedMessage.Header.Get("To"); to != "<user@example.com>" {
+		t.Fatalf("unexpected to header %q", to)
+	}
+
+	decodedBody, err := io.ReadAll(quotedprintable.NewReader(parsedMessage.Body))
+	if err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+
+	// Lines are sent with CRLF line endings.
+	if strings.ReplaceAll(string(decodedBody), "\r\n", "\n") != body {
+		t.Fatalf("unexpected body %q", decodedBody)
+	}
+}
+
+func TestSMTPNotifierRejectsInvalidRecipient(t *testing.T) {
+	smtpAddress, _ := startSMTPSink(t)
+
+	smtpNotifier, err := NewSMTPNotifier(configs.SMTP{
+		Address: smtpAddress,
+		From:    "morgana@example.com",
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create smtp notifier: %v", err)
+	}
+
+	err = smtpNotifier.Notify(context.Background(), Message{Recipient: "not an email"})
+	if err == nil {
+		t.Fatalf("expected invalid recipient to be rejected")
+	}
+}
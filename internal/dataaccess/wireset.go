@@ -6,6 +6,7 @@ import (
 	"github.com/hoangdv99/morgana/internal/dataaccess/database"
 	"github.com/hoangdv99/morgana/internal/dataaccess/file"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq"
+	"github.com/hoangdv99/morgana/internal/dataaccess/notifier"
 )
 
 var WireSet = wire.NewSet(
@@ -13,4 +14,5 @@ var WireSet = wire.NewSet(
 	cache.WireSet,
 	mq.WireSet,
 	file.WireSet,
+	notifier.WireSet,
 )
@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +23,173 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AccountRole int32
+
+const (
+	AccountRole_ACCOUNT_ROLE_UNSPECIFIED AccountRole = 0
+	AccountRole_ACCOUNT_ROLE_USER        AccountRole = 1
+	AccountRole_ACCOUNT_ROLE_OPERATOR    AccountRole = 2
+	AccountRole_ACCOUNT_ROLE_ADMIN       AccountRole = 3
+)
+
+// Enum value maps for AccountRole.
+var (
+	AccountRole_name = map[int32]string{
+		0: "ACCOUNT_ROLE_UNSPECIFIED",
+		1: "ACCOUNT_ROLE_USER",
+		2: "ACCOUNT_ROLE_OPERATOR",
+		3: "ACCOUNT_ROLE_ADMIN",
+	}
+	AccountRole_value = map[string]int32{
+		"ACCOUNT_ROLE_UNSPECIFIED": 0,
+		"ACCOUNT_ROLE_USER":        1,
+		"ACCOUNT_ROLE_OPERATOR":    2,
+		"ACCOUNT_ROLE_ADMIN":       3,
+	}
+)
+
+func (x AccountRole) Enum() *AccountRole {
+	p := new(AccountRole)
+	*p = x
+	return p
+}
+
+func (x AccountRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_morgana_v1_morgana_proto_enumTypes[0].Descriptor()
+}
+
+func (AccountRole) Type() protoreflect.EnumType {
+	return &file_morgana_v1_morgana_proto_enumTypes[0]
+}
+
+func (x AccountRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountRole.Descriptor instead.
+func (AccountRole) EnumDescriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{0}
+}
+
+// Organization roles are ordered, every role is allowed to do everything the roles below it can.
+type OrganizationRole int32
+
+const (
+	OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED OrganizationRole = 0
+	// Can list the download tasks of the organization and get their files.
+	OrganizationRole_ORGANIZATION_ROLE_VIEWER OrganizationRole = 1
+	// Can also create, update and delete download tasks of the organization.
+	OrganizationRole_ORGANIZATION_ROLE_MEMBER OrganizationRole = 2
+	// Can also manage the members of the organization.
+	OrganizationRole_ORGANIZATION_ROLE_OWNER OrganizationRole = 3
+)
+
+// Enum value maps for OrganizationRole.
+var (
+	OrganizationRole_name = map[int32]string{
+		0: "ORGANIZATION_ROLE_UNSPECIFIED",
+		1: "ORGANIZATION_ROLE_VIEWER",
+		2: "ORGANIZATION_ROLE_MEMBER",
+		3: "ORGANIZATION_ROLE_OWNER",
+	}
+	OrganizationRole_value = map[string]int32{
+		"ORGANIZATION_ROLE_UNSPECIFIED": 0,
+		"ORGANIZATION_ROLE_VIEWER":      1,
+		"ORGANIZATION_ROLE_MEMBER":      2,
+		"ORGANIZATION_ROLE_OWNER":       3,
+	}
+)
+
+func (x OrganizationRole) Enum() *OrganizationRole {
+	p := new(OrganizationRole)
+	*p = x
+	return p
+}
+
+func (x OrganizationRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrganizationRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_morgana_v1_morgana_proto_enumTypes[1].Descriptor()
+}
+
+func (OrganizationRole) Type() protoreflect.EnumType {
+	return &file_morgana_v1_morgana_proto_enumTypes[1]
+}
+
+func (x OrganizationRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrganizationRole.Descriptor instead.
+func (OrganizationRole) EnumDescriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{1}
+}
+
+type AuditEventType int32
+
+const (
+	AuditEventType_AUDIT_EVENT_TYPE_UNSPECIFIED AuditEventType = 0
+	// Logins to an account were blocked after too many failed attempts.
+	AuditEventType_AUDIT_EVENT_TYPE_ACCOUNT_LOCKED AuditEventType = 1
+	// Logins to an account were unblocked by an admin before the lockout expired.
+	AuditEventType_AUDIT_EVENT_TYPE_ACCOUNT_UNLOCKED AuditEventType = 2
+	// Logins from an IP address were blocked after too many failed attempts.
+	AuditEventType_AUDIT_EVENT_TYPE_IP_ADDRESS_LOCKED AuditEventType = 3
+	// Logins from an IP address were unblocked by an admin before the lockout expired.
+	AuditEventType_AUDIT_EVENT_TYPE_IP_ADDRESS_UNLOCKED AuditEventType = 4
+)
+
+// Enum value maps for AuditEventType.
+var (
+	AuditEventType_name = map[int32]string{
+		0: "AUDIT_EVENT_TYPE_UNSPECIFIED",
+		1: "AUDIT_EVENT_TYPE_ACCOUNT_LOCKED",
+		2: "AUDIT_EVENT_TYPE_ACCOUNT_UNLOCKED",
+		3: "AUDIT_EVENT_TYPE_IP_ADDRESS_LOCKED",
+		4: "AUDIT_EVENT_TYPE_IP_ADDRESS_UNLOCKED",
+	}
+	AuditEventType_value = map[string]int32{
+		"AUDIT_EVENT_TYPE_UNSPECIFIED":         0,
+		"AUDIT_EVENT_TYPE_ACCOUNT_LOCKED":      1,
+		"AUDIT_EVENT_TYPE_ACCOUNT_UNLOCKED":    2,
+		"AUDIT_EVENT_TYPE_IP_ADDRESS_LOCKED":   3,
+		"AUDIT_EVENT_TYPE_IP_ADDRESS_UNLOCKED": 4,
+	}
+)
+
+func (x AuditEventType) Enum() *AuditEventType {
+	p := new(AuditEventType)
+	*p = x
+	return p
+}
+
+func (x AuditEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_morgana_v1_morgana_proto_enumTypes[2].Descriptor()
+}
+
+func (AuditEventType) Type() protoreflect.EnumType {
+	return &file_morgana_v1_morgana_proto_enumTypes[2]
+}
+
+func (x AuditEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEventType.Descriptor instead.
+func (AuditEventType) EnumDescriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{2}
+}
+
 type DownloadType int32
 
 const (
@@ -52,11 +220,11 @@ func (x DownloadType) String() string {
 }
 
 func (DownloadType) Descriptor() protoreflect.EnumDescriptor {
-	return file_morgana_v1_morgana_proto_enumTypes[0].Descriptor()
+	return file_morgana_v1_morgana_proto_enumTypes[3].Descriptor()
 }
 
 func (DownloadType) Type() protoreflect.EnumType {
-	return &file_morgana_v1_morgana_proto_enumTypes[0]
+	return &file_morgana_v1_morgana_proto_enumTypes[3]
 }
 
 func (x DownloadType) Number() protoreflect.EnumNumber {
@@ -65,7 +233,7 @@ func (x DownloadType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadType.Descriptor instead.
 func (DownloadType) EnumDescriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{0}
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{3}
 }
 
 type DownloadStatus int32
@@ -76,6 +244,7 @@ const (
 	DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING DownloadStatus = 2
 	DownloadStatus_DOWNLOAD_STATUS_FAILED      DownloadStatus = 3
 	DownloadStatus_DOWNLOAD_STATUS_SUCCESS     DownloadStatus = 4
+	DownloadStatus_DOWNLOAD_STATUS_CANCELED    DownloadStatus = 5
 )
 
 // Enum value maps for DownloadStatus.
@@ -86,6 +255,7 @@ var (
 		2: "DOWNLOAD_STATUS_DOWNLOADING",
 		3: "DOWNLOAD_STATUS_FAILED",
 		4: "DOWNLOAD_STATUS_SUCCESS",
+		5: "DOWNLOAD_STATUS_CANCELED",
 	}
 	DownloadStatus_value = map[string]int32{
 		"DOWNLOAD_STATUS_UNSPECIFIED": 0,
@@ -93,6 +263,7 @@ var (
 		"DOWNLOAD_STATUS_DOWNLOADING": 2,
 		"DOWNLOAD_STATUS_FAILED":      3,
 		"DOWNLOAD_STATUS_SUCCESS":     4,
+		"DOWNLOAD_STATUS_CANCELED":    5,
 	}
 )
 
@@ -107,11 +278,11 @@ func (x DownloadStatus) String() string {
 }
 
 func (DownloadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_morgana_v1_morgana_proto_enumTypes[1].Descriptor()
+	return file_morgana_v1_morgana_proto_enumTypes[4].Descriptor()
 }
 
 func (DownloadStatus) Type() protoreflect.EnumType {
-	return &file_morgana_v1_morgana_proto_enumTypes[1]
+	return &file_morgana_v1_morgana_proto_enumTypes[4]
 }
 
 func (x DownloadStatus) Number() protoreflect.EnumNumber {
@@ -120,7 +291,7 @@ func (x DownloadStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadStatus.Descriptor instead.
 func (DownloadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{1}
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{4}
 }
 
 type Account struct {
@@ -175,31 +346,30 @@ func (x *Account) GetAccountName() string {
 	return ""
 }
 
-type DownloadTask struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account        *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	DownloadType   DownloadType           `protobuf:"varint,3,opt,name=download_type,json=downloadType,proto3,enum=morgana.v1.DownloadType" json:"download_type,omitempty"`
-	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
-	DownloadStatus DownloadStatus         `protobuf:"varint,5,opt,name=download_status,json=downloadStatus,proto3,enum=morgana.v1.DownloadStatus" json:"download_status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AdminAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Role          AccountRole            `protobuf:"varint,2,opt,name=role,proto3,enum=morgana.v1.AccountRole" json:"role,omitempty"`
+	Disabled      bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	DisabledAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadTask) Reset() {
-	*x = DownloadTask{}
+func (x *AdminAccount) Reset() {
+	*x = AdminAccount{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadTask) String() string {
+func (x *AdminAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadTask) ProtoMessage() {}
+func (*AdminAccount) ProtoMessage() {}
 
-func (x *DownloadTask) ProtoReflect() protoreflect.Message {
+func (x *AdminAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -211,68 +381,68 @@ func (x *DownloadTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadTask.ProtoReflect.Descriptor instead.
-func (*DownloadTask) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminAccount.ProtoReflect.Descriptor instead.
+func (*AdminAccount) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *DownloadTask) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *DownloadTask) GetAccount() *Account {
+func (x *AdminAccount) GetAccount() *Account {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-func (x *DownloadTask) GetDownloadType() DownloadType {
+func (x *AdminAccount) GetRole() AccountRole {
 	if x != nil {
-		return x.DownloadType
+		return x.Role
 	}
-	return DownloadType_DOWNLOAD_TYPE_UNSPECIFIED
+	return AccountRole_ACCOUNT_ROLE_UNSPECIFIED
 }
 
-func (x *DownloadTask) GetUrl() string {
+func (x *AdminAccount) GetDisabled() bool {
 	if x != nil {
-		return x.Url
+		return x.Disabled
 	}
-	return ""
+	return false
 }
 
-func (x *DownloadTask) GetDownloadStatus() DownloadStatus {
+func (x *AdminAccount) GetDisabledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DownloadStatus
+		return x.DisabledAt
 	}
-	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
+	return nil
 }
 
-type CreateAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type AuditEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType   AuditEventType         `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=morgana.v1.AuditEventType" json:"event_type,omitempty"`
+	AccountName string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	IpAddress   string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The account that caused the event, not set for events caused by the system.
+	ActorAccount *Account               `protobuf:"bytes,5,opt,name=actor_account,json=actorAccount,proto3" json:"actor_account,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the event stops applying on its own, only set for lockouts.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -284,46 +454,85 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateAccountRequest) GetAccountName() string {
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEventType() AuditEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AuditEventType_AUDIT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CreateAccountRequest) GetPassword() string {
+func (x *AuditEvent) GetIpAddress() string {
 	if x != nil {
-		return x.Password
+		return x.IpAddress
 	}
 	return ""
 }
 
-type CreateAccountResponse struct {
+func (x *AuditEvent) GetActorAccount() *Account {
+	if x != nil {
+		return x.ActorAccount
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type Session struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RefreshedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current       bool                   `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountResponse) Reset() {
-	*x = CreateAccountResponse{}
+func (x *Session) Reset() {
+	*x = Session{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountResponse) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountResponse) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *Session) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,40 +544,75 @@ func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateAccountResponse) GetAccountId() uint64 {
+func (x *Session) GetId() uint64 {
 	if x != nil {
-		return x.AccountId
+		return x.Id
 	}
 	return 0
 }
 
-type CreateSessionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type APIKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The first characters of the key, to tell keys apart. The full key is only returned once,
+	// when it is created.
+	KeyPrefix     string                 `protobuf:"bytes,3,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	ScopeList     []string               `protobuf:"bytes,4,rep,name=scope_list,json=scopeList,proto3" json:"scope_list,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSessionRequest) Reset() {
-	*x = CreateSessionRequest{}
+func (x *APIKey) Reset() {
+	*x = APIKey{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionRequest) String() string {
+func (x *APIKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionRequest) ProtoMessage() {}
+func (*APIKey) ProtoMessage() {}
 
-func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+func (x *APIKey) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -380,46 +624,82 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateSessionRequest) GetAccountName() string {
+func (x *APIKey) GetId() uint64 {
 	if x != nil {
-		return x.AccountName
+		return x.Id
+	}
+	return 0
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateSessionRequest) GetPassword() string {
+func (x *APIKey) GetKeyPrefix() string {
 	if x != nil {
-		return x.Password
+		return x.KeyPrefix
 	}
 	return ""
 }
 
-type CreateSessionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *APIKey) GetScopeList() []string {
+	if x != nil {
+		return x.ScopeList
+	}
+	return nil
 }
 
-func (x *CreateSessionResponse) Reset() {
-	*x = CreateSessionResponse{}
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type Organization struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSessionResponse) String() string {
+func (x *Organization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponse) ProtoMessage() {}
+func (*Organization) ProtoMessage() {}
 
-func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+func (x *Organization) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -431,40 +711,47 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateSessionResponse) GetAccount() *Account {
+func (x *Organization) GetId() uint64 {
 	if x != nil {
-		return x.Account
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CreateDownloadTaskRequest struct {
+func (x *Organization) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+type OrganizationMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DownloadType  DownloadType           `protobuf:"varint,1,opt,name=download_type,json=downloadType,proto3,enum=morgana.v1.DownloadType" json:"download_type,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Role          OrganizationRole       `protobuf:"varint,2,opt,name=role,proto3,enum=morgana.v1.OrganizationRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDownloadTaskRequest) Reset() {
-	*x = CreateDownloadTaskRequest{}
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
 	mi := &file_morgana_v1_morgana_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDownloadTaskRequest) String() string {
+func (x *OrganizationMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDownloadTaskRequest) ProtoMessage() {}
+func (*OrganizationMember) ProtoMessage() {}
 
-func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
 	mi := &file_morgana_v1_morgana_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,47 +763,3323 @@ func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateDownloadTaskRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
 	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateDownloadTaskRequest) GetDownloadType() DownloadType {
+func (x *OrganizationMember) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *OrganizationMember) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+type AccountOrganization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Role          OrganizationRole       `protobuf:"varint,2,opt,name=role,proto3,enum=morgana.v1.OrganizationRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountOrganization) Reset() {
+	*x = AccountOrganization{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountOrganization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountOrganization) ProtoMessage() {}
+
+func (x *AccountOrganization) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountOrganization.ProtoReflect.Descriptor instead.
+func (*AccountOrganization) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountOrganization) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *AccountOrganization) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+// DownloadTaskOwner is either an account or an organization. Requests that leave it unset refer
+// to the account making the request.
+type DownloadTaskOwner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Owner:
+	//
+	//	*DownloadTaskOwner_AccountId
+	//	*DownloadTaskOwner_OrganizationId
+	Owner         isDownloadTaskOwner_Owner `protobuf_oneof:"owner"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadTaskOwner) Reset() {
+	*x = DownloadTaskOwner{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadTaskOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskOwner) ProtoMessage() {}
+
+func (x *DownloadTaskOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskOwner.ProtoReflect.Descriptor instead.
+func (*DownloadTaskOwner) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DownloadTaskOwner) GetOwner() isDownloadTaskOwner_Owner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *DownloadTaskOwner) GetAccountId() uint64 {
+	if x != nil {
+		if x, ok := x.Owner.(*DownloadTaskOwner_AccountId); ok {
+			return x.AccountId
+		}
+	}
+	return 0
+}
+
+func (x *DownloadTaskOwner) GetOrganizationId() uint64 {
+	if x != nil {
+		if x, ok := x.Owner.(*DownloadTaskOwner_OrganizationId); ok {
+			return x.OrganizationId
+		}
+	}
+	return 0
+}
+
+type isDownloadTaskOwner_Owner interface {
+	isDownloadTaskOwner_Owner()
+}
+
+type DownloadTaskOwner_AccountId struct {
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3,oneof"`
+}
+
+type DownloadTaskOwner_OrganizationId struct {
+	OrganizationId uint64 `protobuf:"varint,2,opt,name=organization_id,json=organizationId,proto3,oneof"`
+}
+
+func (*DownloadTaskOwner_AccountId) isDownloadTaskOwner_Owner() {}
+
+func (*DownloadTaskOwner_OrganizationId) isDownloadTaskOwner_Owner() {}
+
+type DownloadTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The account that created the download task.
+	Account        *Account       `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	DownloadType   DownloadType   `protobuf:"varint,3,opt,name=download_type,json=downloadType,proto3,enum=morgana.v1.DownloadType" json:"download_type,omitempty"`
+	Url            string         `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	DownloadStatus DownloadStatus `protobuf:"varint,5,opt,name=download_status,json=downloadStatus,proto3,enum=morgana.v1.DownloadStatus" json:"download_status,omitempty"`
+	FileName       string         `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Set when the download task is owned by an organization instead of by its account.
+	Organization  *Organization `protobuf:"bytes,7,opt,name=organization,proto3" json:"organization,omitempty"`
+	FileSize      uint64        `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadTask) Reset() {
+	*x = DownloadTask{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTask) ProtoMessage() {}
+
+func (x *DownloadTask) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTask.ProtoReflect.Descriptor instead.
+func (*DownloadTask) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DownloadTask) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadTask) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetDownloadType() DownloadType {
 	if x != nil {
 		return x.DownloadType
 	}
 	return DownloadType_DOWNLOAD_TYPE_UNSPECIFIED
 }
 
-func (x *CreateDownloadTaskRequest) GetUrl() string {
+func (x *DownloadTask) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-type CreateDownloadTaskResponse struct {
+func (x *DownloadTask) GetDownloadStatus() DownloadStatus {
+	if x != nil {
+		return x.DownloadStatus
+	}
+	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *DownloadTask) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadTask) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *DownloadTask) GetFileSize() uint64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+type CreateAccountRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// Checked against the password policy of the server.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional, password reset links are sent to it.
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDownloadTaskResponse) Reset() {
-	*x = CreateDownloadTaskResponse{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[7]
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateAccountResponse) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+// Logging in takes a second step for accounts with two-factor authentication: the first request
+// with the account name and the password returns a challenge token instead of a session, which
+// has to be sent back in a second request along with a TOTP or recovery code.
+type CreateSessionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Password    string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Only set in the second step, where the account name and the password are not needed.
+	TwoFactorChallengeToken string `protobuf:"bytes,3,opt,name=two_factor_challenge_token,json=twoFactorChallengeToken,proto3" json:"two_factor_challenge_token,omitempty"`
+	TwoFactorCode           string `protobuf:"bytes,4,opt,name=two_factor_code,json=twoFactorCode,proto3" json:"two_factor_code,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateSessionRequest) Reset() {
+	*x = CreateSessionRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest) ProtoMessage() {}
+
+func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSessionRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetTwoFactorChallengeToken() string {
+	if x != nil {
+		return x.TwoFactorChallengeToken
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetTwoFactorCode() string {
+	if x != nil {
+		return x.TwoFactorCode
+	}
+	return ""
+}
+
+type CreateSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Not set when the login still has to be completed with a two-factor code.
+	Account                      *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	TwoFactorChallengeToken      string                 `protobuf:"bytes,2,opt,name=two_factor_challenge_token,json=twoFactorChallengeToken,proto3" json:"two_factor_challenge_token,omitempty"`
+	TwoFactorChallengeExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=two_factor_challenge_expire_time,json=twoFactorChallengeExpireTime,proto3" json:"two_factor_challenge_expire_time,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *CreateSessionResponse) Reset() {
+	*x = CreateSessionResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponse) ProtoMessage() {}
+
+func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSessionResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateSessionResponse) GetTwoFactorChallengeToken() string {
+	if x != nil {
+		return x.TwoFactorChallengeToken
+	}
+	return ""
+}
+
+func (x *CreateSessionResponse) GetTwoFactorChallengeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TwoFactorChallengeExpireTime
+	}
+	return nil
+}
+
+type RefreshSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The refresh token can also be sent in the MORGANA_REFRESH metadata, which is where the HTTP
+	// gateway puts the refresh token cookie.
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{15}
+}
+
+type DeleteSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionRequest) Reset() {
+	*x = DeleteSessionRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionRequest) ProtoMessage() {}
+
+func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{16}
+}
+
+type DeleteSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSessionResponse) Reset() {
+	*x = DeleteSessionResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionResponse) ProtoMessage() {}
+
+func (x *DeleteSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{17}
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{18}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionList   []*Session             `protobuf:"bytes,1,rep,name=session_list,json=sessionList,proto3" json:"session_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListSessionsResponse) GetSessionList() []*Session {
+	if x != nil {
+		return x.SessionList
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     uint64                 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() uint64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{21}
+}
+
+// Every other session of the account is revoked.
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{23}
+}
+
+// Sends a password reset link to the email of the account, if it has one. The response is the
+// same whether or not the account exists.
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RequestPasswordResetRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{25}
+}
+
+// Every session of the account is revoked.
+type ResetPasswordRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PasswordResetToken string                 `protobuf:"bytes,1,opt,name=password_reset_token,json=passwordResetToken,proto3" json:"password_reset_token,omitempty"`
+	NewPassword        string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResetPasswordRequest) GetPasswordResetToken() string {
+	if x != nil {
+		return x.PasswordResetToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{27}
+}
+
+// Enrolling replaces any enrollment that was not confirmed yet. Two-factor authentication is only
+// enforced once the enrollment is confirmed with a first code.
+type EnrollTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTwoFactorRequest) Reset() {
+	*x = EnrollTwoFactorRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorRequest) ProtoMessage() {}
+
+func (x *EnrollTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{28}
+}
+
+type EnrollTwoFactorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base32 encoded secret, for authenticator apps that cannot scan QR codes.
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	// A PNG image of a QR code of the otpauth URI.
+	QrCodePng     []byte `protobuf:"bytes,3,opt,name=qr_code_png,json=qrCodePng,proto3" json:"qr_code_png,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTwoFactorResponse) Reset() {
+	*x = EnrollTwoFactorResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnrollTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *EnrollTwoFactorResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+func (x *EnrollTwoFactorResponse) GetQrCodePng() []byte {
+	if x != nil {
+		return x.QrCodePng
+	}
+	return nil
+}
+
+type ConfirmTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTwoFactorRequest) Reset() {
+	*x = ConfirmTwoFactorRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTwoFactorRequest) ProtoMessage() {}
+
+func (x *ConfirmTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfirmTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTwoFactorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Single use codes that replace a TOTP code when the authenticator is lost. They are only
+	// returned once.
+	RecoveryCodeList []string `protobuf:"bytes,1,rep,name=recovery_code_list,json=recoveryCodeList,proto3" json:"recovery_code_list,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfirmTwoFactorResponse) Reset() {
+	*x = ConfirmTwoFactorResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTwoFactorResponse) ProtoMessage() {}
+
+func (x *ConfirmTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfirmTwoFactorResponse) GetRecoveryCodeList() []string {
+	if x != nil {
+		return x.RecoveryCodeList
+	}
+	return nil
+}
+
+// Disabling takes a TOTP or recovery code.
+type DisableTwoFactorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorRequest) Reset() {
+	*x = DisableTwoFactorRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DisableTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTwoFactorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTwoFactorResponse) Reset() {
+	*x = DisableTwoFactorResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTwoFactorResponse) ProtoMessage() {}
+
+func (x *DisableTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{33}
+}
+
+type CreateAPIKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Any of tasks:read, tasks:write and files:read.
+	ScopeList []string `protobuf:"bytes,2,rep,name=scope_list,json=scopeList,proto3" json:"scope_list,omitempty"`
+	// Optional, the key never expires when not set.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopeList() []string {
+	if x != nil {
+		return x.ScopeList
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *APIKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type GetAPIKeyListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyListRequest) Reset() {
+	*x = GetAPIKeyListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyListRequest) ProtoMessage() {}
+
+func (x *GetAPIKeyListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyListRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{36}
+}
+
+type GetAPIKeyListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyList    []*APIKey              `protobuf:"bytes,1,rep,name=api_key_list,json=apiKeyList,proto3" json:"api_key_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPIKeyListResponse) Reset() {
+	*x = GetAPIKeyListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPIKeyListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeyListResponse) ProtoMessage() {}
+
+func (x *GetAPIKeyListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeyListResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIKeyListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetAPIKeyListResponse) GetApiKeyList() []*APIKey {
+	if x != nil {
+		return x.ApiKeyList
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeyId      uint64                 `protobuf:"varint,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevokeAPIKeyRequest) GetApiKeyId() uint64 {
+	if x != nil {
+		return x.ApiKeyId
+	}
+	return 0
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{39}
+}
+
+type CreateDownloadTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadType  DownloadType           `protobuf:"varint,1,opt,name=download_type,json=downloadType,proto3,enum=morgana.v1.DownloadType" json:"download_type,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Owner         *DownloadTaskOwner     `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadTaskRequest) Reset() {
+	*x = CreateDownloadTaskRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadTaskRequest) ProtoMessage() {}
+
+func (x *CreateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateDownloadTaskRequest) GetDownloadType() DownloadType {
+	if x != nil {
+		return x.DownloadType
+	}
+	return DownloadType_DOWNLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *CreateDownloadTaskRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateDownloadTaskRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *CreateDownloadTaskRequest) GetOwner() *DownloadTaskOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type CreateDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadTaskResponse) Reset() {
+	*x = CreateDownloadTaskResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadTaskResponse) ProtoMessage() {}
+
+func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+type GetDownloadTaskListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Owner         *DownloadTaskOwner     `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskListRequest) Reset() {
+	*x = GetDownloadTaskListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskListRequest) ProtoMessage() {}
+
+func (x *GetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDownloadTaskListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetDownloadTaskListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDownloadTaskListRequest) GetOwner() *DownloadTaskOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type GetDownloadTaskListResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskList      []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
+	ToalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=toal_download_task_count,json=toalDownloadTaskCount,proto3" json:"toal_download_task_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskListResponse) Reset() {
+	*x = GetDownloadTaskListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskListResponse) ProtoMessage() {}
+
+func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+	if x != nil {
+		return x.DownloadTaskList
+	}
+	return nil
+}
+
+func (x *GetDownloadTaskListResponse) GetToalDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.ToalDownloadTaskCount
+	}
+	return 0
+}
+
+type UpdateDownloadTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDownloadTaskRequest) Reset() {
+	*x = UpdateDownloadTaskRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDownloadTaskRequest) ProtoMessage() {}
+
+func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateDownloadTaskRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+func (x *UpdateDownloadTaskRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type UpdateDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDownloadTaskResponse) Reset() {
+	*x = UpdateDownloadTaskResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDownloadTaskResponse) ProtoMessage() {}
+
+func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+type DeleteDownloadTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteDownloadTaskRequest) Reset() {
+	*x = DeleteDownloadTaskRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadTaskRequest) ProtoMessage() {}
+
+func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeleteDownloadTaskRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+type DeleteDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDownloadTaskResponse) Reset() {
+	*x = DeleteDownloadTaskResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadTaskResponse) ProtoMessage() {}
+
+func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{47}
+}
+
+type GetDownloadTaskFileRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskFileRequest) Reset() {
+	*x = GetDownloadTaskFileRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskFileRequest) ProtoMessage() {}
+
+func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskFileRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskFileRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDownloadTaskFileRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+type GetDownloadTaskFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadTaskFileResponse) Reset() {
+	*x = GetDownloadTaskFileResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadTaskFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadTaskFileResponse) ProtoMessage() {}
+
+func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadTaskFileResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadTaskFileResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDownloadTaskFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         *DownloadTaskOwner     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetUsageRequest) GetOwner() *DownloadTaskOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+type GetUsageResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskCount uint64                 `protobuf:"varint,1,opt,name=download_task_count,json=downloadTaskCount,proto3" json:"download_task_count,omitempty"`
+	// In bytes.
+	TotalFileSize uint64 `protobuf:"varint,2,opt,name=total_file_size,json=totalFileSize,proto3" json:"total_file_size,omitempty"`
+	// The quota of the owner, zero when not limited.
+	MaxDownloadTaskCount uint64 `protobuf:"varint,3,opt,name=max_download_task_count,json=maxDownloadTaskCount,proto3" json:"max_download_task_count,omitempty"`
+	MaxTotalFileSize     uint64 `protobuf:"varint,4,opt,name=max_total_file_size,json=maxTotalFileSize,proto3" json:"max_total_file_size,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetUsageResponse) GetDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.DownloadTaskCount
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalFileSize() uint64 {
+	if x != nil {
+		return x.TotalFileSize
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetMaxDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.MaxDownloadTaskCount
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetMaxTotalFileSize() uint64 {
+	if x != nil {
+		return x.MaxTotalFileSize
+	}
+	return 0
+}
+
+type CreateOrganizationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationName string                 `protobuf:"bytes,1,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateOrganizationRequest) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type GetOrganizationListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizationListRequest) Reset() {
+	*x = GetOrganizationListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationListRequest) ProtoMessage() {}
+
+func (x *GetOrganizationListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationListRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{54}
+}
+
+type GetOrganizationListResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationList []*AccountOrganization `protobuf:"bytes,1,rep,name=organization_list,json=organizationList,proto3" json:"organization_list,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetOrganizationListResponse) Reset() {
+	*x = GetOrganizationListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationListResponse) ProtoMessage() {}
+
+func (x *GetOrganizationListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationListResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetOrganizationListResponse) GetOrganizationList() []*AccountOrganization {
+	if x != nil {
+		return x.OrganizationList
+	}
+	return nil
+}
+
+type GetOrganizationMemberListRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationMemberListRequest) Reset() {
+	*x = GetOrganizationMemberListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationMemberListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationMemberListRequest) ProtoMessage() {}
+
+func (x *GetOrganizationMemberListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationMemberListRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMemberListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetOrganizationMemberListRequest) GetOrganizationId() uint64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationMemberListResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationMemberList []*OrganizationMember  `protobuf:"bytes,1,rep,name=organization_member_list,json=organizationMemberList,proto3" json:"organization_member_list,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetOrganizationMemberListResponse) Reset() {
+	*x = GetOrganizationMemberListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationMemberListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationMemberListResponse) ProtoMessage() {}
+
+func (x *GetOrganizationMemberListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationMemberListResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizationMemberListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetOrganizationMemberListResponse) GetOrganizationMemberList() []*OrganizationMember {
+	if x != nil {
+		return x.OrganizationMemberList
+	}
+	return nil
+}
+
+type AddOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountName    string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Role           OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=morgana.v1.OrganizationRole" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddOrganizationMemberRequest) Reset() {
+	*x = AddOrganizationMemberRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *AddOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddOrganizationMemberRequest) GetOrganizationId() uint64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *AddOrganizationMemberRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AddOrganizationMemberRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+type AddOrganizationMemberResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationMember *OrganizationMember    `protobuf:"bytes,1,opt,name=organization_member,json=organizationMember,proto3" json:"organization_member,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddOrganizationMemberResponse) Reset() {
+	*x = AddOrganizationMemberResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *AddOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddOrganizationMemberResponse) GetOrganizationMember() *OrganizationMember {
+	if x != nil {
+		return x.OrganizationMember
+	}
+	return nil
+}
+
+type UpdateOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role           OrganizationRole       `protobuf:"varint,3,opt,name=role,proto3,enum=morgana.v1.OrganizationRole" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberRequest) Reset() {
+	*x = UpdateOrganizationMemberRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateOrganizationMemberRequest) GetOrganizationId() uint64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationMemberRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationMemberRequest) GetRole() OrganizationRole {
+	if x != nil {
+		return x.Role
+	}
+	return OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+type UpdateOrganizationMemberResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationMember *OrganizationMember    `protobuf:"bytes,1,opt,name=organization_member,json=organizationMember,proto3" json:"organization_member,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationMemberResponse) Reset() {
+	*x = UpdateOrganizationMemberResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateOrganizationMemberResponse) GetOrganizationMember() *OrganizationMember {
+	if x != nil {
+		return x.OrganizationMember
+	}
+	return nil
+}
+
+// Members can remove themselves, other members can only be removed by owners.
+type RemoveOrganizationMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId uint64                 `protobuf:"varint,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AccountId      uint64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberRequest) Reset() {
+	*x = RemoveOrganizationMemberRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberRequest) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RemoveOrganizationMemberRequest) GetOrganizationId() uint64 {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return 0
+}
+
+func (x *RemoveOrganizationMemberRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type RemoveOrganizationMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveOrganizationMemberResponse) Reset() {
+	*x = RemoveOrganizationMemberResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveOrganizationMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrganizationMemberResponse) ProtoMessage() {}
+
+func (x *RemoveOrganizationMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrganizationMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveOrganizationMemberResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{63}
+}
+
+type AdminGetAccountListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetAccountListRequest) Reset() {
+	*x = AdminGetAccountListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetAccountListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetAccountListRequest) ProtoMessage() {}
+
+func (x *AdminGetAccountListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetAccountListRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetAccountListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AdminGetAccountListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AdminGetAccountListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminGetAccountListResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AccountList       []*AdminAccount        `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	TotalAccountCount uint64                 `protobuf:"varint,2,opt,name=total_account_count,json=totalAccountCount,proto3" json:"total_account_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminGetAccountListResponse) Reset() {
+	*x = AdminGetAccountListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetAccountListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetAccountListResponse) ProtoMessage() {}
+
+func (x *AdminGetAccountListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetAccountListResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetAccountListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AdminGetAccountListResponse) GetAccountList() []*AdminAccount {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *AdminGetAccountListResponse) GetTotalAccountCount() uint64 {
+	if x != nil {
+		return x.TotalAccountCount
+	}
+	return 0
+}
+
+type AdminUpdateAccountRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role          AccountRole            `protobuf:"varint,2,opt,name=role,proto3,enum=morgana.v1.AccountRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateAccountRoleRequest) Reset() {
+	*x = AdminUpdateAccountRoleRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateAccountRoleRequest) ProtoMessage() {}
+
+func (x *AdminUpdateAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AdminUpdateAccountRoleRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminUpdateAccountRoleRequest) GetRole() AccountRole {
+	if x != nil {
+		return x.Role
+	}
+	return AccountRole_ACCOUNT_ROLE_UNSPECIFIED
+}
+
+type AdminUpdateAccountRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *AdminAccount          `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateAccountRoleResponse) Reset() {
+	*x = AdminUpdateAccountRoleResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateAccountRoleResponse) ProtoMessage() {}
+
+func (x *AdminUpdateAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*AdminUpdateAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AdminUpdateAccountRoleResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AdminDisableAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDisableAccountRequest) Reset() {
+	*x = AdminDisableAccountRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDisableAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDisableAccountRequest) ProtoMessage() {}
+
+func (x *AdminDisableAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDisableAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminDisableAccountRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AdminDisableAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AdminDisableAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDisableAccountResponse) Reset() {
+	*x = AdminDisableAccountResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDisableAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDisableAccountResponse) ProtoMessage() {}
+
+func (x *AdminDisableAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDisableAccountResponse.ProtoReflect.Descriptor instead.
+func (*AdminDisableAccountResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{69}
+}
+
+type AdminEnableAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     uint64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminEnableAccountRequest) Reset() {
+	*x = AdminEnableAccountRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminEnableAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminEnableAccountRequest) ProtoMessage() {}
+
+func (x *AdminEnableAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminEnableAccountRequest.ProtoReflect.Descriptor instead.
+func (*AdminEnableAccountRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AdminEnableAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type AdminEnableAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminEnableAccountResponse) Reset() {
+	*x = AdminEnableAccountResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminEnableAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminEnableAccountResponse) ProtoMessage() {}
+
+func (x *AdminEnableAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminEnableAccountResponse.ProtoReflect.Descriptor instead.
+func (*AdminEnableAccountResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{71}
+}
+
+type AdminGetDownloadTaskListRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Offset uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional filters, not applied when left unset.
+	AccountId      uint64         `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	DownloadStatus DownloadStatus `protobuf:"varint,4,opt,name=download_status,json=downloadStatus,proto3,enum=morgana.v1.DownloadStatus" json:"download_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminGetDownloadTaskListRequest) Reset() {
+	*x = AdminGetDownloadTaskListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetDownloadTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetDownloadTaskListRequest) ProtoMessage() {}
+
+func (x *AdminGetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AdminGetDownloadTaskListRequest) GetDownloadStatus() DownloadStatus {
+	if x != nil {
+		return x.DownloadStatus
+	}
+	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
+}
+
+type AdminGetDownloadTaskListResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskList       []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
+	TotalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=total_download_task_count,json=totalDownloadTaskCount,proto3" json:"total_download_task_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *AdminGetDownloadTaskListResponse) Reset() {
+	*x = AdminGetDownloadTaskListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetDownloadTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetDownloadTaskListResponse) ProtoMessage() {}
+
+func (x *AdminGetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminGetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+	if x != nil {
+		return x.DownloadTaskList
+	}
+	return nil
+}
+
+func (x *AdminGetDownloadTaskListResponse) GetTotalDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.TotalDownloadTaskCount
+	}
+	return 0
+}
+
+type AdminRetryDownloadTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminRetryDownloadTaskRequest) Reset() {
+	*x = AdminRetryDownloadTaskRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRetryDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRetryDownloadTaskRequest) ProtoMessage() {}
+
+func (x *AdminRetryDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRetryDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*AdminRetryDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdminRetryDownloadTaskRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+type AdminRetryDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRetryDownloadTaskResponse) Reset() {
+	*x = AdminRetryDownloadTaskResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDownloadTaskResponse) String() string {
+func (x *AdminRetryDownloadTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDownloadTaskResponse) ProtoMessage() {}
+func (*AdminRetryDownloadTaskResponse) ProtoMessage() {}
 
-func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[7]
+func (x *AdminRetryDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,41 +4090,40 @@ func (x *CreateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AdminRetryDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*AdminRetryDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CreateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+func (x *AdminRetryDownloadTaskResponse) GetDownloadTask() *DownloadTask {
 	if x != nil {
 		return x.DownloadTask
 	}
 	return nil
 }
 
-type GetDownloadTaskListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AdminCancelDownloadTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskListRequest) Reset() {
-	*x = GetDownloadTaskListRequest{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[8]
+func (x *AdminCancelDownloadTaskRequest) Reset() {
+	*x = AdminCancelDownloadTaskRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskListRequest) String() string {
+func (x *AdminCancelDownloadTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskListRequest) ProtoMessage() {}
+func (*AdminCancelDownloadTaskRequest) ProtoMessage() {}
 
-func (x *GetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[8]
+func (x *AdminCancelDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,48 +4134,40 @@ func (x *GetDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskListRequest.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskListRequest) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetDownloadTaskListRequest) GetOffset() uint64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use AdminCancelDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*AdminCancelDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetDownloadTaskListRequest) GetLimit() uint64 {
+func (x *AdminCancelDownloadTaskRequest) GetDownloadTaskId() uint64 {
 	if x != nil {
-		return x.Limit
+		return x.DownloadTaskId
 	}
 	return 0
 }
 
-type GetDownloadTaskListResponse struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskList      []*DownloadTask        `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
-	ToalDownloadTaskCount uint64                 `protobuf:"varint,2,opt,name=toal_download_task_count,json=toalDownloadTaskCount,proto3" json:"toal_download_task_count,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type AdminCancelDownloadTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskListResponse) Reset() {
-	*x = GetDownloadTaskListResponse{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[9]
+func (x *AdminCancelDownloadTaskResponse) Reset() {
+	*x = AdminCancelDownloadTaskResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskListResponse) String() string {
+func (x *AdminCancelDownloadTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskListResponse) ProtoMessage() {}
+func (*AdminCancelDownloadTaskResponse) ProtoMessage() {}
 
-func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[9]
+func (x *AdminCancelDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,48 +4178,41 @@ func (x *GetDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskListResponse.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskListResponse) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AdminCancelDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*AdminCancelDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+func (x *AdminCancelDownloadTaskResponse) GetDownloadTask() *DownloadTask {
 	if x != nil {
-		return x.DownloadTaskList
+		return x.DownloadTask
 	}
 	return nil
 }
 
-func (x *GetDownloadTaskListResponse) GetToalDownloadTaskCount() uint64 {
-	if x != nil {
-		return x.ToalDownloadTaskCount
-	}
-	return 0
-}
-
-type UpdateDownloadTaskRequest struct {
+type DownloadTaskStatusCount struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
-	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	DownloadStatus DownloadStatus         `protobuf:"varint,1,opt,name=download_status,json=downloadStatus,proto3,enum=morgana.v1.DownloadStatus" json:"download_status,omitempty"`
+	Count          uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateDownloadTaskRequest) Reset() {
-	*x = UpdateDownloadTaskRequest{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[10]
+func (x *DownloadTaskStatusCount) Reset() {
+	*x = DownloadTaskStatusCount{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDownloadTaskRequest) String() string {
+func (x *DownloadTaskStatusCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDownloadTaskRequest) ProtoMessage() {}
+func (*DownloadTaskStatusCount) ProtoMessage() {}
 
-func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[10]
+func (x *DownloadTaskStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,47 +4223,46 @@ func (x *UpdateDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDownloadTaskRequest) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DownloadTaskStatusCount.ProtoReflect.Descriptor instead.
+func (*DownloadTaskStatusCount) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateDownloadTaskRequest) GetDownloadTaskId() uint64 {
+func (x *DownloadTaskStatusCount) GetDownloadStatus() DownloadStatus {
 	if x != nil {
-		return x.DownloadTaskId
+		return x.DownloadStatus
 	}
-	return 0
+	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
 }
 
-func (x *UpdateDownloadTaskRequest) GetUrl() string {
+func (x *DownloadTaskStatusCount) GetCount() uint64 {
 	if x != nil {
-		return x.Url
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type UpdateDownloadTaskResponse struct {
+type AdminGetSystemStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTask  *DownloadTask          `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDownloadTaskResponse) Reset() {
-	*x = UpdateDownloadTaskResponse{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[11]
+func (x *AdminGetSystemStatsRequest) Reset() {
+	*x = AdminGetSystemStatsRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDownloadTaskResponse) String() string {
+func (x *AdminGetSystemStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDownloadTaskResponse) ProtoMessage() {}
+func (*AdminGetSystemStatsRequest) ProtoMessage() {}
 
-func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[11]
+func (x *AdminGetSystemStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,40 +4273,95 @@ func (x *UpdateDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AdminGetSystemStatsRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetSystemStatsRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UpdateDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+type AdminGetSystemStatsResponse struct {
+	state                       protoimpl.MessageState     `protogen:"open.v1"`
+	AccountCount                uint64                     `protobuf:"varint,1,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	DisabledAccountCount        uint64                     `protobuf:"varint,2,opt,name=disabled_account_count,json=disabledAccountCount,proto3" json:"disabled_account_count,omitempty"`
+	DownloadTaskStatusCountList []*DownloadTaskStatusCount `protobuf:"bytes,3,rep,name=download_task_status_count_list,json=downloadTaskStatusCountList,proto3" json:"download_task_status_count_list,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *AdminGetSystemStatsResponse) Reset() {
+	*x = AdminGetSystemStatsResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetSystemStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetSystemStatsResponse) ProtoMessage() {}
+
+func (x *AdminGetSystemStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[80]
 	if x != nil {
-		return x.DownloadTask
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetSystemStatsResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetSystemStatsResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AdminGetSystemStatsResponse) GetAccountCount() uint64 {
+	if x != nil {
+		return x.AccountCount
+	}
+	return 0
+}
+
+func (x *AdminGetSystemStatsResponse) GetDisabledAccountCount() uint64 {
+	if x != nil {
+		return x.DisabledAccountCount
+	}
+	return 0
+}
+
+func (x *AdminGetSystemStatsResponse) GetDownloadTaskStatusCountList() []*DownloadTaskStatusCount {
+	if x != nil {
+		return x.DownloadTaskStatusCountList
 	}
 	return nil
 }
 
-type DeleteDownloadTaskRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Either the account name or the IP address has to be set, both are unlocked when both are set.
+type AdminUnlockLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDownloadTaskRequest) Reset() {
-	*x = DeleteDownloadTaskRequest{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[12]
+func (x *AdminUnlockLoginRequest) Reset() {
+	*x = AdminUnlockLoginRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDownloadTaskRequest) String() string {
+func (x *AdminUnlockLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDownloadTaskRequest) ProtoMessage() {}
+func (*AdminUnlockLoginRequest) ProtoMessage() {}
 
-func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[12]
+func (x *AdminUnlockLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,39 +4372,46 @@ func (x *DeleteDownloadTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDownloadTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDownloadTaskRequest) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AdminUnlockLoginRequest.ProtoReflect.Descriptor instead.
+func (*AdminUnlockLoginRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteDownloadTaskRequest) GetDownloadTaskId() uint64 {
+func (x *AdminUnlockLoginRequest) GetAccountName() string {
 	if x != nil {
-		return x.DownloadTaskId
+		return x.AccountName
 	}
-	return 0
+	return ""
 }
 
-type DeleteDownloadTaskResponse struct {
+func (x *AdminUnlockLoginRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type AdminUnlockLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDownloadTaskResponse) Reset() {
-	*x = DeleteDownloadTaskResponse{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[13]
+func (x *AdminUnlockLoginResponse) Reset() {
+	*x = AdminUnlockLoginResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDownloadTaskResponse) String() string {
+func (x *AdminUnlockLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDownloadTaskResponse) ProtoMessage() {}
+func (*AdminUnlockLoginResponse) ProtoMessage() {}
 
-func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[13]
+func (x *AdminUnlockLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,33 +4422,34 @@ func (x *DeleteDownloadTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDownloadTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDownloadTaskResponse) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AdminUnlockLoginResponse.ProtoReflect.Descriptor instead.
+func (*AdminUnlockLoginResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{82}
 }
 
-type GetDownloadTaskFileRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DownloadTaskId uint64                 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AdminGetAuditEventListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        uint64                 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadTaskFileRequest) Reset() {
-	*x = GetDownloadTaskFileRequest{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[14]
+func (x *AdminGetAuditEventListRequest) Reset() {
+	*x = AdminGetAuditEventListRequest{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskFileRequest) String() string {
+func (x *AdminGetAuditEventListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskFileRequest) ProtoMessage() {}
+func (*AdminGetAuditEventListRequest) ProtoMessage() {}
 
-func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[14]
+func (x *AdminGetAuditEventListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,40 +4460,48 @@ func (x *GetDownloadTaskFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskFileRequest.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskFileRequest) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AdminGetAuditEventListRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetAuditEventListRequest) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetDownloadTaskFileRequest) GetDownloadTaskId() uint64 {
+func (x *AdminGetAuditEventListRequest) GetOffset() uint64 {
 	if x != nil {
-		return x.DownloadTaskId
+		return x.Offset
 	}
 	return 0
 }
 
-type GetDownloadTaskFileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AdminGetAuditEventListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *GetDownloadTaskFileResponse) Reset() {
-	*x = GetDownloadTaskFileResponse{}
-	mi := &file_morgana_v1_morgana_proto_msgTypes[15]
+type AdminGetAuditEventListResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AuditEventList       []*AuditEvent          `protobuf:"bytes,1,rep,name=audit_event_list,json=auditEventList,proto3" json:"audit_event_list,omitempty"`
+	TotalAuditEventCount uint64                 `protobuf:"varint,2,opt,name=total_audit_event_count,json=totalAuditEventCount,proto3" json:"total_audit_event_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdminGetAuditEventListResponse) Reset() {
+	*x = AdminGetAuditEventListResponse{}
+	mi := &file_morgana_v1_morgana_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadTaskFileResponse) String() string {
+func (x *AdminGetAuditEventListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadTaskFileResponse) ProtoMessage() {}
+func (*AdminGetAuditEventListResponse) ProtoMessage() {}
 
-func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_morgana_v1_morgana_proto_msgTypes[15]
+func (x *AdminGetAuditEventListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_morgana_v1_morgana_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,52 +4512,182 @@ func (x *GetDownloadTaskFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadTaskFileResponse.ProtoReflect.Descriptor instead.
-func (*GetDownloadTaskFileResponse) Descriptor() ([]byte, []int) {
-	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AdminGetAuditEventListResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetAuditEventListResponse) Descriptor() ([]byte, []int) {
+	return file_morgana_v1_morgana_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetDownloadTaskFileResponse) GetData() []byte {
+func (x *AdminGetAuditEventListResponse) GetAuditEventList() []*AuditEvent {
 	if x != nil {
-		return x.Data
+		return x.AuditEventList
 	}
 	return nil
 }
 
+func (x *AdminGetAuditEventListResponse) GetTotalAuditEventCount() uint64 {
+	if x != nil {
+		return x.TotalAuditEventCount
+	}
+	return 0
+}
+
 var File_morgana_v1_morgana_proto protoreflect.FileDescriptor
 
 const file_morgana_v1_morgana_proto_rawDesc = "" +
 	"\n" +
 	"\x18morgana/v1/morgana.proto\x12\n" +
-	"morgana.v1\x1a\x1bbuf/validate/validate.proto\"<\n" +
+	"morgana.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"<\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
-	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\xe3\x01\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\"\xc3\x01\n" +
+	"\fAdminAccount\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.morgana.v1.AccountR\aaccount\x12+\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.morgana.v1.AccountRoleR\x04role\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12;\n" +
+	"\vdisabled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"disabledAt\"\xc9\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e2\x1a.morgana.v1.AuditEventTypeR\teventType\x12!\n" +
+	"\faccount_name\x18\x03 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x128\n" +
+	"\ractor_account\x18\x05 \x01(\v2\x13.morgana.v1.AccountR\factorAccount\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xe8\x01\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\frefreshed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vrefreshedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\bR\acurrent\"\x9e\x02\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x03 \x01(\tR\tkeyPrefix\x12\x1d\n" +
+	"\n" +
+	"scope_list\x18\x04 \x03(\tR\tscopeList\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"K\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12+\n" +
+	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\"u\n" +
+	"\x12OrganizationMember\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.morgana.v1.AccountR\aaccount\x120\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1c.morgana.v1.OrganizationRoleR\x04role\"\x85\x01\n" +
+	"\x13AccountOrganization\x12<\n" +
+	"\forganization\x18\x01 \x01(\v2\x18.morgana.v1.OrganizationR\forganization\x120\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1c.morgana.v1.OrganizationRoleR\x04role\"h\n" +
+	"\x11DownloadTaskOwner\x12\x1f\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04H\x00R\taccountId\x12)\n" +
+	"\x0forganization_id\x18\x02 \x01(\x04H\x00R\x0eorganizationIdB\a\n" +
+	"\x05owner\"\xdb\x02\n" +
 	"\fDownloadTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12-\n" +
 	"\aaccount\x18\x02 \x01(\v2\x13.morgana.v1.AccountR\aaccount\x12=\n" +
 	"\rdownload_type\x18\x03 \x01(\x0e2\x18.morgana.v1.DownloadTypeR\fdownloadType\x12\x10\n" +
 	"\x03url\x18\x04 \x01(\tR\x03url\x12C\n" +
-	"\x0fdownload_status\x18\x05 \x01(\x0e2\x1a.morgana.v1.DownloadStatusR\x0edownloadStatus\"\x8d\x01\n" +
+	"\x0fdownload_status\x18\x05 \x01(\x0e2\x1a.morgana.v1.DownloadStatusR\x0edownloadStatus\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12<\n" +
+	"\forganization\x18\a \x01(\v2\x18.morgana.v1.OrganizationR\forganization\x12\x1b\n" +
+	"\tfile_size\x18\b \x01(\x04R\bfileSize\"\x9d\x01\n" +
 	"\x14CreateAccountRequest\x12=\n" +
-	"\faccount_name\x18\x01 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x126\n" +
-	"\bpassword\x18\x02 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\bpassword\"6\n" +
+	"\faccount_name\x18\x01 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\bpassword\x12\x1e\n" +
+	"\x05email\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\x05email\"6\n" +
 	"\x15CreateAccountResponse\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x01 \x01(\x04R\taccountId\"\x8d\x01\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\"\xe2\x01\n" +
 	"\x14CreateSessionRequest\x12=\n" +
-	"\faccount_name\x18\x01 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x126\n" +
-	"\bpassword\x18\x02 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\bpassword\"F\n" +
+	"\faccount_name\x18\x01 \x01(\tB\x1a\xbaH\x17r\x152\x13^[a-zA-Z0-9]{6,32}$R\vaccountName\x12&\n" +
+	"\bpassword\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\bpassword\x12;\n" +
+	"\x1atwo_factor_challenge_token\x18\x03 \x01(\tR\x17twoFactorChallengeToken\x12&\n" +
+	"\x0ftwo_factor_code\x18\x04 \x01(\tR\rtwoFactorCode\"\xe7\x01\n" +
 	"\x15CreateSessionResponse\x12-\n" +
-	"\aaccount\x18\x01 \x01(\v2\x13.morgana.v1.AccountR\aaccount\"v\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.morgana.v1.AccountR\aaccount\x12;\n" +
+	"\x1atwo_factor_challenge_token\x18\x02 \x01(\tR\x17twoFactorChallengeToken\x12b\n" +
+	" two_factor_challenge_expire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x1ctwoFactorChallengeExpireTime\"<\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x18\n" +
+	"\x16RefreshSessionResponse\"\x16\n" +
+	"\x14DeleteSessionRequest\"\x17\n" +
+	"\x15DeleteSessionResponse\"\x15\n" +
+	"\x13ListSessionsRequest\"N\n" +
+	"\x14ListSessionsResponse\x126\n" +
+	"\fsession_list\x18\x01 \x03(\v2\x13.morgana.v1.SessionR\vsessionList\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x04R\tsessionId\"\x17\n" +
+	"\x15RevokeSessionResponse\"q\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\vnewPassword\"\x18\n" +
+	"\x16ChangePasswordResponse\"@\n" +
+	"\x1bRequestPasswordResetRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"w\n" +
+	"\x14ResetPasswordRequest\x120\n" +
+	"\x14password_reset_token\x18\x01 \x01(\tR\x12passwordResetToken\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\vnewPassword\"\x17\n" +
+	"\x15ResetPasswordResponse\"\x18\n" +
+	"\x16EnrollTwoFactorRequest\"r\n" +
+	"\x17EnrollTwoFactorResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\x12\x1e\n" +
+	"\vqr_code_png\x18\x03 \x01(\fR\tqrCodePng\"-\n" +
+	"\x17ConfirmTwoFactorRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"H\n" +
+	"\x18ConfirmTwoFactorResponse\x12,\n" +
+	"\x12recovery_code_list\x18\x01 \x03(\tR\x10recoveryCodeList\"-\n" +
+	"\x17DisableTwoFactorRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x1a\n" +
+	"\x18DisableTwoFactorResponse\"\x99\x01\n" +
+	"\x13CreateAPIKeyRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x04name\x12'\n" +
+	"\n" +
+	"scope_list\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\tscopeList\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"[\n" +
+	"\x14CreateAPIKeyResponse\x12+\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x12.morgana.v1.APIKeyR\x06apiKey\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x16\n" +
+	"\x14GetAPIKeyListRequest\"M\n" +
+	"\x15GetAPIKeyListResponse\x124\n" +
+	"\fapi_key_list\x18\x01 \x03(\v2\x12.morgana.v1.APIKeyR\n" +
+	"apiKeyList\"3\n" +
+	"\x13RevokeAPIKeyRequest\x12\x1c\n" +
+	"\n" +
+	"api_key_id\x18\x01 \x01(\x04R\bapiKeyId\"\x16\n" +
+	"\x14RevokeAPIKeyResponse\"\xd2\x01\n" +
 	"\x19CreateDownloadTaskRequest\x12=\n" +
 	"\rdownload_type\x18\x01 \x01(\x0e2\x18.morgana.v1.DownloadTypeR\fdownloadType\x12\x1a\n" +
-	"\x03url\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\x03url\"[\n" +
+	"\x03url\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\x03url\x12%\n" +
+	"\tfile_name\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bfileName\x123\n" +
+	"\x05owner\x18\x04 \x01(\v2\x1d.morgana.v1.DownloadTaskOwnerR\x05owner\"[\n" +
 	"\x1aCreateDownloadTaskResponse\x12=\n" +
-	"\rdownload_task\x18\x01 \x01(\v2\x18.morgana.v1.DownloadTaskR\fdownloadTask\"S\n" +
+	"\rdownload_task\x18\x01 \x01(\v2\x18.morgana.v1.DownloadTaskR\fdownloadTask\"\x88\x01\n" +
 	"\x1aGetDownloadTaskListRequest\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
-	"\x05limit\x18\x02 \x01(\x04B\a\xbaH\x042\x02\x18dR\x05limit\"\x9e\x01\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xbaH\x042\x02\x18dR\x05limit\x123\n" +
+	"\x05owner\x18\x03 \x01(\v2\x1d.morgana.v1.DownloadTaskOwnerR\x05owner\"\x9e\x01\n" +
 	"\x1bGetDownloadTaskListResponse\x12F\n" +
 	"\x12download_task_list\x18\x01 \x03(\v2\x18.morgana.v1.DownloadTaskR\x10downloadTaskList\x127\n" +
 	"\x18toal_download_task_count\x18\x02 \x01(\x04R\x15toalDownloadTaskCount\"a\n" +
@@ -955,24 +4702,165 @@ const file_morgana_v1_morgana_proto_rawDesc = "" +
 	"\x1aGetDownloadTaskFileRequest\x12(\n" +
 	"\x10download_task_id\x18\x01 \x01(\x04R\x0edownloadTaskId\"1\n" +
 	"\x1bGetDownloadTaskFileResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data*E\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"F\n" +
+	"\x0fGetUsageRequest\x123\n" +
+	"\x05owner\x18\x01 \x01(\v2\x1d.morgana.v1.DownloadTaskOwnerR\x05owner\"\xd0\x01\n" +
+	"\x10GetUsageResponse\x12.\n" +
+	"\x13download_task_count\x18\x01 \x01(\x04R\x11downloadTaskCount\x12&\n" +
+	"\x0ftotal_file_size\x18\x02 \x01(\x04R\rtotalFileSize\x125\n" +
+	"\x17max_download_task_count\x18\x03 \x01(\x04R\x14maxDownloadTaskCount\x12-\n" +
+	"\x13max_total_file_size\x18\x04 \x01(\x04R\x10maxTotalFileSize\"T\n" +
+	"\x19CreateOrganizationRequest\x127\n" +
+	"\x11organization_name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x10organizationName\"Z\n" +
+	"\x1aCreateOrganizationResponse\x12<\n" +
+	"\forganization\x18\x01 \x01(\v2\x18.morgana.v1.OrganizationR\forganization\"\x1c\n" +
+	"\x1aGetOrganizationListRequest\"k\n" +
+	"\x1bGetOrganizationListResponse\x12L\n" +
+	"\x11organization_list\x18\x01 \x03(\v2\x1f.morgana.v1.AccountOrganizationR\x10organizationList\"K\n" +
+	" GetOrganizationMemberListRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x04R\x0eorganizationId\"}\n" +
+	"!GetOrganizationMemberListResponse\x12X\n" +
+	"\x18organization_member_list\x18\x01 \x03(\v2\x1e.morgana.v1.OrganizationMemberR\x16organizationMemberList\"\x9c\x01\n" +
+	"\x1cAddOrganizationMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x04R\x0eorganizationId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x120\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.morgana.v1.OrganizationRoleR\x04role\"p\n" +
+	"\x1dAddOrganizationMemberResponse\x12O\n" +
+	"\x13organization_member\x18\x01 \x01(\v2\x1e.morgana.v1.OrganizationMemberR\x12organizationMember\"\x9b\x01\n" +
+	"\x1fUpdateOrganizationMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x04R\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\x120\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.morgana.v1.OrganizationRoleR\x04role\"s\n" +
+	" UpdateOrganizationMemberResponse\x12O\n" +
+	"\x13organization_member\x18\x01 \x01(\v2\x1e.morgana.v1.OrganizationMemberR\x12organizationMember\"i\n" +
+	"\x1fRemoveOrganizationMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\x04R\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x04R\taccountId\"\"\n" +
+	" RemoveOrganizationMemberResponse\"S\n" +
+	"\x1aAdminGetAccountListRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xbaH\x042\x02\x18dR\x05limit\"\x8a\x01\n" +
+	"\x1bAdminGetAccountListResponse\x12;\n" +
+	"\faccount_list\x18\x01 \x03(\v2\x18.morgana.v1.AdminAccountR\vaccountList\x12.\n" +
+	"\x13total_account_count\x18\x02 \x01(\x04R\x11totalAccountCount\"k\n" +
+	"\x1dAdminUpdateAccountRoleRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\x12+\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.morgana.v1.AccountRoleR\x04role\"T\n" +
+	"\x1eAdminUpdateAccountRoleResponse\x122\n" +
+	"\aaccount\x18\x01 \x01(\v2\x18.morgana.v1.AdminAccountR\aaccount\";\n" +
+	"\x1aAdminDisableAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\"\x1d\n" +
+	"\x1bAdminDisableAccountResponse\":\n" +
+	"\x19AdminEnableAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x04R\taccountId\"\x1c\n" +
+	"\x1aAdminEnableAccountResponse\"\xbc\x01\n" +
+	"\x1fAdminGetDownloadTaskListRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xbaH\x042\x02\x18dR\x05limit\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x04R\taccountId\x12C\n" +
+	"\x0fdownload_status\x18\x04 \x01(\x0e2\x1a.morgana.v1.DownloadStatusR\x0edownloadStatus\"\xa5\x01\n" +
+	" AdminGetDownloadTaskListResponse\x12F\n" +
+	"\x12download_task_list\x18\x01 \x03(\v2\x18.morgana.v1.DownloadTaskR\x10downloadTaskList\x129\n" +
+	"\x19total_download_task_count\x18\x02 \x01(\x04R\x16totalDownloadTaskCount\"I\n" +
+	"\x1dAdminRetryDownloadTaskRequest\x12(\n" +
+	"\x10download_task_id\x18\x01 \x01(\x04R\x0edownloadTaskId\"_\n" +
+	"\x1eAdminRetryDownloadTaskResponse\x12=\n" +
+	"\rdownload_task\x18\x01 \x01(\v2\x18.morgana.v1.DownloadTaskR\fdownloadTask\"J\n" +
+	"\x1eAdminCancelDownloadTaskRequest\x12(\n" +
+	"\x10download_task_id\x18\x01 \x01(\x04R\x0edownloadTaskId\"`\n" +
+	"\x1fAdminCancelDownloadTaskResponse\x12=\n" +
+	"\rdownload_task\x18\x01 \x01(\v2\x18.morgana.v1.DownloadTaskR\fdownloadTask\"t\n" +
+	"\x17DownloadTaskStatusCount\x12C\n" +
+	"\x0fdownload_status\x18\x01 \x01(\x0e2\x1a.morgana.v1.DownloadStatusR\x0edownloadStatus\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\x1c\n" +
+	"\x1aAdminGetSystemStatsRequest\"\xe3\x01\n" +
+	"\x1bAdminGetSystemStatsResponse\x12#\n" +
+	"\raccount_count\x18\x01 \x01(\x04R\faccountCount\x124\n" +
+	"\x16disabled_account_count\x18\x02 \x01(\x04R\x14disabledAccountCount\x12i\n" +
+	"\x1fdownload_task_status_count_list\x18\x03 \x03(\v2#.morgana.v1.DownloadTaskStatusCountR\x1bdownloadTaskStatusCountList\"[\n" +
+	"\x17AdminUnlockLoginRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\"\x1a\n" +
+	"\x18AdminUnlockLoginResponse\"V\n" +
+	"\x1dAdminGetAuditEventListRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x04B\a\xbaH\x042\x02\x18dR\x05limit\"\x99\x01\n" +
+	"\x1eAdminGetAuditEventListResponse\x12@\n" +
+	"\x10audit_event_list\x18\x01 \x03(\v2\x16.morgana.v1.AuditEventR\x0eauditEventList\x125\n" +
+	"\x17total_audit_event_count\x18\x02 \x01(\x04R\x14totalAuditEventCount*u\n" +
+	"\vAccountRole\x12\x1c\n" +
+	"\x18ACCOUNT_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ACCOUNT_ROLE_USER\x10\x01\x12\x19\n" +
+	"\x15ACCOUNT_ROLE_OPERATOR\x10\x02\x12\x16\n" +
+	"\x12ACCOUNT_ROLE_ADMIN\x10\x03*\x8e\x01\n" +
+	"\x10OrganizationRole\x12!\n" +
+	"\x1dORGANIZATION_ROLE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ORGANIZATION_ROLE_VIEWER\x10\x01\x12\x1c\n" +
+	"\x18ORGANIZATION_ROLE_MEMBER\x10\x02\x12\x1b\n" +
+	"\x17ORGANIZATION_ROLE_OWNER\x10\x03*\xd0\x01\n" +
+	"\x0eAuditEventType\x12 \n" +
+	"\x1cAUDIT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fAUDIT_EVENT_TYPE_ACCOUNT_LOCKED\x10\x01\x12%\n" +
+	"!AUDIT_EVENT_TYPE_ACCOUNT_UNLOCKED\x10\x02\x12&\n" +
+	"\"AUDIT_EVENT_TYPE_IP_ADDRESS_LOCKED\x10\x03\x12(\n" +
+	"$AUDIT_EVENT_TYPE_IP_ADDRESS_UNLOCKED\x10\x04*E\n" +
 	"\fDownloadType\x12\x1d\n" +
 	"\x19DOWNLOAD_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12DOWNLOAD_TYPE_HTTP\x10\x01*\xa8\x01\n" +
+	"\x12DOWNLOAD_TYPE_HTTP\x10\x01*\xc6\x01\n" +
 	"\x0eDownloadStatus\x12\x1f\n" +
 	"\x1bDOWNLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17DOWNLOAD_STATUS_PENDING\x10\x01\x12\x1f\n" +
 	"\x1bDOWNLOAD_STATUS_DOWNLOADING\x10\x02\x12\x1a\n" +
 	"\x16DOWNLOAD_STATUS_FAILED\x10\x03\x12\x1b\n" +
-	"\x17DOWNLOAD_STATUS_SUCCESS\x10\x042\xcb\x05\n" +
+	"\x17DOWNLOAD_STATUS_SUCCESS\x10\x04\x12\x1c\n" +
+	"\x18DOWNLOAD_STATUS_CANCELED\x10\x052\xe5\x14\n" +
 	"\x0eMorganaService\x12V\n" +
 	"\rCreateAccount\x12 .morgana.v1.CreateAccountRequest\x1a!.morgana.v1.CreateAccountResponse\"\x00\x12V\n" +
-	"\rCreateSession\x12 .morgana.v1.CreateSessionRequest\x1a!.morgana.v1.CreateSessionResponse\"\x00\x12e\n" +
+	"\rCreateSession\x12 .morgana.v1.CreateSessionRequest\x1a!.morgana.v1.CreateSessionResponse\"\x00\x12Y\n" +
+	"\x0eRefreshSession\x12!.morgana.v1.RefreshSessionRequest\x1a\".morgana.v1.RefreshSessionResponse\"\x00\x12V\n" +
+	"\rDeleteSession\x12 .morgana.v1.DeleteSessionRequest\x1a!.morgana.v1.DeleteSessionResponse\"\x00\x12S\n" +
+	"\fListSessions\x12\x1f.morgana.v1.ListSessionsRequest\x1a .morgana.v1.ListSessionsResponse\"\x00\x12V\n" +
+	"\rRevokeSession\x12 .morgana.v1.RevokeSessionRequest\x1a!.morgana.v1.RevokeSessionResponse\"\x00\x12Y\n" +
+	"\x0eChangePassword\x12!.morgana.v1.ChangePasswordRequest\x1a\".morgana.v1.ChangePasswordResponse\"\x00\x12k\n" +
+	"\x14RequestPasswordReset\x12'.morgana.v1.RequestPasswordResetRequest\x1a(.morgana.v1.RequestPasswordResetResponse\"\x00\x12V\n" +
+	"\rResetPassword\x12 .morgana.v1.ResetPasswordRequest\x1a!.morgana.v1.ResetPasswordResponse\"\x00\x12\\\n" +
+	"\x0fEnrollTwoFactor\x12\".morgana.v1.EnrollTwoFactorRequest\x1a#.morgana.v1.EnrollTwoFactorResponse\"\x00\x12_\n" +
+	"\x10ConfirmTwoFactor\x12#.morgana.v1.ConfirmTwoFactorRequest\x1a$.morgana.v1.ConfirmTwoFactorResponse\"\x00\x12_\n" +
+	"\x10DisableTwoFactor\x12#.morgana.v1.DisableTwoFactorRequest\x1a$.morgana.v1.DisableTwoFactorResponse\"\x00\x12S\n" +
+	"\fCreateAPIKey\x12\x1f.morgana.v1.CreateAPIKeyRequest\x1a .morgana.v1.CreateAPIKeyResponse\"\x00\x12V\n" +
+	"\rGetAPIKeyList\x12 .morgana.v1.GetAPIKeyListRequest\x1a!.morgana.v1.GetAPIKeyListResponse\"\x00\x12S\n" +
+	"\fRevokeAPIKey\x12\x1f.morgana.v1.RevokeAPIKeyRequest\x1a .morgana.v1.RevokeAPIKeyResponse\"\x00\x12e\n" +
 	"\x12CreateDownloadTask\x12%.morgana.v1.CreateDownloadTaskRequest\x1a&.morgana.v1.CreateDownloadTaskResponse\"\x00\x12h\n" +
 	"\x13GetDownloadTaskList\x12&.morgana.v1.GetDownloadTaskListRequest\x1a'.morgana.v1.GetDownloadTaskListResponse\"\x00\x12e\n" +
 	"\x12UpdateDownloadTask\x12%.morgana.v1.UpdateDownloadTaskRequest\x1a&.morgana.v1.UpdateDownloadTaskResponse\"\x00\x12e\n" +
 	"\x12DeleteDownloadTask\x12%.morgana.v1.DeleteDownloadTaskRequest\x1a&.morgana.v1.DeleteDownloadTaskResponse\"\x00\x12j\n" +
-	"\x13GetDownloadTaskFile\x12&.morgana.v1.GetDownloadTaskFileRequest\x1a'.morgana.v1.GetDownloadTaskFileResponse\"\x000\x01B\x8a\x01\n" +
+	"\x13GetDownloadTaskFile\x12&.morgana.v1.GetDownloadTaskFileRequest\x1a'.morgana.v1.GetDownloadTaskFileResponse\"\x000\x01\x12G\n" +
+	"\bGetUsage\x12\x1b.morgana.v1.GetUsageRequest\x1a\x1c.morgana.v1.GetUsageResponse\"\x00\x12e\n" +
+	"\x12CreateOrganization\x12%.morgana.v1.CreateOrganizationRequest\x1a&.morgana.v1.CreateOrganizationResponse\"\x00\x12h\n" +
+	"\x13GetOrganizationList\x12&.morgana.v1.GetOrganizationListRequest\x1a'.morgana.v1.GetOrganizationListResponse\"\x00\x12z\n" +
+	"\x19GetOrganizationMemberList\x12,.morgana.v1.GetOrganizationMemberListRequest\x1a-.morgana.v1.GetOrganizationMemberListResponse\"\x00\x12n\n" +
+	"\x15AddOrganizationMember\x12(.morgana.v1.AddOrganizationMemberRequest\x1a).morgana.v1.AddOrganizationMemberResponse\"\x00\x12w\n" +
+	"\x18UpdateOrganizationMember\x12+.morgana.v1.UpdateOrganizationMemberRequest\x1a,.morgana.v1.UpdateOrganizationMemberResponse\"\x00\x12w\n" +
+	"\x18RemoveOrganizationMember\x12+.morgana.v1.RemoveOrganizationMemberRequest\x1a,.morgana.v1.RemoveOrganizationMemberResponse\"\x002\xaa\b\n" +
+	"\fAdminService\x12c\n" +
+	"\x0eGetAccountList\x12&.morgana.v1.AdminGetAccountListRequest\x1a'.morgana.v1.AdminGetAccountListResponse\"\x00\x12l\n" +
+	"\x11UpdateAccountRole\x12).morgana.v1.AdminUpdateAccountRoleRequest\x1a*.morgana.v1.AdminUpdateAccountRoleResponse\"\x00\x12c\n" +
+	"\x0eDisableAccount\x12&.morgana.v1.AdminDisableAccountRequest\x1a'.morgana.v1.AdminDisableAccountResponse\"\x00\x12`\n" +
+	"\rEnableAccount\x12%.morgana.v1.AdminEnableAccountRequest\x1a&.morgana.v1.AdminEnableAccountResponse\"\x00\x12r\n" +
+	"\x13GetDownloadTaskList\x12+.morgana.v1.AdminGetDownloadTaskListRequest\x1a,.morgana.v1.AdminGetDownloadTaskListResponse\"\x00\x12l\n" +
+	"\x11RetryDownloadTask\x12).morgana.v1.AdminRetryDownloadTaskRequest\x1a*.morgana.v1.AdminRetryDownloadTaskResponse\"\x00\x12o\n" +
+	"\x12CancelDownloadTask\x12*.morgana.v1.AdminCancelDownloadTaskRequest\x1a+.morgana.v1.AdminCancelDownloadTaskResponse\"\x00\x12c\n" +
+	"\x0eGetSystemStats\x12&.morgana.v1.AdminGetSystemStatsRequest\x1a'.morgana.v1.AdminGetSystemStatsResponse\"\x00\x12Z\n" +
+	"\vUnlockLogin\x12#.morgana.v1.AdminUnlockLoginRequest\x1a$.morgana.v1.AdminUnlockLoginResponse\"\x00\x12l\n" +
+	"\x11GetAuditEventList\x12).morgana.v1.AdminGetAuditEventListRequest\x1a*.morgana.v1.AdminGetAuditEventListResponse\"\x00B\x8a\x01\n" +
 	"\x0ecom.morgana.v1B\fMorganaProtoP\x01Z!grpc/morgana/morgana/v1;morganav1\xa2\x02\x03MXX\xaa\x02\n" +
 	"Morgana.V1\xca\x02\n" +
 	"Morgana\\V1\xe2\x02\x16Morgana\\V1\\GPBMetadata\xea\x02\vMorgana::V1b\x06proto3"
@@ -989,56 +4877,232 @@ func file_morgana_v1_morgana_proto_rawDescGZIP() []byte {
 	return file_morgana_v1_morgana_proto_rawDescData
 }
 
-var file_morgana_v1_morgana_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_morgana_v1_morgana_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_morgana_v1_morgana_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_morgana_v1_morgana_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_morgana_v1_morgana_proto_goTypes = []any{
-	(DownloadType)(0),                   // 0: morgana.v1.DownloadType
-	(DownloadStatus)(0),                 // 1: morgana.v1.DownloadStatus
-	(*Account)(nil),                     // 2: morgana.v1.Account
-	(*DownloadTask)(nil),                // 3: morgana.v1.DownloadTask
-	(*CreateAccountRequest)(nil),        // 4: morgana.v1.CreateAccountRequest
-	(*CreateAccountResponse)(nil),       // 5: morgana.v1.CreateAccountResponse
-	(*CreateSessionRequest)(nil),        // 6: morgana.v1.CreateSessionRequest
-	(*CreateSessionResponse)(nil),       // 7: morgana.v1.CreateSessionResponse
-	(*CreateDownloadTaskRequest)(nil),   // 8: morgana.v1.CreateDownloadTaskRequest
-	(*CreateDownloadTaskResponse)(nil),  // 9: morgana.v1.CreateDownloadTaskResponse
-	(*GetDownloadTaskListRequest)(nil),  // 10: morgana.v1.GetDownloadTaskListRequest
-	(*GetDownloadTaskListResponse)(nil), // 11: morgana.v1.GetDownloadTaskListResponse
-	(*UpdateDownloadTaskRequest)(nil),   // 12: morgana.v1.UpdateDownloadTaskRequest
-	(*UpdateDownloadTaskResponse)(nil),  // 13: morgana.v1.UpdateDownloadTaskResponse
-	(*DeleteDownloadTaskRequest)(nil),   // 14: morgana.v1.DeleteDownloadTaskRequest
-	(*DeleteDownloadTaskResponse)(nil),  // 15: morgana.v1.DeleteDownloadTaskResponse
-	(*GetDownloadTaskFileRequest)(nil),  // 16: morgana.v1.GetDownloadTaskFileRequest
-	(*GetDownloadTaskFileResponse)(nil), // 17: morgana.v1.GetDownloadTaskFileResponse
+	(AccountRole)(0),                          // 0: morgana.v1.AccountRole
+	(OrganizationRole)(0),                     // 1: morgana.v1.OrganizationRole
+	(AuditEventType)(0),                       // 2: morgana.v1.AuditEventType
+	(DownloadType)(0),                         // 3: morgana.v1.DownloadType
+	(DownloadStatus)(0),                       // 4: morgana.v1.DownloadStatus
+	(*Account)(nil),                           // 5: morgana.v1.Account
+	(*AdminAccount)(nil),                      // 6: morgana.v1.AdminAccount
+	(*AuditEvent)(nil),                        // 7: morgana.v1.AuditEvent
+	(*Session)(nil),                           // 8: morgana.v1.Session
+	(*APIKey)(nil),                            // 9: morgana.v1.APIKey
+	(*Organization)(nil),                      // 10: morgana.v1.Organization
+	(*OrganizationMember)(nil),                // 11: morgana.v1.OrganizationMember
+	(*AccountOrganization)(nil),               // 12: morgana.v1.AccountOrganization
+	(*DownloadTaskOwner)(nil),                 // 13: morgana.v1.DownloadTaskOwner
+	(*DownloadTask)(nil),                      // 14: morgana.v1.DownloadTask
+	(*CreateAccountRequest)(nil),              // 15: morgana.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),             // 16: morgana.v1.CreateAccountResponse
+	(*CreateSessionRequest)(nil),              // 17: morgana.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),             // 18: morgana.v1.CreateSessionResponse
+	(*RefreshSessionRequest)(nil),             // 19: morgana.v1.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),            // 20: morgana.v1.RefreshSessionResponse
+	(*DeleteSessionRequest)(nil),              // 21: morgana.v1.DeleteSessionRequest
+	(*DeleteSessionResponse)(nil),             // 22: morgana.v1.DeleteSessionResponse
+	(*ListSessionsRequest)(nil),               // 23: morgana.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),              // 24: morgana.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),              // 25: morgana.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),             // 26: morgana.v1.RevokeSessionResponse
+	(*ChangePasswordRequest)(nil),             // 27: morgana.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),            // 28: morgana.v1.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),       // 29: morgana.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),      // 30: morgana.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),              // 31: morgana.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),             // 32: morgana.v1.ResetPasswordResponse
+	(*EnrollTwoFactorRequest)(nil),            // 33: morgana.v1.EnrollTwoFactorRequest
+	(*EnrollTwoFactorResponse)(nil),           // 34: morgana.v1.EnrollTwoFactorResponse
+	(*ConfirmTwoFactorRequest)(nil),           // 35: morgana.v1.ConfirmTwoFactorRequest
+	(*ConfirmTwoFactorResponse)(nil),          // 36: morgana.v1.ConfirmTwoFactorResponse
+	(*DisableTwoFactorRequest)(nil),           // 37: morgana.v1.DisableTwoFactorRequest
+	(*DisableTwoFactorResponse)(nil),          // 38: morgana.v1.DisableTwoFactorResponse
+	(*CreateAPIKeyRequest)(nil),               // 39: morgana.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),              // 40: morgana.v1.CreateAPIKeyResponse
+	(*GetAPIKeyListRequest)(nil),              // 41: morgana.v1.GetAPIKeyListRequest
+	(*GetAPIKeyListResponse)(nil),             // 42: morgana.v1.GetAPIKeyListResponse
+	(*RevokeAPIKeyRequest)(nil),               // 43: morgana.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),              // 44: morgana.v1.RevokeAPIKeyResponse
+	(*CreateDownloadTaskRequest)(nil),         // 45: morgana.v1.CreateDownloadTaskRequest
+	(*CreateDownloadTaskResponse)(nil),        // 46: morgana.v1.CreateDownloadTaskResponse
+	(*GetDownloadTaskListRequest)(nil),        // 47: morgana.v1.GetDownloadTaskListRequest
+	(*GetDownloadTaskListResponse)(nil),       // 48: morgana.v1.GetDownloadTaskListResponse
+	(*UpdateDownloadTaskRequest)(nil),         // 49: morgana.v1.UpdateDownloadTaskRequest
+	(*UpdateDownloadTaskResponse)(nil),        // 50: morgana.v1.UpdateDownloadTaskResponse
+	(*DeleteDownloadTaskRequest)(nil),         // 51: morgana.v1.DeleteDownloadTaskRequest
+	(*DeleteDownloadTaskResponse)(nil),        // 52: morgana.v1.DeleteDownloadTaskResponse
+	(*GetDownloadTaskFileRequest)(nil),        // 53: morgana.v1.GetDownloadTaskFileRequest
+	(*GetDownloadTaskFileResponse)(nil),       // 54: morgana.v1.GetDownloadTaskFileResponse
+	(*GetUsageRequest)(nil),                   // 55: morgana.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),                  // 56: morgana.v1.GetUsageResponse
+	(*CreateOrganizationRequest)(nil),         // 57: morgana.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),        // 58: morgana.v1.CreateOrganizationResponse
+	(*GetOrganizationListRequest)(nil),        // 59: morgana.v1.GetOrganizationListRequest
+	(*GetOrganizationListResponse)(nil),       // 60: morgana.v1.GetOrganizationListResponse
+	(*GetOrganizationMemberListRequest)(nil),  // 61: morgana.v1.GetOrganizationMemberListRequest
+	(*GetOrganizationMemberListResponse)(nil), // 62: morgana.v1.GetOrganizationMemberListResponse
+	(*AddOrganizationMemberRequest)(nil),      // 63: morgana.v1.AddOrganizationMemberRequest
+	(*AddOrganizationMemberResponse)(nil),     // 64: morgana.v1.AddOrganizationMemberResponse
+	(*UpdateOrganizationMemberRequest)(nil),   // 65: morgana.v1.UpdateOrganizationMemberRequest
+	(*UpdateOrganizationMemberResponse)(nil),  // 66: morgana.v1.UpdateOrganizationMemberResponse
+	(*RemoveOrganizationMemberRequest)(nil),   // 67: morgana.v1.RemoveOrganizationMemberRequest
+	(*RemoveOrganizationMemberResponse)(nil),  // 68: morgana.v1.RemoveOrganizationMemberResponse
+	(*AdminGetAccountListRequest)(nil),        // 69: morgana.v1.AdminGetAccountListRequest
+	(*AdminGetAccountListResponse)(nil),       // 70: morgana.v1.AdminGetAccountListResponse
+	(*AdminUpdateAccountRoleRequest)(nil),     // 71: morgana.v1.AdminUpdateAccountRoleRequest
+	(*AdminUpdateAccountRoleResponse)(nil),    // 72: morgana.v1.AdminUpdateAccountRoleResponse
+	(*AdminDisableAccountRequest)(nil),        // 73: morgana.v1.AdminDisableAccountRequest
+	(*AdminDisableAccountResponse)(nil),       // 74: morgana.v1.AdminDisableAccountResponse
+	(*AdminEnableAccountRequest)(nil),         // 75: morgana.v1.AdminEnableAccountRequest
+	(*AdminEnableAccountResponse)(nil),        // 76: morgana.v1.AdminEnableAccountResponse
+	(*AdminGetDownloadTaskListRequest)(nil),   // 77: morgana.v1.AdminGetDownloadTaskListRequest
+	(*AdminGetDownloadTaskListResponse)(nil),  // 78: morgana.v1.AdminGetDownloadTaskListResponse
+	(*AdminRetryDownloadTaskRequest)(nil),     // 79: morgana.v1.AdminRetryDownloadTaskRequest
+	(*AdminRetryDownloadTaskResponse)(nil),    // 80: morgana.v1.AdminRetryDownloadTaskResponse
+	(*AdminCancelDownloadTaskRequest)(nil),    // 81: morgana.v1.AdminCancelDownloadTaskRequest
+	(*AdminCancelDownloadTaskResponse)(nil),   // 82: morgana.v1.AdminCancelDownloadTaskResponse
+	(*DownloadTaskStatusCount)(nil),           // 83: morgana.v1.DownloadTaskStatusCount
+	(*AdminGetSystemStatsRequest)(nil),        // 84: morgana.v1.AdminGetSystemStatsRequest
+	(*AdminGetSystemStatsResponse)(nil),       // 85: morgana.v1.AdminGetSystemStatsResponse
+	(*AdminUnlockLoginRequest)(nil),           // 86: morgana.v1.AdminUnlockLoginRequest
+	(*AdminUnlockLoginResponse)(nil),          // 87: morgana.v1.AdminUnlockLoginResponse
+	(*AdminGetAuditEventListRequest)(nil),     // 88: morgana.v1.AdminGetAuditEventListRequest
+	(*AdminGetAuditEventListResponse)(nil),    // 89: morgana.v1.AdminGetAuditEventListResponse
+	(*timestamppb.Timestamp)(nil),             // 90: google.protobuf.Timestamp
 }
 var file_morgana_v1_morgana_proto_depIdxs = []int32{
-	2,  // 0: morgana.v1.DownloadTask.account:type_name -> morgana.v1.Account
-	0,  // 1: morgana.v1.DownloadTask.download_type:type_name -> morgana.v1.DownloadType
-	1,  // 2: morgana.v1.DownloadTask.download_status:type_name -> morgana.v1.DownloadStatus
-	2,  // 3: morgana.v1.CreateSessionResponse.account:type_name -> morgana.v1.Account
-	0,  // 4: morgana.v1.CreateDownloadTaskRequest.download_type:type_name -> morgana.v1.DownloadType
-	3,  // 5: morgana.v1.CreateDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
-	3,  // 6: morgana.v1.GetDownloadTaskListResponse.download_task_list:type_name -> morgana.v1.DownloadTask
-	3,  // 7: morgana.v1.UpdateDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
-	4,  // 8: morgana.v1.MorganaService.CreateAccount:input_type -> morgana.v1.CreateAccountRequest
-	6,  // 9: morgana.v1.MorganaService.CreateSession:input_type -> morgana.v1.CreateSessionRequest
-	8,  // 10: morgana.v1.MorganaService.CreateDownloadTask:input_type -> morgana.v1.CreateDownloadTaskRequest
-	10, // 11: morgana.v1.MorganaService.GetDownloadTaskList:input_type -> morgana.v1.GetDownloadTaskListRequest
-	12, // 12: morgana.v1.MorganaService.UpdateDownloadTask:input_type -> morgana.v1.UpdateDownloadTaskRequest
-	14, // 13: morgana.v1.MorganaService.DeleteDownloadTask:input_type -> morgana.v1.DeleteDownloadTaskRequest
-	16, // 14: morgana.v1.MorganaService.GetDownloadTaskFile:input_type -> morgana.v1.GetDownloadTaskFileRequest
-	5,  // 15: morgana.v1.MorganaService.CreateAccount:output_type -> morgana.v1.CreateAccountResponse
-	7,  // 16: morgana.v1.MorganaService.CreateSession:output_type -> morgana.v1.CreateSessionResponse
-	9,  // 17: morgana.v1.MorganaService.CreateDownloadTask:output_type -> morgana.v1.CreateDownloadTaskResponse
-	11, // 18: morgana.v1.MorganaService.GetDownloadTaskList:output_type -> morgana.v1.GetDownloadTaskListResponse
-	13, // 19: morgana.v1.MorganaService.UpdateDownloadTask:output_type -> morgana.v1.UpdateDownloadTaskResponse
-	15, // 20: morgana.v1.MorganaService.DeleteDownloadTask:output_type -> morgana.v1.DeleteDownloadTaskResponse
-	17, // 21: morgana.v1.MorganaService.GetDownloadTaskFile:output_type -> morgana.v1.GetDownloadTaskFileResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	5,  // 0: morgana.v1.AdminAccount.account:type_name -> morgana.v1.Account
+	0,  // 1: morgana.v1.AdminAccount.role:type_name -> morgana.v1.AccountRole
+	90, // 2: morgana.v1.AdminAccount.disabled_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: morgana.v1.AuditEvent.event_type:type_name -> morgana.v1.AuditEventType
+	5,  // 4: morgana.v1.AuditEvent.actor_account:type_name -> morgana.v1.Account
+	90, // 5: morgana.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	90, // 6: morgana.v1.AuditEvent.expires_at:type_name -> google.protobuf.Timestamp
+	90, // 7: morgana.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	90, // 8: morgana.v1.Session.refreshed_at:type_name -> google.protobuf.Timestamp
+	90, // 9: morgana.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	90, // 10: morgana.v1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	90, // 11: morgana.v1.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	90, // 12: morgana.v1.APIKey.last_used_at:type_name -> google.protobuf.Timestamp
+	5,  // 13: morgana.v1.OrganizationMember.account:type_name -> morgana.v1.Account
+	1,  // 14: morgana.v1.OrganizationMember.role:type_name -> morgana.v1.OrganizationRole
+	10, // 15: morgana.v1.AccountOrganization.organization:type_name -> morgana.v1.Organization
+	1,  // 16: morgana.v1.AccountOrganization.role:type_name -> morgana.v1.OrganizationRole
+	5,  // 17: morgana.v1.DownloadTask.account:type_name -> morgana.v1.Account
+	3,  // 18: morgana.v1.DownloadTask.download_type:type_name -> morgana.v1.DownloadType
+	4,  // 19: morgana.v1.DownloadTask.download_status:type_name -> morgana.v1.DownloadStatus
+	10, // 20: morgana.v1.DownloadTask.organization:type_name -> morgana.v1.Organization
+	5,  // 21: morgana.v1.CreateSessionResponse.account:type_name -> morgana.v1.Account
+	90, // 22: morgana.v1.CreateSessionResponse.two_factor_challenge_expire_time:type_name -> google.protobuf.Timestamp
+	8,  // 23: morgana.v1.ListSessionsResponse.session_list:type_name -> morgana.v1.Session
+	90, // 24: morgana.v1.CreateAPIKeyRequest.expires_at:type_name -> google.protobuf.Timestamp
+	9,  // 25: morgana.v1.CreateAPIKeyResponse.api_key:type_name -> morgana.v1.APIKey
+	9,  // 26: morgana.v1.GetAPIKeyListResponse.api_key_list:type_name -> morgana.v1.APIKey
+	3,  // 27: morgana.v1.CreateDownloadTaskRequest.download_type:type_name -> morgana.v1.DownloadType
+	13, // 28: morgana.v1.CreateDownloadTaskRequest.owner:type_name -> morgana.v1.DownloadTaskOwner
+	14, // 29: morgana.v1.CreateDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
+	13, // 30: morgana.v1.GetDownloadTaskListRequest.owner:type_name -> morgana.v1.DownloadTaskOwner
+	14, // 31: morgana.v1.GetDownloadTaskListResponse.download_task_list:type_name -> morgana.v1.DownloadTask
+	14, // 32: morgana.v1.UpdateDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
+	13, // 33: morgana.v1.GetUsageRequest.owner:type_name -> morgana.v1.DownloadTaskOwner
+	10, // 34: morgana.v1.CreateOrganizationResponse.organization:type_name -> morgana.v1.Organization
+	12, // 35: morgana.v1.GetOrganizationListResponse.organization_list:type_name -> morgana.v1.AccountOrganization
+	11, // 36: morgana.v1.GetOrganizationMemberListResponse.organization_member_list:type_name -> morgana.v1.OrganizationMember
+	1,  // 37: morgana.v1.AddOrganizationMemberRequest.role:type_name -> morgana.v1.OrganizationRole
+	11, // 38: morgana.v1.AddOrganizationMemberResponse.organization_member:type_name -> morgana.v1.OrganizationMember
+	1,  // 39: morgana.v1.UpdateOrganizationMemberRequest.role:type_name -> morgana.v1.OrganizationRole
+	11, // 40: morgana.v1.UpdateOrganizationMemberResponse.organization_member:type_name -> morgana.v1.OrganizationMember
+	6,  // 41: morgana.v1.AdminGetAccountListResponse.account_list:type_name -> morgana.v1.AdminAccount
+	0,  // 42: morgana.v1.AdminUpdateAccountRoleRequest.role:type_name -> morgana.v1.AccountRole
+	6,  // 43: morgana.v1.AdminUpdateAccountRoleResponse.account:type_name -> morgana.v1.AdminAccount
+	4,  // 44: morgana.v1.AdminGetDownloadTaskListRequest.download_status:type_name -> morgana.v1.DownloadStatus
+	14, // 45: morgana.v1.AdminGetDownloadTaskListResponse.download_task_list:type_name -> morgana.v1.DownloadTask
+	14, // 46: morgana.v1.AdminRetryDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
+	14, // 47: morgana.v1.AdminCancelDownloadTaskResponse.download_task:type_name -> morgana.v1.DownloadTask
+	4,  // 48: morgana.v1.DownloadTaskStatusCount.download_status:type_name -> morgana.v1.DownloadStatus
+	83, // 49: morgana.v1.AdminGetSystemStatsResponse.download_task_status_count_list:type_name -> morgana.v1.DownloadTaskStatusCount
+	7,  // 50: morgana.v1.AdminGetAuditEventListResponse.audit_event_list:type_name -> morgana.v1.AuditEvent
+	15, // 51: morgana.v1.MorganaService.CreateAccount:input_type -> morgana.v1.CreateAccountRequest
+	17, // 52: morgana.v1.MorganaService.CreateSession:input_type -> morgana.v1.CreateSessionRequest
+	19, // 53: morgana.v1.MorganaService.RefreshSession:input_type -> morgana.v1.RefreshSessionRequest
+	21, // 54: morgana.v1.MorganaService.DeleteSession:input_type -> morgana.v1.DeleteSessionRequest
+	23, // 55: morgana.v1.MorganaService.ListSessions:input_type -> morgana.v1.ListSessionsRequest
+	25, // 56: morgana.v1.MorganaService.RevokeSession:input_type -> morgana.v1.RevokeSessionRequest
+	27, // 57: morgana.v1.MorganaService.ChangePassword:input_type -> morgana.v1.ChangePasswordRequest
+	29, // 58: morgana.v1.MorganaService.RequestPasswordReset:input_type -> morgana.v1.RequestPasswordResetRequest
+	31, // 59: morgana.v1.MorganaService.ResetPassword:input_type -> morgana.v1.ResetPasswordRequest
+	33, // 60: morgana.v1.MorganaService.EnrollTwoFactor:input_type -> morgana.v1.EnrollTwoFactorRequest
+	35, // 61: morgana.v1.MorganaService.ConfirmTwoFactor:input_type -> morgana.v1.ConfirmTwoFactorRequest
+	37, // 62: morgana.v1.MorganaService.DisableTwoFactor:input_type -> morgana.v1.DisableTwoFactorRequest
+	39, // 63: morgana.v1.MorganaService.CreateAPIKey:input_type -> morgana.v1.CreateAPIKeyRequest
+	41, // 64: morgana.v1.MorganaService.GetAPIKeyList:input_type -> morgana.v1.GetAPIKeyListRequest
+	43, // 65: morgana.v1.MorganaService.RevokeAPIKey:input_type -> morgana.v1.RevokeAPIKeyRequest
+	45, // 66: morgana.v1.MorganaService.CreateDownloadTask:input_type -> morgana.v1.CreateDownloadTaskRequest
+	47, // 67: morgana.v1.MorganaService.GetDownloadTaskList:input_type -> morgana.v1.GetDownloadTaskListRequest
+	49, // 68: morgana.v1.MorganaService.UpdateDownloadTask:input_type -> morgana.v1.UpdateDownloadTaskRequest
+	51, // 69: morgana.v1.MorganaService.DeleteDownloadTask:input_type -> morgana.v1.DeleteDownloadTaskRequest
+	53, // 70: morgana.v1.MorganaService.GetDownloadTaskFile:input_type -> morgana.v1.GetDownloadTaskFileRequest
+	55, // 71: morgana.v1.MorganaService.GetUsage:input_type -> morgana.v1.GetUsageRequest
+	57, // 72: morgana.v1.MorganaService.CreateOrganization:input_type -> morgana.v1.CreateOrganizationRequest
+	59, // 73: morgana.v1.MorganaService.GetOrganizationList:input_type -> morgana.v1.GetOrganizationListRequest
+	61, // 74: morgana.v1.MorganaService.GetOrganizationMemberList:input_type -> morgana.v1.GetOrganizationMemberListRequest
+	63, // 75: morgana.v1.MorganaService.AddOrganizationMember:input_type -> morgana.v1.AddOrganizationMemberRequest
+	65, // 76: morgana.v1.MorganaService.UpdateOrganizationMember:input_type -> morgana.v1.UpdateOrganizationMemberRequest
+	67, // 77: morgana.v1.MorganaService.RemoveOrganizationMember:input_type -> morgana.v1.RemoveOrganizationMemberRequest
+	69, // 78: morgana.v1.AdminService.GetAccountList:input_type -> morgana.v1.AdminGetAccountListRequest
+	71, // 79: morgana.v1.AdminService.UpdateAccountRole:input_type -> morgana.v1.AdminUpdateAccountRoleRequest
+	73, // 80: morgana.v1.AdminService.DisableAccount:input_type -> morgana.v1.AdminDisableAccountRequest
+	75, // 81: morgana.v1.AdminService.EnableAccount:input_type -> morgana.v1.AdminEnableAccountRequest
+	77, // 82: morgana.v1.AdminService.GetDownloadTaskList:input_type -> morgana.v1.AdminGetDownloadTaskListRequest
+	79, // 83: morgana.v1.AdminService.RetryDownloadTask:input_type -> morgana.v1.AdminRetryDownloadTaskRequest
+	81, // 84: morgana.v1.AdminService.CancelDownloadTask:input_type -> morgana.v1.AdminCancelDownloadTaskRequest
+	84, // 85: morgana.v1.AdminService.GetSystemStats:input_type -> morgana.v1.AdminGetSystemStatsRequest
+	86, // 86: morgana.v1.AdminService.UnlockLogin:input_type -> morgana.v1.AdminUnlockLoginRequest
+	88, // 87: morgana.v1.AdminService.GetAuditEventList:input_type -> morgana.v1.AdminGetAuditEventListRequest
+	16, // 88: morgana.v1.MorganaService.CreateAccount:output_type -> morgana.v1.CreateAccountResponse
+	18, // 89: morgana.v1.MorganaService.CreateSession:output_type -> morgana.v1.CreateSessionResponse
+	20, // 90: morgana.v1.MorganaService.RefreshSession:output_type -> morgana.v1.RefreshSessionResponse
+	22, // 91: morgana.v1.MorganaService.DeleteSession:output_type -> morgana.v1.DeleteSessionResponse
+	24, // 92: morgana.v1.MorganaService.ListSessions:output_type -> morgana.v1.ListSessionsResponse
+	26, // 93: morgana.v1.MorganaService.RevokeSession:output_type -> morgana.v1.RevokeSessionResponse
+	28, // 94: morgana.v1.MorganaService.ChangePassword:output_type -> morgana.v1.ChangePasswordResponse
+	30, // 95: morgana.v1.MorganaService.RequestPasswordReset:output_type -> morgana.v1.RequestPasswordResetResponse
+	32, // 96: morgana.v1.MorganaService.ResetPassword:output_type -> morgana.v1.ResetPasswordResponse
+	34, // 97: morgana.v1.MorganaService.EnrollTwoFactor:output_type -> morgana.v1.EnrollTwoFactorResponse
+	36, // 98: morgana.v1.MorganaService.ConfirmTwoFactor:output_type -> morgana.v1.ConfirmTwoFactorResponse
+	38, // 99: morgana.v1.MorganaService.DisableTwoFactor:output_type -> morgana.v1.DisableTwoFactorResponse
+	40, // 100: morgana.v1.MorganaService.CreateAPIKey:output_type -> morgana.v1.CreateAPIKeyResponse
+	42, // 101: morgana.v1.MorganaService.GetAPIKeyList:output_type -> morgana.v1.GetAPIKeyListResponse
+	44, // 102: morgana.v1.MorganaService.RevokeAPIKey:output_type -> morgana.v1.RevokeAPIKeyResponse
+	46, // 103: morgana.v1.MorganaService.CreateDownloadTask:output_type -> morgana.v1.CreateDownloadTaskResponse
+	48, // 104: morgana.v1.MorganaService.GetDownloadTaskList:output_type -> morgana.v1.GetDownloadTaskListResponse
+	50, // 105: morgana.v1.MorganaService.UpdateDownloadTask:output_type -> morgana.v1.UpdateDownloadTaskResponse
+	52, // 106: morgana.v1.MorganaService.DeleteDownloadTask:output_type -> morgana.v1.DeleteDownloadTaskResponse
+	54, // 107: morgana.v1.MorganaService.GetDownloadTaskFile:output_type -> morgana.v1.GetDownloadTaskFileResponse
+	56, // 108: morgana.v1.MorganaService.GetUsage:output_type -> morgana.v1.GetUsageResponse
+	58, // 109: morgana.v1.MorganaService.CreateOrganization:output_type -> morgana.v1.CreateOrganizationResponse
+	60, // 110: morgana.v1.MorganaService.GetOrganizationList:output_type -> morgana.v1.GetOrganizationListResponse
+	62, // 111: morgana.v1.MorganaService.GetOrganizationMemberList:output_type -> morgana.v1.GetOrganizationMemberListResponse
+	64, // 112: morgana.v1.MorganaService.AddOrganizationMember:output_type -> morgana.v1.AddOrganizationMemberResponse
+	66, // 113: morgana.v1.MorganaService.UpdateOrganizationMember:output_type -> morgana.v1.UpdateOrganizationMemberResponse
+	68, // 114: morgana.v1.MorganaService.RemoveOrganizationMember:output_type -> morgana.v1.RemoveOrganizationMemberResponse
+	70, // 115: morgana.v1.AdminService.GetAccountList:output_type -> morgana.v1.AdminGetAccountListResponse
+	72, // 116: morgana.v1.AdminService.UpdateAccountRole:output_type -> morgana.v1.AdminUpdateAccountRoleResponse
+	74, // 117: morgana.v1.AdminService.DisableAccount:output_type -> morgana.v1.AdminDisableAccountResponse
+	76, // 118: morgana.v1.AdminService.EnableAccount:output_type -> morgana.v1.AdminEnableAccountResponse
+	78, // 119: morgana.v1.AdminService.GetDownloadTaskList:output_type -> morgana.v1.AdminGetDownloadTaskListResponse
+	80, // 120: morgana.v1.AdminService.RetryDownloadTask:output_type -> morgana.v1.AdminRetryDownloadTaskResponse
+	82, // 121: morgana.v1.AdminService.CancelDownloadTask:output_type -> morgana.v1.AdminCancelDownloadTaskResponse
+	85, // 122: morgana.v1.AdminService.GetSystemStats:output_type -> morgana.v1.AdminGetSystemStatsResponse
+	87, // 123: morgana.v1.AdminService.UnlockLogin:output_type -> morgana.v1.AdminUnlockLoginResponse
+	89, // 124: morgana.v1.AdminService.GetAuditEventList:output_type -> morgana.v1.AdminGetAuditEventListResponse
+	88, // [88:125] is the sub-list for method output_type
+	51, // [51:88] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_morgana_v1_morgana_proto_init() }
@@ -1046,15 +5110,19 @@ func file_morgana_v1_morgana_proto_init() {
 	if File_morgana_v1_morgana_proto != nil {
 		return
 	}
+	file_morgana_v1_morgana_proto_msgTypes[8].OneofWrappers = []any{
+		(*DownloadTaskOwner_AccountId)(nil),
+		(*DownloadTaskOwner_OrganizationId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_morgana_v1_morgana_proto_rawDesc), len(file_morgana_v1_morgana_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   85,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_morgana_v1_morgana_proto_goTypes,
 		DependencyIndexes: file_morgana_v1_morgana_proto_depIdxs,
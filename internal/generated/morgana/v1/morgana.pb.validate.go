@@ -137,78 +137,8175 @@ var _ interface {
 	ErrorName() string
 } = AccountValidationError{}
 
-// Validate checks the field values on DownloadTask with the rules defined in
+// Validate checks the field values on AdminAccount with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DownloadTask) Validate() error {
+func (m *AdminAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAccountMultiError, or
+// nil if none found.
+func (m *AdminAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	// no validation rules for Disabled
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAccountMultiError is an error wrapping multiple validation errors
+// returned by AdminAccount.ValidateAll() if the designated constraints aren't met.
+type AdminAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAccountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAccountMultiError) AllErrors() []error { return m }
+
+// AdminAccountValidationError is the validation error returned by
+// AdminAccount.Validate if the designated constraints aren't met.
+type AdminAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAccountValidationError) ErrorName() string { return "AdminAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAccountValidationError{}
+
+// Validate checks the field values on AuditEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EventType
+
+	// no validation rules for AccountName
+
+	// no validation rules for IpAddress
+
+	if all {
+		switch v := interface{}(m.GetActorAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "ActorAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "ActorAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActorAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "ActorAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEventMultiError) AllErrors() []error { return m }
+
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEventValidationError{}
+
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Session) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Session) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefreshedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "RefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "RefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "RefreshedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Current
+
+	if len(errors) > 0 {
+		return SessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionMultiError) AllErrors() []error { return m }
+
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIKeyMultiError, or nil if none found.
+func (m *APIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for KeyPrefix
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return APIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIKeyMultiError is an error wrapping multiple validation errors returned by
+// APIKey.ValidateAll() if the designated constraints aren't met.
+type APIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyMultiError) AllErrors() []error { return m }
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrganizationName
+
+	if len(errors) > 0 {
+		return OrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMultiError) AllErrors() []error { return m }
+
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrganizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationValidationError{}
+
+// Validate checks the field values on OrganizationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationMemberMultiError, or nil if none found.
+func (m *OrganizationMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationMemberValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return OrganizationMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMemberMultiError is an error wrapping multiple validation errors
+// returned by OrganizationMember.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMemberMultiError) AllErrors() []error { return m }
+
+// OrganizationMemberValidationError is the validation error returned by
+// OrganizationMember.Validate if the designated constraints aren't met.
+type OrganizationMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationMemberValidationError) ErrorName() string {
+	return "OrganizationMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationMemberValidationError{}
+
+// Validate checks the field values on AccountOrganization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountOrganization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountOrganization with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountOrganizationMultiError, or nil if none found.
+func (m *AccountOrganization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountOrganization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountOrganizationValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountOrganizationValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountOrganizationValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return AccountOrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountOrganizationMultiError is an error wrapping multiple validation
+// errors returned by AccountOrganization.ValidateAll() if the designated
+// constraints aren't met.
+type AccountOrganizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountOrganizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountOrganizationMultiError) AllErrors() []error { return m }
+
+// AccountOrganizationValidationError is the validation error returned by
+// AccountOrganization.Validate if the designated constraints aren't met.
+type AccountOrganizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountOrganizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountOrganizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountOrganizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountOrganizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountOrganizationValidationError) ErrorName() string {
+	return "AccountOrganizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccountOrganizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountOrganization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountOrganizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountOrganizationValidationError{}
+
+// Validate checks the field values on DownloadTaskOwner with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskOwner) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskOwner with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskOwnerMultiError, or nil if none found.
+func (m *DownloadTaskOwner) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskOwner) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Owner.(type) {
+	case *DownloadTaskOwner_AccountId:
+		if v == nil {
+			err := DownloadTaskOwnerValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for AccountId
+	case *DownloadTaskOwner_OrganizationId:
+		if v == nil {
+			err := DownloadTaskOwnerValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for OrganizationId
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskOwnerMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskOwnerMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskOwner.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskOwnerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskOwnerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskOwnerMultiError) AllErrors() []error { return m }
+
+// DownloadTaskOwnerValidationError is the validation error returned by
+// DownloadTaskOwner.Validate if the designated constraints aren't met.
+type DownloadTaskOwnerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskOwnerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskOwnerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskOwnerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskOwnerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskOwnerValidationError) ErrorName() string {
+	return "DownloadTaskOwnerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskOwnerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskOwner.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskOwnerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskOwnerValidationError{}
+
+// Validate checks the field values on DownloadTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DownloadTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DownloadTaskMultiError, or
+// nil if none found.
+func (m *DownloadTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DownloadType
+
+	// no validation rules for Url
+
+	// no validation rules for DownloadStatus
+
+	// no validation rules for FileName
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FileSize
+
+	if len(errors) > 0 {
+		return DownloadTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskMultiError is an error wrapping multiple validation errors
+// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
+type DownloadTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskMultiError) AllErrors() []error { return m }
+
+// DownloadTaskValidationError is the validation error returned by
+// DownloadTask.Validate if the designated constraints aren't met.
+type DownloadTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskValidationError{}
+
+// Validate checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountRequestMultiError, or nil if none found.
+func (m *CreateAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountName
+
+	// no validation rules for Password
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return CreateAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateAccountRequestValidationError is the validation error returned by
+// CreateAccountRequest.Validate if the designated constraints aren't met.
+type CreateAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountRequestValidationError) ErrorName() string {
+	return "CreateAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountRequestValidationError{}
+
+// Validate checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountResponseMultiError, or nil if none found.
+func (m *CreateAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return CreateAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateAccountResponseValidationError is the validation error returned by
+// CreateAccountResponse.Validate if the designated constraints aren't met.
+type CreateAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountResponseValidationError) ErrorName() string {
+	return "CreateAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountResponseValidationError{}
+
+// Validate checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionRequestMultiError, or nil if none found.
+func (m *CreateSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountName
+
+	// no validation rules for Password
+
+	// no validation rules for TwoFactorChallengeToken
+
+	// no validation rules for TwoFactorCode
+
+	if len(errors) > 0 {
+		return CreateSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSessionRequestValidationError is the validation error returned by
+// CreateSessionRequest.Validate if the designated constraints aren't met.
+type CreateSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionRequestValidationError) ErrorName() string {
+	return "CreateSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionRequestValidationError{}
+
+// Validate checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionResponseMultiError, or nil if none found.
+func (m *CreateSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSessionResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TwoFactorChallengeToken
+
+	if all {
+		switch v := interface{}(m.GetTwoFactorChallengeExpireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "TwoFactorChallengeExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "TwoFactorChallengeExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTwoFactorChallengeExpireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSessionResponseValidationError{
+				field:  "TwoFactorChallengeExpireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+
+// CreateSessionResponseValidationError is the validation error returned by
+// CreateSessionResponse.Validate if the designated constraints aren't met.
+type CreateSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionResponseValidationError) ErrorName() string {
+	return "CreateSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionResponseValidationError{}
+
+// Validate checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return RefreshSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
+
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionRequestValidationError{}
+
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RefreshSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
+
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionResponseValidationError{}
+
+// Validate checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionRequestMultiError, or nil if none found.
+func (m *DeleteSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSessionRequestValidationError is the validation error returned by
+// DeleteSessionRequest.Validate if the designated constraints aren't met.
+type DeleteSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionRequestValidationError) ErrorName() string {
+	return "DeleteSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionRequestValidationError{}
+
+// Validate checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionResponseMultiError, or nil if none found.
+func (m *DeleteSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSessionResponseValidationError is the validation error returned by
+// DeleteSessionResponse.Validate if the designated constraints aren't met.
+type DeleteSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionResponseValidationError) ErrorName() string {
+	return "DeleteSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionResponseValidationError{}
+
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsRequestValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessionList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("SessionList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionResponseMultiError, or nil if none found.
+func (m *RevokeSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionResponseValidationError is the validation error returned by
+// RevokeSessionResponse.Validate if the designated constraints aren't met.
+type RevokeSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionResponseValidationError) ErrorName() string {
+	return "RevokeSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionResponseValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CurrentPassword
+
+	// no validation rules for NewPassword
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChangePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
+
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordResponseValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountName
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+// Validate checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetResponseMultiError, or nil if none found.
+func (m *RequestPasswordResetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestPasswordResetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetResponseMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetResponseValidationError is the validation error returned
+// by RequestPasswordResetResponse.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetResponseValidationError) ErrorName() string {
+	return "RequestPasswordResetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetResponseValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PasswordResetToken
+
+	// no validation rules for NewPassword
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+// Validate checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordResponseMultiError, or nil if none found.
+func (m *ResetPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordResponseMultiError) AllErrors() []error { return m }
+
+// ResetPasswordResponseValidationError is the validation error returned by
+// ResetPasswordResponse.Validate if the designated constraints aren't met.
+type ResetPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordResponseValidationError) ErrorName() string {
+	return "ResetPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordResponseValidationError{}
+
+// Validate checks the field values on EnrollTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTwoFactorRequestMultiError, or nil if none found.
+func (m *EnrollTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnrollTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by EnrollTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnrollTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTwoFactorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// EnrollTwoFactorRequestValidationError is the validation error returned by
+// EnrollTwoFactorRequest.Validate if the designated constraints aren't met.
+type EnrollTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTwoFactorRequestValidationError) ErrorName() string {
+	return "EnrollTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTwoFactorRequestValidationError{}
+
+// Validate checks the field values on EnrollTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTwoFactorResponseMultiError, or nil if none found.
+func (m *EnrollTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for OtpauthUri
+
+	// no validation rules for QrCodePng
+
+	if len(errors) > 0 {
+		return EnrollTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by EnrollTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnrollTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTwoFactorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTwoFactorResponseValidationError is the validation error returned by
+// EnrollTwoFactorResponse.Validate if the designated constraints aren't met.
+type EnrollTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTwoFactorResponseValidationError) ErrorName() string {
+	return "EnrollTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTwoFactorResponseValidationError{}
+
+// Validate checks the field values on ConfirmTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTwoFactorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTwoFactorRequestMultiError, or nil if none found.
+func (m *ConfirmTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return ConfirmTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTwoFactorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTwoFactorRequestValidationError is the validation error returned by
+// ConfirmTwoFactorRequest.Validate if the designated constraints aren't met.
+type ConfirmTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTwoFactorRequestValidationError) ErrorName() string {
+	return "ConfirmTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTwoFactorRequestValidationError{}
+
+// Validate checks the field values on ConfirmTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTwoFactorResponseMultiError, or nil if none found.
+func (m *ConfirmTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTwoFactorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTwoFactorResponseValidationError is the validation error returned by
+// ConfirmTwoFactorResponse.Validate if the designated constraints aren't met.
+type ConfirmTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTwoFactorResponseValidationError) ErrorName() string {
+	return "ConfirmTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTwoFactorResponseValidationError{}
+
+// Validate checks the field values on DisableTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTwoFactorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTwoFactorRequestMultiError, or nil if none found.
+func (m *DisableTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DisableTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTwoFactorRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// DisableTwoFactorRequestValidationError is the validation error returned by
+// DisableTwoFactorRequest.Validate if the designated constraints aren't met.
+type DisableTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTwoFactorRequestValidationError) ErrorName() string {
+	return "DisableTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTwoFactorRequestValidationError{}
+
+// Validate checks the field values on DisableTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTwoFactorResponseMultiError, or nil if none found.
+func (m *DisableTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTwoFactorResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// DisableTwoFactorResponseValidationError is the validation error returned by
+// DisableTwoFactorResponse.Validate if the designated constraints aren't met.
+type DisableTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTwoFactorResponseValidationError) ErrorName() string {
+	return "DisableTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTwoFactorResponseValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on GetAPIKeyListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAPIKeyListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAPIKeyListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAPIKeyListRequestMultiError, or nil if none found.
+func (m *GetAPIKeyListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAPIKeyListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAPIKeyListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAPIKeyListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAPIKeyListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAPIKeyListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAPIKeyListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAPIKeyListRequestMultiError) AllErrors() []error { return m }
+
+// GetAPIKeyListRequestValidationError is the validation error returned by
+// GetAPIKeyListRequest.Validate if the designated constraints aren't met.
+type GetAPIKeyListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAPIKeyListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAPIKeyListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAPIKeyListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAPIKeyListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAPIKeyListRequestValidationError) ErrorName() string {
+	return "GetAPIKeyListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAPIKeyListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAPIKeyListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAPIKeyListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAPIKeyListRequestValidationError{}
+
+// Validate checks the field values on GetAPIKeyListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAPIKeyListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAPIKeyListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAPIKeyListResponseMultiError, or nil if none found.
+func (m *GetAPIKeyListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAPIKeyListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeyList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAPIKeyListResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAPIKeyListResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAPIKeyListResponseValidationError{
+					field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetAPIKeyListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAPIKeyListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAPIKeyListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAPIKeyListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAPIKeyListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAPIKeyListResponseMultiError) AllErrors() []error { return m }
+
+// GetAPIKeyListResponseValidationError is the validation error returned by
+// GetAPIKeyListResponse.Validate if the designated constraints aren't met.
+type GetAPIKeyListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAPIKeyListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAPIKeyListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAPIKeyListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAPIKeyListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAPIKeyListResponseValidationError) ErrorName() string {
+	return "GetAPIKeyListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAPIKeyListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAPIKeyListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAPIKeyListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAPIKeyListResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKeyId
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyResponseValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskRequestMultiError, or nil if none found.
+func (m *CreateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadType
+
+	// no validation rules for Url
+
+	// no validation rules for FileName
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskRequestValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskRequestValidationError is the validation error returned by
+// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CreateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
+	return "CreateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskResponseMultiError, or nil if none found.
+func (m *CreateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskResponseValidationError is the validation error returned
+// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CreateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
+	return "CreateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDownloadTaskListRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDownloadTaskListRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDownloadTaskListRequestValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListRequestValidationError is the validation error returned
+// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ToalDownloadTaskCount
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListResponseValidationError is the validation error returned
+// by GetDownloadTaskListResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskRequestMultiError, or nil if none found.
+func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskRequestValidationError is the validation error returned by
+// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
+	return "UpdateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskResponseMultiError, or nil if none found.
+func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskResponseValidationError is the validation error returned
+// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
+	return "UpdateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskRequestMultiError, or nil if none found.
+func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskRequestValidationError is the validation error returned by
+// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
+	return "DeleteDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskResponseMultiError, or nil if none found.
+func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskResponseValidationError is the validation error returned
+// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
+	return "DeleteDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileRequestValidationError is the validation error returned
+// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileResponseValidationError is the validation error returned
+// by GetDownloadTaskFileResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileResponseValidationError{}
+
+// Validate checks the field values on GetUsageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageRequestMultiError, or nil if none found.
+func (m *GetUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUsageRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUsageRequestValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUsageRequestValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUsageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetUsageRequestValidationError is the validation error returned by
+// GetUsageRequest.Validate if the designated constraints aren't met.
+type GetUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageRequestValidationError) ErrorName() string { return "GetUsageRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageRequestValidationError{}
+
+// Validate checks the field values on GetUsageResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUsageResponseMultiError, or nil if none found.
+func (m *GetUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskCount
+
+	// no validation rules for TotalFileSize
+
+	// no validation rules for MaxDownloadTaskCount
+
+	// no validation rules for MaxTotalFileSize
+
+	if len(errors) > 0 {
+		return GetUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUsageResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUsageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUsageResponseMultiError) AllErrors() []error { return m }
+
+// GetUsageResponseValidationError is the validation error returned by
+// GetUsageResponse.Validate if the designated constraints aren't met.
+type GetUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUsageResponseValidationError) ErrorName() string { return "GetUsageResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUsageResponseValidationError{}
+
+// Validate checks the field values on CreateOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrganizationName
+
+	if len(errors) > 0 {
+		return CreateOrganizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateOrganizationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationRequestValidationError is the validation error returned by
+// CreateOrganizationRequest.Validate if the designated constraints aren't met.
+type CreateOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationRequestValidationError{}
+
+// Validate checks the field values on CreateOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrganizationResponseMultiError, or nil if none found.
+func (m *CreateOrganizationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganization()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrganizationResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrganizationResponseValidationError{
+					field:  "Organization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganization()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrganizationResponseValidationError{
+				field:  "Organization",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateOrganizationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateOrganizationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateOrganizationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationResponseMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationResponseValidationError is the validation error returned
+// by CreateOrganizationResponse.Validate if the designated constraints aren't met.
+type CreateOrganizationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationResponseValidationError) ErrorName() string {
+	return "CreateOrganizationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationResponseValidationError{}
+
+// Validate checks the field values on GetOrganizationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrganizationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrganizationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrganizationListRequestMultiError, or nil if none found.
+func (m *GetOrganizationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrganizationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetOrganizationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrganizationListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetOrganizationListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetOrganizationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrganizationListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrganizationListRequestMultiError) AllErrors() []error { return m }
+
+// GetOrganizationListRequestValidationError is the validation error returned
+// by GetOrganizationListRequest.Validate if the designated constraints aren't met.
+type GetOrganizationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrganizationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrganizationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrganizationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrganizationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrganizationListRequestValidationError) ErrorName() string {
+	return "GetOrganizationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrganizationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrganizationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrganizationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrganizationListRequestValidationError{}
+
+// Validate checks the field values on GetOrganizationListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrganizationListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrganizationListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrganizationListResponseMultiError, or nil if none found.
+func (m *GetOrganizationListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrganizationListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrganizationList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrganizationListResponseValidationError{
+						field:  fmt.Sprintf("OrganizationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrganizationListResponseValidationError{
+						field:  fmt.Sprintf("OrganizationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrganizationListResponseValidationError{
+					field:  fmt.Sprintf("OrganizationList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrganizationListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrganizationListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetOrganizationListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetOrganizationListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrganizationListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrganizationListResponseMultiError) AllErrors() []error { return m }
+
+// GetOrganizationListResponseValidationError is the validation error returned
+// by GetOrganizationListResponse.Validate if the designated constraints
+// aren't met.
+type GetOrganizationListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrganizationListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrganizationListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrganizationListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrganizationListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrganizationListResponseValidationError) ErrorName() string {
+	return "GetOrganizationListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrganizationListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrganizationListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrganizationListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrganizationListResponseValidationError{}
+
+// Validate checks the field values on GetOrganizationMemberListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetOrganizationMemberListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrganizationMemberListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetOrganizationMemberListRequestMultiError, or nil if none found.
+func (m *GetOrganizationMemberListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrganizationMemberListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrganizationId
+
+	if len(errors) > 0 {
+		return GetOrganizationMemberListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrganizationMemberListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetOrganizationMemberListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrganizationMemberListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrganizationMemberListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrganizationMemberListRequestMultiError) AllErrors() []error { return m }
+
+// GetOrganizationMemberListRequestValidationError is the validation error
+// returned by GetOrganizationMemberListRequest.Validate if the designated
+// constraints aren't met.
+type GetOrganizationMemberListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrganizationMemberListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrganizationMemberListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrganizationMemberListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrganizationMemberListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrganizationMemberListRequestValidationError) ErrorName() string {
+	return "GetOrganizationMemberListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrganizationMemberListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrganizationMemberListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrganizationMemberListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrganizationMemberListRequestValidationError{}
+
+// Validate checks the field values on GetOrganizationMemberListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetOrganizationMemberListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrganizationMemberListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetOrganizationMemberListResponseMultiError, or nil if none found.
+func (m *GetOrganizationMemberListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrganizationMemberListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrganizationMemberList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrganizationMemberListResponseValidationError{
+						field:  fmt.Sprintf("OrganizationMemberList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrganizationMemberListResponseValidationError{
+						field:  fmt.Sprintf("OrganizationMemberList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrganizationMemberListResponseValidationError{
+					field:  fmt.Sprintf("OrganizationMemberList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrganizationMemberListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrganizationMemberListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetOrganizationMemberListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrganizationMemberListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrganizationMemberListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrganizationMemberListResponseMultiError) AllErrors() []error { return m }
+
+// GetOrganizationMemberListResponseValidationError is the validation error
+// returned by GetOrganizationMemberListResponse.Validate if the designated
+// constraints aren't met.
+type GetOrganizationMemberListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrganizationMemberListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrganizationMemberListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrganizationMemberListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrganizationMemberListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrganizationMemberListResponseValidationError) ErrorName() string {
+	return "GetOrganizationMemberListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrganizationMemberListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrganizationMemberListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrganizationMemberListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrganizationMemberListResponseValidationError{}
+
+// Validate checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrganizationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for AccountName
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return AddOrganizationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrganizationMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddOrganizationMemberRequestValidationError is the validation error returned
+// by AddOrganizationMemberRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrganizationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrganizationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrganizationMemberRequestValidationError{}
+
+// Validate checks the field values on AddOrganizationMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberResponseMultiError, or nil if none found.
+func (m *AddOrganizationMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrganizationMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganizationMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddOrganizationMemberResponseValidationError{
+					field:  "OrganizationMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddOrganizationMemberResponseValidationError{
+					field:  "OrganizationMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganizationMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddOrganizationMemberResponseValidationError{
+				field:  "OrganizationMember",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddOrganizationMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AddOrganizationMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrganizationMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+
+// AddOrganizationMemberResponseValidationError is the validation error
+// returned by AddOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type AddOrganizationMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrganizationMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrganizationMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrganizationMemberResponseValidationError) ErrorName() string {
+	return "AddOrganizationMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrganizationMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrganizationMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrganizationMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrganizationMemberResponseValidationError{}
+
+// Validate checks the field values on UpdateOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrganizationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrganizationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOrganizationMemberRequestMultiError, or nil if none found.
+func (m *UpdateOrganizationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrganizationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for AccountId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return UpdateOrganizationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrganizationMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOrganizationMemberRequestValidationError is the validation error
+// returned by UpdateOrganizationMemberRequest.Validate if the designated
+// constraints aren't met.
+type UpdateOrganizationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrganizationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrganizationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrganizationMemberRequestValidationError) ErrorName() string {
+	return "UpdateOrganizationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrganizationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrganizationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrganizationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrganizationMemberRequestValidationError{}
+
+// Validate checks the field values on UpdateOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateOrganizationMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOrganizationMemberResponseMultiError, or nil if none found.
+func (m *UpdateOrganizationMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrganizationMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrganizationMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOrganizationMemberResponseValidationError{
+					field:  "OrganizationMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOrganizationMemberResponseValidationError{
+					field:  "OrganizationMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganizationMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOrganizationMemberResponseValidationError{
+				field:  "OrganizationMember",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrganizationMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateOrganizationMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateOrganizationMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrganizationMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+
+// UpdateOrganizationMemberResponseValidationError is the validation error
+// returned by UpdateOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type UpdateOrganizationMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrganizationMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrganizationMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrganizationMemberResponseValidationError) ErrorName() string {
+	return "UpdateOrganizationMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrganizationMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrganizationMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrganizationMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrganizationMemberResponseValidationError{}
+
+// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberRequestValidationError is the validation error
+// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveOrganizationMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveOrganizationMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberResponseMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveOrganizationMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberResponseValidationError is the validation error
+// returned by RemoveOrganizationMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberResponseValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberResponseValidationError{}
+
+// Validate checks the field values on AdminGetAccountListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminGetAccountListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGetAccountListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminGetAccountListRequestMultiError, or nil if none found.
+func (m *AdminGetAccountListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGetAccountListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return AdminGetAccountListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGetAccountListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetAccountListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminGetAccountListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGetAccountListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGetAccountListRequestMultiError) AllErrors() []error { return m }
+
+// AdminGetAccountListRequestValidationError is the validation error returned
+// by AdminGetAccountListRequest.Validate if the designated constraints aren't met.
+type AdminGetAccountListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGetAccountListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGetAccountListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGetAccountListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGetAccountListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGetAccountListRequestValidationError) ErrorName() string {
+	return "AdminGetAccountListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminGetAccountListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGetAccountListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGetAccountListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGetAccountListRequestValidationError{}
+
+// Validate checks the field values on AdminGetAccountListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminGetAccountListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGetAccountListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminGetAccountListResponseMultiError, or nil if none found.
+func (m *AdminGetAccountListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGetAccountListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetAccountListResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetAccountListResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminGetAccountListResponseValidationError{
+					field:  fmt.Sprintf("AccountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAccountCount
+
+	if len(errors) > 0 {
+		return AdminGetAccountListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGetAccountListResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminGetAccountListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminGetAccountListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGetAccountListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGetAccountListResponseMultiError) AllErrors() []error { return m }
+
+// AdminGetAccountListResponseValidationError is the validation error returned
+// by AdminGetAccountListResponse.Validate if the designated constraints
+// aren't met.
+type AdminGetAccountListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGetAccountListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGetAccountListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGetAccountListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGetAccountListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGetAccountListResponseValidationError) ErrorName() string {
+	return "AdminGetAccountListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminGetAccountListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGetAccountListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGetAccountListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGetAccountListResponseValidationError{}
+
+// Validate checks the field values on AdminUpdateAccountRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUpdateAccountRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUpdateAccountRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountRoleRequestMultiError, or nil if none found.
+func (m *AdminUpdateAccountRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUpdateAccountRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return AdminUpdateAccountRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUpdateAccountRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateAccountRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateAccountRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUpdateAccountRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUpdateAccountRoleRequestMultiError) AllErrors() []error { return m }
+
+// AdminUpdateAccountRoleRequestValidationError is the validation error
+// returned by AdminUpdateAccountRoleRequest.Validate if the designated
+// constraints aren't met.
+type AdminUpdateAccountRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUpdateAccountRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUpdateAccountRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUpdateAccountRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUpdateAccountRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUpdateAccountRoleRequestValidationError) ErrorName() string {
+	return "AdminUpdateAccountRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUpdateAccountRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUpdateAccountRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUpdateAccountRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUpdateAccountRoleRequestValidationError{}
+
+// Validate checks the field values on AdminUpdateAccountRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUpdateAccountRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUpdateAccountRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminUpdateAccountRoleResponseMultiError, or nil if none found.
+func (m *AdminUpdateAccountRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUpdateAccountRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminUpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminUpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminUpdateAccountRoleResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminUpdateAccountRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUpdateAccountRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminUpdateAccountRoleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminUpdateAccountRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUpdateAccountRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUpdateAccountRoleResponseMultiError) AllErrors() []error { return m }
+
+// AdminUpdateAccountRoleResponseValidationError is the validation error
+// returned by AdminUpdateAccountRoleResponse.Validate if the designated
+// constraints aren't met.
+type AdminUpdateAccountRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUpdateAccountRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUpdateAccountRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUpdateAccountRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUpdateAccountRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUpdateAccountRoleResponseValidationError) ErrorName() string {
+	return "AdminUpdateAccountRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUpdateAccountRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUpdateAccountRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUpdateAccountRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUpdateAccountRoleResponseValidationError{}
+
+// Validate checks the field values on AdminDisableAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDisableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminDisableAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDisableAccountRequestMultiError, or nil if none found.
+func (m *AdminDisableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminDisableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return AdminDisableAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminDisableAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminDisableAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDisableAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminDisableAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminDisableAccountRequestMultiError) AllErrors() []error { return m }
+
+// AdminDisableAccountRequestValidationError is the validation error returned
+// by AdminDisableAccountRequest.Validate if the designated constraints aren't met.
+type AdminDisableAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminDisableAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminDisableAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminDisableAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminDisableAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminDisableAccountRequestValidationError) ErrorName() string {
+	return "AdminDisableAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminDisableAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminDisableAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminDisableAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminDisableAccountRequestValidationError{}
+
+// Validate checks the field values on AdminDisableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminDisableAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DownloadTask with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DownloadTaskMultiError, or
-// nil if none found.
-func (m *DownloadTask) ValidateAll() error {
+// ValidateAll checks the field values on AdminDisableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminDisableAccountResponseMultiError, or nil if none found.
+func (m *AdminDisableAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DownloadTask) validate(all bool) error {
+func (m *AdminDisableAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DownloadTaskValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DownloadTaskValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DownloadTaskValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for DownloadType
-
-	// no validation rules for Url
-
-	// no validation rules for DownloadStatus
-
 	if len(errors) > 0 {
-		return DownloadTaskMultiError(errors)
+		return AdminDisableAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DownloadTaskMultiError is an error wrapping multiple validation errors
-// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
-type DownloadTaskMultiError []error
+// AdminDisableAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminDisableAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminDisableAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DownloadTaskMultiError) Error() string {
+func (m AdminDisableAccountResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -217,11 +8314,12 @@ func (m DownloadTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DownloadTaskMultiError) AllErrors() []error { return m }
+func (m AdminDisableAccountResponseMultiError) AllErrors() []error { return m }
 
-// DownloadTaskValidationError is the validation error returned by
-// DownloadTask.Validate if the designated constraints aren't met.
-type DownloadTaskValidationError struct {
+// AdminDisableAccountResponseValidationError is the validation error returned
+// by AdminDisableAccountResponse.Validate if the designated constraints
+// aren't met.
+type AdminDisableAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -229,22 +8327,24 @@ type DownloadTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e DownloadTaskValidationError) Field() string { return e.field }
+func (e AdminDisableAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DownloadTaskValidationError) Reason() string { return e.reason }
+func (e AdminDisableAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DownloadTaskValidationError) Cause() error { return e.cause }
+func (e AdminDisableAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DownloadTaskValidationError) Key() bool { return e.key }
+func (e AdminDisableAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+func (e AdminDisableAccountResponseValidationError) ErrorName() string {
+	return "AdminDisableAccountResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DownloadTaskValidationError) Error() string {
+func (e AdminDisableAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -256,14 +8356,14 @@ func (e DownloadTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDownloadTask.%s: %s%s",
+		"invalid %sAdminDisableAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DownloadTaskValidationError{}
+var _ error = AdminDisableAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -271,48 +8371,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DownloadTaskValidationError{}
+} = AdminDisableAccountResponseValidationError{}
 
-// Validate checks the field values on CreateAccountRequest with the rules
+// Validate checks the field values on AdminEnableAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountRequest) Validate() error {
+func (m *AdminEnableAccountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminEnableAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAccountRequestMultiError, or nil if none found.
-func (m *CreateAccountRequest) ValidateAll() error {
+// AdminEnableAccountRequestMultiError, or nil if none found.
+func (m *AdminEnableAccountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountRequest) validate(all bool) error {
+func (m *AdminEnableAccountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountName
-
-	// no validation rules for Password
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return CreateAccountRequestMultiError(errors)
+		return AdminEnableAccountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAccountRequestMultiError []error
+// AdminEnableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminEnableAccountRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminEnableAccountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountRequestMultiError) Error() string {
+func (m AdminEnableAccountRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -321,11 +8419,11 @@ func (m CreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m AdminEnableAccountRequestMultiError) AllErrors() []error { return m }
 
-// CreateAccountRequestValidationError is the validation error returned by
-// CreateAccountRequest.Validate if the designated constraints aren't met.
-type CreateAccountRequestValidationError struct {
+// AdminEnableAccountRequestValidationError is the validation error returned by
+// AdminEnableAccountRequest.Validate if the designated constraints aren't met.
+type AdminEnableAccountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -333,24 +8431,24 @@ type CreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountRequestValidationError) Field() string { return e.field }
+func (e AdminEnableAccountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e AdminEnableAccountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e AdminEnableAccountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+func (e AdminEnableAccountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountRequestValidationError) ErrorName() string {
-	return "CreateAccountRequestValidationError"
+func (e AdminEnableAccountRequestValidationError) ErrorName() string {
+	return "AdminEnableAccountRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountRequestValidationError) Error() string {
+func (e AdminEnableAccountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -362,14 +8460,14 @@ func (e CreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountRequest.%s: %s%s",
+		"invalid %sAdminEnableAccountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountRequestValidationError{}
+var _ error = AdminEnableAccountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -377,46 +8475,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountRequestValidationError{}
+} = AdminEnableAccountRequestValidationError{}
 
-// Validate checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminEnableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountResponse) Validate() error {
+func (m *AdminEnableAccountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminEnableAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAccountResponseMultiError, or nil if none found.
-func (m *CreateAccountResponse) ValidateAll() error {
+// AdminEnableAccountResponseMultiError, or nil if none found.
+func (m *AdminEnableAccountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountResponse) validate(all bool) error {
+func (m *AdminEnableAccountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
-
 	if len(errors) > 0 {
-		return CreateAccountResponseMultiError(errors)
+		return AdminEnableAccountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAccountResponseMultiError []error
+// AdminEnableAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminEnableAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AdminEnableAccountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountResponseMultiError) Error() string {
+func (m AdminEnableAccountResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -425,11 +8521,11 @@ func (m CreateAccountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+func (m AdminEnableAccountResponseMultiError) AllErrors() []error { return m }
 
-// CreateAccountResponseValidationError is the validation error returned by
-// CreateAccountResponse.Validate if the designated constraints aren't met.
-type CreateAccountResponseValidationError struct {
+// AdminEnableAccountResponseValidationError is the validation error returned
+// by AdminEnableAccountResponse.Validate if the designated constraints aren't met.
+type AdminEnableAccountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -437,24 +8533,24 @@ type CreateAccountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountResponseValidationError) Field() string { return e.field }
+func (e AdminEnableAccountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+func (e AdminEnableAccountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+func (e AdminEnableAccountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+func (e AdminEnableAccountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountResponseValidationError) ErrorName() string {
-	return "CreateAccountResponseValidationError"
+func (e AdminEnableAccountResponseValidationError) ErrorName() string {
+	return "AdminEnableAccountResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountResponseValidationError) Error() string {
+func (e AdminEnableAccountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -466,14 +8562,14 @@ func (e CreateAccountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountResponse.%s: %s%s",
+		"invalid %sAdminEnableAccountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountResponseValidationError{}
+var _ error = AdminEnableAccountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -481,48 +8577,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountResponseValidationError{}
+} = AdminEnableAccountResponseValidationError{}
 
-// Validate checks the field values on CreateSessionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminGetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionRequest) Validate() error {
+func (m *AdminGetDownloadTaskListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateSessionRequestMultiError, or nil if none found.
-func (m *CreateSessionRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminGetDownloadTaskListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *AdminGetDownloadTaskListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionRequest) validate(all bool) error {
+func (m *AdminGetDownloadTaskListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountName
+	// no validation rules for Offset
 
-	// no validation rules for Password
+	// no validation rules for Limit
+
+	// no validation rules for AccountId
+
+	// no validation rules for DownloadStatus
 
 	if len(errors) > 0 {
-		return CreateSessionRequestMultiError(errors)
+		return AdminGetDownloadTaskListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateSessionRequestMultiError []error
+// AdminGetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetDownloadTaskListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGetDownloadTaskListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionRequestMultiError) Error() string {
+func (m AdminGetDownloadTaskListRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -531,11 +8631,12 @@ func (m CreateSessionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+func (m AdminGetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
 
-// CreateSessionRequestValidationError is the validation error returned by
-// CreateSessionRequest.Validate if the designated constraints aren't met.
-type CreateSessionRequestValidationError struct {
+// AdminGetDownloadTaskListRequestValidationError is the validation error
+// returned by AdminGetDownloadTaskListRequest.Validate if the designated
+// constraints aren't met.
+type AdminGetDownloadTaskListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -543,24 +8644,24 @@ type CreateSessionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionRequestValidationError) Field() string { return e.field }
+func (e AdminGetDownloadTaskListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+func (e AdminGetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+func (e AdminGetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+func (e AdminGetDownloadTaskListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionRequestValidationError) ErrorName() string {
-	return "CreateSessionRequestValidationError"
+func (e AdminGetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "AdminGetDownloadTaskListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionRequestValidationError) Error() string {
+func (e AdminGetDownloadTaskListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -572,14 +8673,14 @@ func (e CreateSessionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionRequest.%s: %s%s",
+		"invalid %sAdminGetDownloadTaskListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionRequestValidationError{}
+var _ error = AdminGetDownloadTaskListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -587,73 +8688,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionRequestValidationError{}
+} = AdminGetDownloadTaskListRequestValidationError{}
 
-// Validate checks the field values on CreateSessionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionResponse) Validate() error {
+// Validate checks the field values on AdminGetDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminGetDownloadTaskListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateSessionResponseMultiError, or nil if none found.
-func (m *CreateSessionResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminGetDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *AdminGetDownloadTaskListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionResponse) validate(all bool) error {
+func (m *AdminGetDownloadTaskListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
+				return AdminGetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSessionResponseValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalDownloadTaskCount
+
 	if len(errors) > 0 {
-		return CreateSessionResponseMultiError(errors)
+		return AdminGetDownloadTaskListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// AdminGetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminGetDownloadTaskListResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionResponseMultiError []error
+type AdminGetDownloadTaskListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionResponseMultiError) Error() string {
+func (m AdminGetDownloadTaskListResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -662,11 +8772,12 @@ func (m CreateSessionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+func (m AdminGetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
 
-// CreateSessionResponseValidationError is the validation error returned by
-// CreateSessionResponse.Validate if the designated constraints aren't met.
-type CreateSessionResponseValidationError struct {
+// AdminGetDownloadTaskListResponseValidationError is the validation error
+// returned by AdminGetDownloadTaskListResponse.Validate if the designated
+// constraints aren't met.
+type AdminGetDownloadTaskListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -674,24 +8785,24 @@ type CreateSessionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionResponseValidationError) Field() string { return e.field }
+func (e AdminGetDownloadTaskListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+func (e AdminGetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+func (e AdminGetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+func (e AdminGetDownloadTaskListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionResponseValidationError) ErrorName() string {
-	return "CreateSessionResponseValidationError"
+func (e AdminGetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "AdminGetDownloadTaskListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionResponseValidationError) Error() string {
+func (e AdminGetDownloadTaskListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -703,14 +8814,14 @@ func (e CreateSessionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionResponse.%s: %s%s",
+		"invalid %sAdminGetDownloadTaskListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionResponseValidationError{}
+var _ error = AdminGetDownloadTaskListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -718,48 +8829,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionResponseValidationError{}
+} = AdminGetDownloadTaskListResponseValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminRetryDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskRequest) Validate() error {
+func (m *AdminRetryDownloadTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskRequestMultiError, or nil if none found.
-func (m *CreateDownloadTaskRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminRetryDownloadTaskRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRetryDownloadTaskRequestMultiError, or nil if none found.
+func (m *AdminRetryDownloadTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskRequest) validate(all bool) error {
+func (m *AdminRetryDownloadTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadType
-
-	// no validation rules for Url
+	// no validation rules for DownloadTaskId
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskRequestMultiError(errors)
+		return AdminRetryDownloadTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateDownloadTaskRequestMultiError []error
+// AdminRetryDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminRetryDownloadTaskRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRetryDownloadTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskRequestMultiError) Error() string {
+func (m AdminRetryDownloadTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -768,11 +8877,12 @@ func (m CreateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminRetryDownloadTaskRequestMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskRequestValidationError is the validation error returned by
-// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type CreateDownloadTaskRequestValidationError struct {
+// AdminRetryDownloadTaskRequestValidationError is the validation error
+// returned by AdminRetryDownloadTaskRequest.Validate if the designated
+// constraints aren't met.
+type AdminRetryDownloadTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -780,24 +8890,24 @@ type CreateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminRetryDownloadTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminRetryDownloadTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminRetryDownloadTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminRetryDownloadTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
-	return "CreateDownloadTaskRequestValidationError"
+func (e AdminRetryDownloadTaskRequestValidationError) ErrorName() string {
+	return "AdminRetryDownloadTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskRequestValidationError) Error() string {
+func (e AdminRetryDownloadTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -809,14 +8919,14 @@ func (e CreateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminRetryDownloadTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskRequestValidationError{}
+var _ error = AdminRetryDownloadTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -824,24 +8934,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskRequestValidationError{}
+} = AdminRetryDownloadTaskRequestValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskResponse with the
+// Validate checks the field values on AdminRetryDownloadTaskResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskResponse) Validate() error {
+func (m *AdminRetryDownloadTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskResponseMultiError, or nil if none found.
-func (m *CreateDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminRetryDownloadTaskResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminRetryDownloadTaskResponseMultiError, or nil if none found.
+func (m *AdminRetryDownloadTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskResponse) validate(all bool) error {
+func (m *AdminRetryDownloadTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -852,7 +8962,7 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 		switch v := interface{}(m.GetDownloadTask()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
+				errors = append(errors, AdminRetryDownloadTaskResponseValidationError{
 					field:  "DownloadTask",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -860,7 +8970,7 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
+				errors = append(errors, AdminRetryDownloadTaskResponseValidationError{
 					field:  "DownloadTask",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -869,7 +8979,7 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateDownloadTaskResponseValidationError{
+			return AdminRetryDownloadTaskResponseValidationError{
 				field:  "DownloadTask",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -878,19 +8988,19 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskResponseMultiError(errors)
+		return AdminRetryDownloadTaskResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateDownloadTaskResponseMultiError []error
+// AdminRetryDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminRetryDownloadTaskResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminRetryDownloadTaskResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskResponseMultiError) Error() string {
+func (m AdminRetryDownloadTaskResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -899,11 +9009,12 @@ func (m CreateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AdminRetryDownloadTaskResponseMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskResponseValidationError is the validation error returned
-// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type CreateDownloadTaskResponseValidationError struct {
+// AdminRetryDownloadTaskResponseValidationError is the validation error
+// returned by AdminRetryDownloadTaskResponse.Validate if the designated
+// constraints aren't met.
+type AdminRetryDownloadTaskResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -911,24 +9022,24 @@ type CreateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AdminRetryDownloadTaskResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AdminRetryDownloadTaskResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AdminRetryDownloadTaskResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AdminRetryDownloadTaskResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
-	return "CreateDownloadTaskResponseValidationError"
+func (e AdminRetryDownloadTaskResponseValidationError) ErrorName() string {
+	return "AdminRetryDownloadTaskResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskResponseValidationError) Error() string {
+func (e AdminRetryDownloadTaskResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -940,14 +9051,14 @@ func (e CreateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		"invalid %sAdminRetryDownloadTaskResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskResponseValidationError{}
+var _ error = AdminRetryDownloadTaskResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -955,48 +9066,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskResponseValidationError{}
+} = AdminRetryDownloadTaskResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListRequest with the
+// Validate checks the field values on AdminCancelDownloadTaskRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListRequest) Validate() error {
+func (m *AdminCancelDownloadTaskRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskListRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminCancelDownloadTaskRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminCancelDownloadTaskRequestMultiError, or nil if none found.
+func (m *AdminCancelDownloadTaskRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListRequest) validate(all bool) error {
+func (m *AdminCancelDownloadTaskRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for DownloadTaskId
 
 	if len(errors) > 0 {
-		return GetDownloadTaskListRequestMultiError(errors)
+		return AdminCancelDownloadTaskRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListRequestMultiError []error
+// AdminCancelDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminCancelDownloadTaskRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminCancelDownloadTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListRequestMultiError) Error() string {
+func (m AdminCancelDownloadTaskRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1005,11 +9114,12 @@ func (m GetDownloadTaskListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+func (m AdminCancelDownloadTaskRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListRequestValidationError is the validation error returned
-// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskListRequestValidationError struct {
+// AdminCancelDownloadTaskRequestValidationError is the validation error
+// returned by AdminCancelDownloadTaskRequest.Validate if the designated
+// constraints aren't met.
+type AdminCancelDownloadTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1017,24 +9127,24 @@ type GetDownloadTaskListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+func (e AdminCancelDownloadTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+func (e AdminCancelDownloadTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+func (e AdminCancelDownloadTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+func (e AdminCancelDownloadTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskListRequestValidationError"
+func (e AdminCancelDownloadTaskRequestValidationError) ErrorName() string {
+	return "AdminCancelDownloadTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListRequestValidationError) Error() string {
+func (e AdminCancelDownloadTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1046,14 +9156,14 @@ func (e GetDownloadTaskListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		"invalid %sAdminCancelDownloadTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListRequestValidationError{}
+var _ error = AdminCancelDownloadTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1061,80 +9171,180 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListRequestValidationError{}
+} = AdminCancelDownloadTaskRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListResponse with the
+// Validate checks the field values on AdminCancelDownloadTaskResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListResponse) Validate() error {
+func (m *AdminCancelDownloadTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskListResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminCancelDownloadTaskResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminCancelDownloadTaskResponseMultiError, or nil if none found.
+func (m *AdminCancelDownloadTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListResponse) validate(all bool) error {
+func (m *AdminCancelDownloadTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDownloadTaskList() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminCancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetDownloadTaskListResponseValidationError{
-					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+				errors = append(errors, AdminCancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminCancelDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return AdminCancelDownloadTaskResponseMultiError(errors)
 	}
 
-	// no validation rules for ToalDownloadTaskCount
+	return nil
+}
+
+// AdminCancelDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminCancelDownloadTaskResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminCancelDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCancelDownloadTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCancelDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// AdminCancelDownloadTaskResponseValidationError is the validation error
+// returned by AdminCancelDownloadTaskResponse.Validate if the designated
+// constraints aren't met.
+type AdminCancelDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCancelDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCancelDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCancelDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCancelDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCancelDownloadTaskResponseValidationError) ErrorName() string {
+	return "AdminCancelDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCancelDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCancelDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCancelDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCancelDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on DownloadTaskStatusCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskStatusCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskStatusCount with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskStatusCountMultiError, or nil if none found.
+func (m *DownloadTaskStatusCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskStatusCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadStatus
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return GetDownloadTaskListResponseMultiError(errors)
+		return DownloadTaskStatusCountMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListResponseMultiError []error
+// DownloadTaskStatusCountMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskStatusCount.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskStatusCountMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListResponseMultiError) Error() string {
+func (m DownloadTaskStatusCountMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1143,12 +9353,11 @@ func (m GetDownloadTaskListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+func (m DownloadTaskStatusCountMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListResponseValidationError is the validation error returned
-// by GetDownloadTaskListResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskListResponseValidationError struct {
+// DownloadTaskStatusCountValidationError is the validation error returned by
+// DownloadTaskStatusCount.Validate if the designated constraints aren't met.
+type DownloadTaskStatusCountValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1156,24 +9365,24 @@ type GetDownloadTaskListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+func (e DownloadTaskStatusCountValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+func (e DownloadTaskStatusCountValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+func (e DownloadTaskStatusCountValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+func (e DownloadTaskStatusCountValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskListResponseValidationError"
+func (e DownloadTaskStatusCountValidationError) ErrorName() string {
+	return "DownloadTaskStatusCountValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListResponseValidationError) Error() string {
+func (e DownloadTaskStatusCountValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1185,14 +9394,14 @@ func (e GetDownloadTaskListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		"invalid %sDownloadTaskStatusCount.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListResponseValidationError{}
+var _ error = DownloadTaskStatusCountValidationError{}
 
 var _ interface {
 	Field() string
@@ -1200,48 +9409,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListResponseValidationError{}
+} = DownloadTaskStatusCountValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminGetSystemStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskRequest) Validate() error {
+func (m *AdminGetSystemStatsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// ValidateAll checks the field values on AdminGetSystemStatsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskRequestMultiError, or nil if none found.
-func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+// AdminGetSystemStatsRequestMultiError, or nil if none found.
+func (m *AdminGetSystemStatsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+func (m *AdminGetSystemStatsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
-
-	// no validation rules for Url
-
 	if len(errors) > 0 {
-		return UpdateDownloadTaskRequestMultiError(errors)
+		return AdminGetSystemStatsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateDownloadTaskRequestMultiError []error
+// AdminGetSystemStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetSystemStatsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminGetSystemStatsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskRequestMultiError) Error() string {
+func (m AdminGetSystemStatsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1250,11 +9455,11 @@ func (m UpdateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminGetSystemStatsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskRequestValidationError is the validation error returned by
-// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskRequestValidationError struct {
+// AdminGetSystemStatsRequestValidationError is the validation error returned
+// by AdminGetSystemStatsRequest.Validate if the designated constraints aren't met.
+type AdminGetSystemStatsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1262,24 +9467,24 @@ type UpdateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminGetSystemStatsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminGetSystemStatsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminGetSystemStatsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminGetSystemStatsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
-	return "UpdateDownloadTaskRequestValidationError"
+func (e AdminGetSystemStatsRequestValidationError) ErrorName() string {
+	return "AdminGetSystemStatsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskRequestValidationError) Error() string {
+func (e AdminGetSystemStatsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1291,14 +9496,14 @@ func (e UpdateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminGetSystemStatsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskRequestValidationError{}
+var _ error = AdminGetSystemStatsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1306,73 +9511,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskRequestValidationError{}
+} = AdminGetSystemStatsRequestValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskResponse with the
+// Validate checks the field values on AdminGetSystemStatsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskResponse) Validate() error {
+func (m *AdminGetSystemStatsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// ValidateAll checks the field values on AdminGetSystemStatsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskResponseMultiError, or nil if none found.
-func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+// AdminGetSystemStatsResponseMultiError, or nil if none found.
+func (m *AdminGetSystemStatsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+func (m *AdminGetSystemStatsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for AccountCount
+
+	// no validation rules for DisabledAccountCount
+
+	for idx, item := range m.GetDownloadTaskStatusCountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetSystemStatsResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskStatusCountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetSystemStatsResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskStatusCountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				return AdminGetSystemStatsResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskStatusCountList[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UpdateDownloadTaskResponseMultiError(errors)
+		return AdminGetSystemStatsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// AdminGetSystemStatsResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminGetSystemStatsResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateDownloadTaskResponseMultiError []error
+type AdminGetSystemStatsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskResponseMultiError) Error() string {
+func (m AdminGetSystemStatsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1381,11 +9595,12 @@ func (m UpdateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AdminGetSystemStatsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskResponseValidationError is the validation error returned
-// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskResponseValidationError struct {
+// AdminGetSystemStatsResponseValidationError is the validation error returned
+// by AdminGetSystemStatsResponse.Validate if the designated constraints
+// aren't met.
+type AdminGetSystemStatsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1393,24 +9608,24 @@ type UpdateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AdminGetSystemStatsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AdminGetSystemStatsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AdminGetSystemStatsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AdminGetSystemStatsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
-	return "UpdateDownloadTaskResponseValidationError"
+func (e AdminGetSystemStatsResponseValidationError) ErrorName() string {
+	return "AdminGetSystemStatsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskResponseValidationError) Error() string {
+func (e AdminGetSystemStatsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1422,14 +9637,14 @@ func (e UpdateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		"invalid %sAdminGetSystemStatsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskResponseValidationError{}
+var _ error = AdminGetSystemStatsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1437,46 +9652,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskResponseValidationError{}
+} = AdminGetSystemStatsResponseValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskRequest with the rules
+// Validate checks the field values on AdminUnlockLoginRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskRequest) Validate() error {
+func (m *AdminUnlockLoginRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// ValidateAll checks the field values on AdminUnlockLoginRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskRequestMultiError, or nil if none found.
-func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+// AdminUnlockLoginRequestMultiError, or nil if none found.
+func (m *AdminUnlockLoginRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+func (m *AdminUnlockLoginRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for AccountName
+
+	// no validation rules for IpAddress
 
 	if len(errors) > 0 {
-		return DeleteDownloadTaskRequestMultiError(errors)
+		return AdminUnlockLoginRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteDownloadTaskRequestMultiError []error
+// AdminUnlockLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUnlockLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUnlockLoginRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskRequestMultiError) Error() string {
+func (m AdminUnlockLoginRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1485,11 +9702,11 @@ func (m DeleteDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AdminUnlockLoginRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskRequestValidationError is the validation error returned by
-// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskRequestValidationError struct {
+// AdminUnlockLoginRequestValidationError is the validation error returned by
+// AdminUnlockLoginRequest.Validate if the designated constraints aren't met.
+type AdminUnlockLoginRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1497,24 +9714,24 @@ type DeleteDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AdminUnlockLoginRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AdminUnlockLoginRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AdminUnlockLoginRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AdminUnlockLoginRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
-	return "DeleteDownloadTaskRequestValidationError"
+func (e AdminUnlockLoginRequestValidationError) ErrorName() string {
+	return "AdminUnlockLoginRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskRequestValidationError) Error() string {
+func (e AdminUnlockLoginRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1526,14 +9743,14 @@ func (e DeleteDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		"invalid %sAdminUnlockLoginRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskRequestValidationError{}
+var _ error = AdminUnlockLoginRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1541,24 +9758,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskRequestValidationError{}
+} = AdminUnlockLoginRequestValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminUnlockLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskResponse) Validate() error {
+func (m *AdminUnlockLoginResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// ValidateAll checks the field values on AdminUnlockLoginResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskResponseMultiError, or nil if none found.
-func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+// AdminUnlockLoginResponseMultiError, or nil if none found.
+func (m *AdminUnlockLoginResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+func (m *AdminUnlockLoginResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1566,19 +9783,19 @@ func (m *DeleteDownloadTaskResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return DeleteDownloadTaskResponseMultiError(errors)
+		return AdminUnlockLoginResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteDownloadTaskResponseMultiError []error
+// AdminUnlockLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by AdminUnlockLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUnlockLoginResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskResponseMultiError) Error() string {
+func (m AdminUnlockLoginResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1587,11 +9804,11 @@ func (m DeleteDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AdminUnlockLoginResponseMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskResponseValidationError is the validation error returned
-// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskResponseValidationError struct {
+// AdminUnlockLoginResponseValidationError is the validation error returned by
+// AdminUnlockLoginResponse.Validate if the designated constraints aren't met.
+type AdminUnlockLoginResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1599,24 +9816,24 @@ type DeleteDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AdminUnlockLoginResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AdminUnlockLoginResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AdminUnlockLoginResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AdminUnlockLoginResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
-	return "DeleteDownloadTaskResponseValidationError"
+func (e AdminUnlockLoginResponseValidationError) ErrorName() string {
+	return "AdminUnlockLoginResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskResponseValidationError) Error() string {
+func (e AdminUnlockLoginResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1628,14 +9845,14 @@ func (e DeleteDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		"invalid %sAdminUnlockLoginResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskResponseValidationError{}
+var _ error = AdminUnlockLoginResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1643,46 +9860,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskResponseValidationError{}
+} = AdminUnlockLoginResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileRequest with the
+// Validate checks the field values on AdminGetAuditEventListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileRequest) Validate() error {
+func (m *AdminGetAuditEventListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on AdminGetAuditEventListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetAuditEventListRequestMultiError, or nil if none found.
+func (m *AdminGetAuditEventListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+func (m *AdminGetAuditEventListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return GetDownloadTaskFileRequestMultiError(errors)
+		return AdminGetAuditEventListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskFileRequestMultiError []error
+// AdminGetAuditEventListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminGetAuditEventListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGetAuditEventListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileRequestMultiError) Error() string {
+func (m AdminGetAuditEventListRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1691,11 +9910,12 @@ func (m GetDownloadTaskFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+func (m AdminGetAuditEventListRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileRequestValidationError is the validation error returned
-// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskFileRequestValidationError struct {
+// AdminGetAuditEventListRequestValidationError is the validation error
+// returned by AdminGetAuditEventListRequest.Validate if the designated
+// constraints aren't met.
+type AdminGetAuditEventListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1703,24 +9923,24 @@ type GetDownloadTaskFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+func (e AdminGetAuditEventListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+func (e AdminGetAuditEventListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+func (e AdminGetAuditEventListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+func (e AdminGetAuditEventListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskFileRequestValidationError"
+func (e AdminGetAuditEventListRequestValidationError) ErrorName() string {
+	return "AdminGetAuditEventListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileRequestValidationError) Error() string {
+func (e AdminGetAuditEventListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1732,14 +9952,14 @@ func (e GetDownloadTaskFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		"invalid %sAdminGetAuditEventListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileRequestValidationError{}
+var _ error = AdminGetAuditEventListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1747,46 +9967,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileRequestValidationError{}
+} = AdminGetAuditEventListRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileResponse with the
+// Validate checks the field values on AdminGetAuditEventListResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileResponse) Validate() error {
+func (m *AdminGetAuditEventListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on AdminGetAuditEventListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminGetAuditEventListResponseMultiError, or nil if none found.
+func (m *AdminGetAuditEventListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+func (m *AdminGetAuditEventListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	for idx, item := range m.GetAuditEventList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGetAuditEventListResponseValidationError{
+						field:  fmt.Sprintf("AuditEventList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGetAuditEventListResponseValidationError{
+						field:  fmt.Sprintf("AuditEventList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminGetAuditEventListResponseValidationError{
+					field:  fmt.Sprintf("AuditEventList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAuditEventCount
 
 	if len(errors) > 0 {
-		return GetDownloadTaskFileResponseMultiError(errors)
+		return AdminGetAuditEventListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskFileResponseMultiError []error
+// AdminGetAuditEventListResponseMultiError is an error wrapping multiple
+// validation errors returned by AdminGetAuditEventListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AdminGetAuditEventListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileResponseMultiError) Error() string {
+func (m AdminGetAuditEventListResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1795,12 +10049,12 @@ func (m GetDownloadTaskFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+func (m AdminGetAuditEventListResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileResponseValidationError is the validation error returned
-// by GetDownloadTaskFileResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskFileResponseValidationError struct {
+// AdminGetAuditEventListResponseValidationError is the validation error
+// returned by AdminGetAuditEventListResponse.Validate if the designated
+// constraints aren't met.
+type AdminGetAuditEventListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1808,24 +10062,24 @@ type GetDownloadTaskFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+func (e AdminGetAuditEventListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+func (e AdminGetAuditEventListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+func (e AdminGetAuditEventListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+func (e AdminGetAuditEventListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskFileResponseValidationError"
+func (e AdminGetAuditEventListResponseValidationError) ErrorName() string {
+	return "AdminGetAuditEventListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileResponseValidationError) Error() string {
+func (e AdminGetAuditEventListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1837,14 +10091,14 @@ func (e GetDownloadTaskFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		"invalid %sAdminGetAuditEventListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileResponseValidationError{}
+var _ error = AdminGetAuditEventListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1852,4 +10106,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileResponseValidationError{}
+} = AdminGetAuditEventListResponseValidationError{}
@@ -19,13 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MorganaService_CreateAccount_FullMethodName       = "/morgana.v1.MorganaService/CreateAccount"
-	MorganaService_CreateSession_FullMethodName       = "/morgana.v1.MorganaService/CreateSession"
-	MorganaService_CreateDownloadTask_FullMethodName  = "/morgana.v1.MorganaService/CreateDownloadTask"
-	MorganaService_GetDownloadTaskList_FullMethodName = "/morgana.v1.MorganaService/GetDownloadTaskList"
-	MorganaService_UpdateDownloadTask_FullMethodName  = "/morgana.v1.MorganaService/UpdateDownloadTask"
-	MorganaService_DeleteDownloadTask_FullMethodName  = "/morgana.v1.MorganaService/DeleteDownloadTask"
-	MorganaService_GetDownloadTaskFile_FullMethodName = "/morgana.v1.MorganaService/GetDownloadTaskFile"
+	MorganaService_CreateAccount_FullMethodName             = "/morgana.v1.MorganaService/CreateAccount"
+	MorganaService_CreateSession_FullMethodName             = "/morgana.v1.MorganaService/CreateSession"
+	MorganaService_RefreshSession_FullMethodName            = "/morgana.v1.MorganaService/RefreshSession"
+	MorganaService_DeleteSession_FullMethodName             = "/morgana.v1.MorganaService/DeleteSession"
+	MorganaService_ListSessions_FullMethodName              = "/morgana.v1.MorganaService/ListSessions"
+	MorganaService_RevokeSession_FullMethodName             = "/morgana.v1.MorganaService/RevokeSession"
+	MorganaService_ChangePassword_FullMethodName            = "/morgana.v1.MorganaService/ChangePassword"
+	MorganaService_RequestPasswordReset_FullMethodName      = "/morgana.v1.MorganaService/RequestPasswordReset"
+	MorganaService_ResetPassword_FullMethodName             = "/morgana.v1.MorganaService/ResetPassword"
+	MorganaService_EnrollTwoFactor_FullMethodName           = "/morgana.v1.MorganaService/EnrollTwoFactor"
+	MorganaService_ConfirmTwoFactor_FullMethodName          = "/morgana.v1.MorganaService/ConfirmTwoFactor"
+	MorganaService_DisableTwoFactor_FullMethodName          = "/morgana.v1.MorganaService/DisableTwoFactor"
+	MorganaService_CreateAPIKey_FullMethodName              = "/morgana.v1.MorganaService/CreateAPIKey"
+	MorganaService_GetAPIKeyList_FullMethodName             = "/morgana.v1.MorganaService/GetAPIKeyList"
+	MorganaService_RevokeAPIKey_FullMethodName              = "/morgana.v1.MorganaService/RevokeAPIKey"
+	MorganaService_CreateDownloadTask_FullMethodName        = "/morgana.v1.MorganaService/CreateDownloadTask"
+	MorganaService_GetDownloadTaskList_FullMethodName       = "/morgana.v1.MorganaService/GetDownloadTaskList"
+	MorganaService_UpdateDownloadTask_FullMethodName        = "/morgana.v1.MorganaService/UpdateDownloadTask"
+	MorganaService_DeleteDownloadTask_FullMethodName        = "/morgana.v1.MorganaService/DeleteDownloadTask"
+	MorganaService_GetDownloadTaskFile_FullMethodName       = "/morgana.v1.MorganaService/GetDownloadTaskFile"
+	MorganaService_GetUsage_FullMethodName                  = "/morgana.v1.MorganaService/GetUsage"
+	MorganaService_CreateOrganization_FullMethodName        = "/morgana.v1.MorganaService/CreateOrganization"
+	MorganaService_GetOrganizationList_FullMethodName       = "/morgana.v1.MorganaService/GetOrganizationList"
+	MorganaService_GetOrganizationMemberList_FullMethodName = "/morgana.v1.MorganaService/GetOrganizationMemberList"
+	MorganaService_AddOrganizationMember_FullMethodName     = "/morgana.v1.MorganaService/AddOrganizationMember"
+	MorganaService_UpdateOrganizationMember_FullMethodName  = "/morgana.v1.MorganaService/UpdateOrganizationMember"
+	MorganaService_RemoveOrganizationMember_FullMethodName  = "/morgana.v1.MorganaService/RemoveOrganizationMember"
 )
 
 // MorganaServiceClient is the client API for MorganaService service.
@@ -34,11 +54,31 @@ const (
 type MorganaServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	GetAPIKeyList(ctx context.Context, in *GetAPIKeyListRequest, opts ...grpc.CallOption) (*GetAPIKeyListResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
 	CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error)
 	GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetDownloadTaskFileResponse], error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	GetOrganizationList(ctx context.Context, in *GetOrganizationListRequest, opts ...grpc.CallOption) (*GetOrganizationListResponse, error)
+	GetOrganizationMemberList(ctx context.Context, in *GetOrganizationMemberListRequest, opts ...grpc.CallOption) (*GetOrganizationMemberListResponse, error)
+	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error)
+	UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error)
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error)
 }
 
 type morganaServiceClient struct {
@@ -69,6 +109,136 @@ func (c *morganaServiceClient) CreateSession(ctx context.Context, in *CreateSess
 	return out, nil
 }
 
+func (c *morganaServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, MorganaService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, MorganaService_DeleteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, MorganaService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, MorganaService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, MorganaService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, MorganaService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, MorganaService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MorganaService_EnrollTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MorganaService_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, MorganaService_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MorganaService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) GetAPIKeyList(ctx context.Context, in *GetAPIKeyListRequest, opts ...grpc.CallOption) (*GetAPIKeyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAPIKeyListResponse)
+	err := c.cc.Invoke(ctx, MorganaService_GetAPIKeyList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MorganaService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *morganaServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDownloadTaskResponse)
@@ -128,17 +298,107 @@ func (c *morganaServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetD
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MorganaService_GetDownloadTaskFileClient = grpc.ServerStreamingClient[GetDownloadTaskFileResponse]
 
+func (c *morganaServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, MorganaService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, MorganaService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) GetOrganizationList(ctx context.Context, in *GetOrganizationListRequest, opts ...grpc.CallOption) (*GetOrganizationListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationListResponse)
+	err := c.cc.Invoke(ctx, MorganaService_GetOrganizationList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) GetOrganizationMemberList(ctx context.Context, in *GetOrganizationMemberListRequest, opts ...grpc.CallOption) (*GetOrganizationMemberListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizationMemberListResponse)
+	err := c.cc.Invoke(ctx, MorganaService_GetOrganizationMemberList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*AddOrganizationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, MorganaService_AddOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) UpdateOrganizationMember(ctx context.Context, in *UpdateOrganizationMemberRequest, opts ...grpc.CallOption) (*UpdateOrganizationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, MorganaService_UpdateOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *morganaServiceClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*RemoveOrganizationMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrganizationMemberResponse)
+	err := c.cc.Invoke(ctx, MorganaService_RemoveOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MorganaServiceServer is the server API for MorganaService service.
 // All implementations must embed UnimplementedMorganaServiceServer
 // for forward compatibility.
 type MorganaServiceServer interface {
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	GetAPIKeyList(context.Context, *GetAPIKeyListRequest) (*GetAPIKeyListResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
 	GetDownloadTaskFile(*GetDownloadTaskFileRequest, grpc.ServerStreamingServer[GetDownloadTaskFileResponse]) error
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	GetOrganizationList(context.Context, *GetOrganizationListRequest) (*GetOrganizationListResponse, error)
+	GetOrganizationMemberList(context.Context, *GetOrganizationMemberListRequest) (*GetOrganizationMemberListResponse, error)
+	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error)
+	UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error)
 	mustEmbedUnimplementedMorganaServiceServer()
 }
 
@@ -155,6 +415,45 @@ func (UnimplementedMorganaServiceServer) CreateAccount(context.Context, *CreateA
 func (UnimplementedMorganaServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
 }
+func (UnimplementedMorganaServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedMorganaServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedMorganaServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedMorganaServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedMorganaServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedMorganaServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedMorganaServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedMorganaServiceServer) EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTwoFactor not implemented")
+}
+func (UnimplementedMorganaServiceServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedMorganaServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedMorganaServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedMorganaServiceServer) GetAPIKeyList(context.Context, *GetAPIKeyListRequest) (*GetAPIKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeyList not implemented")
+}
+func (UnimplementedMorganaServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedMorganaServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
 }
@@ -170,6 +469,27 @@ func (UnimplementedMorganaServiceServer) DeleteDownloadTask(context.Context, *De
 func (UnimplementedMorganaServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, grpc.ServerStreamingServer[GetDownloadTaskFileResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
 }
+func (UnimplementedMorganaServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedMorganaServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedMorganaServiceServer) GetOrganizationList(context.Context, *GetOrganizationListRequest) (*GetOrganizationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationList not implemented")
+}
+func (UnimplementedMorganaServiceServer) GetOrganizationMemberList(context.Context, *GetOrganizationMemberListRequest) (*GetOrganizationMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationMemberList not implemented")
+}
+func (UnimplementedMorganaServiceServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*AddOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationMember not implemented")
+}
+func (UnimplementedMorganaServiceServer) UpdateOrganizationMember(context.Context, *UpdateOrganizationMemberRequest) (*UpdateOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationMember not implemented")
+}
+func (UnimplementedMorganaServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*RemoveOrganizationMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
 func (UnimplementedMorganaServiceServer) mustEmbedUnimplementedMorganaServiceServer() {}
 func (UnimplementedMorganaServiceServer) testEmbeddedByValue()                        {}
 
@@ -227,127 +547,1011 @@ func _MorganaService_CreateSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MorganaService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDownloadTaskRequest)
+func _MorganaService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MorganaServiceServer).CreateDownloadTask(ctx, in)
+		return srv.(MorganaServiceServer).RefreshSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MorganaService_CreateDownloadTask_FullMethodName,
+		FullMethod: MorganaService_RefreshSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MorganaServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+		return srv.(MorganaServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MorganaService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDownloadTaskListRequest)
+func _MorganaService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MorganaServiceServer).GetDownloadTaskList(ctx, in)
+		return srv.(MorganaServiceServer).DeleteSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MorganaService_GetDownloadTaskList_FullMethodName,
+		FullMethod: MorganaService_DeleteSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MorganaServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+		return srv.(MorganaServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MorganaService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDownloadTaskRequest)
+func _MorganaService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MorganaServiceServer).UpdateDownloadTask(ctx, in)
+		return srv.(MorganaServiceServer).ListSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MorganaService_UpdateDownloadTask_FullMethodName,
+		FullMethod: MorganaService_ListSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MorganaServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+		return srv.(MorganaServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MorganaService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDownloadTaskRequest)
+func _MorganaService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MorganaServiceServer).DeleteDownloadTask(ctx, in)
+		return srv.(MorganaServiceServer).RevokeSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MorganaService_DeleteDownloadTask_FullMethodName,
+		FullMethod: MorganaService_RevokeSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MorganaServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+		return srv.(MorganaServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MorganaService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetDownloadTaskFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MorganaService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MorganaServiceServer).GetDownloadTaskFile(m, &grpc.GenericServerStream[GetDownloadTaskFileRequest, GetDownloadTaskFileResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type MorganaService_GetDownloadTaskFileServer = grpc.ServerStreamingServer[GetDownloadTaskFileResponse]
+func _MorganaService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// MorganaService_ServiceDesc is the grpc.ServiceDesc for MorganaService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MorganaService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "morgana.v1.MorganaService",
-	HandlerType: (*MorganaServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAccount",
-			Handler:    _MorganaService_CreateAccount_Handler,
-		},
-		{
-			MethodName: "CreateSession",
-			Handler:    _MorganaService_CreateSession_Handler,
-		},
-		{
-			MethodName: "CreateDownloadTask",
-			Handler:    _MorganaService_CreateDownloadTask_Handler,
-		},
-		{
-			MethodName: "GetDownloadTaskList",
-			Handler:    _MorganaService_GetDownloadTaskList_Handler,
-		},
-		{
-			MethodName: "UpdateDownloadTask",
-			Handler:    _MorganaService_UpdateDownloadTask_Handler,
-		},
-		{
-			MethodName: "DeleteDownloadTask",
-			Handler:    _MorganaService_DeleteDownloadTask_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetDownloadTaskFile",
-			Handler:       _MorganaService_GetDownloadTaskFile_Handler,
-			ServerStreams: true,
+func _MorganaService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_EnrollTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).EnrollTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_EnrollTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).EnrollTwoFactor(ctx, req.(*EnrollTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).ConfirmTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_ConfirmTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_GetAPIKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).GetAPIKeyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_GetAPIKeyList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).GetAPIKeyList(ctx, req.(*GetAPIKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).CreateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_CreateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_GetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).UpdateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_UpdateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).DeleteDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_DeleteDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MorganaServiceServer).GetDownloadTaskFile(m, &grpc.GenericServerStream[GetDownloadTaskFileRequest, GetDownloadTaskFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MorganaService_GetDownloadTaskFileServer = grpc.ServerStreamingServer[GetDownloadTaskFileResponse]
+
+func _MorganaService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_GetOrganizationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).GetOrganizationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_GetOrganizationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).GetOrganizationList(ctx, req.(*GetOrganizationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_GetOrganizationMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationMemberListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).GetOrganizationMemberList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_GetOrganizationMemberList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).GetOrganizationMemberList(ctx, req.(*GetOrganizationMemberListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).AddOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_AddOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_UpdateOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).UpdateOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_UpdateOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).UpdateOrganizationMember(ctx, req.(*UpdateOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MorganaService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MorganaServiceServer).RemoveOrganizationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MorganaService_RemoveOrganizationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MorganaServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MorganaService_ServiceDesc is the grpc.ServiceDesc for MorganaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MorganaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "morgana.v1.MorganaService",
+	HandlerType: (*MorganaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _MorganaService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _MorganaService_CreateSession_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _MorganaService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _MorganaService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _MorganaService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _MorganaService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _MorganaService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _MorganaService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _MorganaService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "EnrollTwoFactor",
+			Handler:    _MorganaService_EnrollTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _MorganaService_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _MorganaService_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _MorganaService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetAPIKeyList",
+			Handler:    _MorganaService_GetAPIKeyList_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _MorganaService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateDownloadTask",
+			Handler:    _MorganaService_CreateDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskList",
+			Handler:    _MorganaService_GetDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "UpdateDownloadTask",
+			Handler:    _MorganaService_UpdateDownloadTask_Handler,
+		},
+		{
+			MethodName: "DeleteDownloadTask",
+			Handler:    _MorganaService_DeleteDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _MorganaService_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _MorganaService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganizationList",
+			Handler:    _MorganaService_GetOrganizationList_Handler,
+		},
+		{
+			MethodName: "GetOrganizationMemberList",
+			Handler:    _MorganaService_GetOrganizationMemberList_Handler,
+		},
+		{
+			MethodName: "AddOrganizationMember",
+			Handler:    _MorganaService_AddOrganizationMember_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationMember",
+			Handler:    _MorganaService_UpdateOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _MorganaService_RemoveOrganizationMember_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetDownloadTaskFile",
+			Handler:       _MorganaService_GetDownloadTaskFile_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "morgana/v1/morgana.proto",
+}
+
+const (
+	AdminService_GetAccountList_FullMethodName      = "/morgana.v1.AdminService/GetAccountList"
+	AdminService_UpdateAccountRole_FullMethodName   = "/morgana.v1.AdminService/UpdateAccountRole"
+	AdminService_DisableAccount_FullMethodName      = "/morgana.v1.AdminService/DisableAccount"
+	AdminService_EnableAccount_FullMethodName       = "/morgana.v1.AdminService/EnableAccount"
+	AdminService_GetDownloadTaskList_FullMethodName = "/morgana.v1.AdminService/GetDownloadTaskList"
+	AdminService_RetryDownloadTask_FullMethodName   = "/morgana.v1.AdminService/RetryDownloadTask"
+	AdminService_CancelDownloadTask_FullMethodName  = "/morgana.v1.AdminService/CancelDownloadTask"
+	AdminService_GetSystemStats_FullMethodName      = "/morgana.v1.AdminService/GetSystemStats"
+	AdminService_UnlockLogin_FullMethodName         = "/morgana.v1.AdminService/UnlockLogin"
+	AdminService_GetAuditEventList_FullMethodName   = "/morgana.v1.AdminService/GetAuditEventList"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	GetAccountList(ctx context.Context, in *AdminGetAccountListRequest, opts ...grpc.CallOption) (*AdminGetAccountListResponse, error)
+	UpdateAccountRole(ctx context.Context, in *AdminUpdateAccountRoleRequest, opts ...grpc.CallOption) (*AdminUpdateAccountRoleResponse, error)
+	DisableAccount(ctx context.Context, in *AdminDisableAccountRequest, opts ...grpc.CallOption) (*AdminDisableAccountResponse, error)
+	EnableAccount(ctx context.Context, in *AdminEnableAccountRequest, opts ...grpc.CallOption) (*AdminEnableAccountResponse, error)
+	GetDownloadTaskList(ctx context.Context, in *AdminGetDownloadTaskListRequest, opts ...grpc.CallOption) (*AdminGetDownloadTaskListResponse, error)
+	RetryDownloadTask(ctx context.Context, in *AdminRetryDownloadTaskRequest, opts ...grpc.CallOption) (*AdminRetryDownloadTaskResponse, error)
+	CancelDownloadTask(ctx context.Context, in *AdminCancelDownloadTaskRequest, opts ...grpc.CallOption) (*AdminCancelDownloadTaskResponse, error)
+	GetSystemStats(ctx context.Context, in *AdminGetSystemStatsRequest, opts ...grpc.CallOption) (*AdminGetSystemStatsResponse, error)
+	UnlockLogin(ctx context.Context, in *AdminUnlockLoginRequest, opts ...grpc.CallOption) (*AdminUnlockLoginResponse, error)
+	GetAuditEventList(ctx context.Context, in *AdminGetAuditEventListRequest, opts ...grpc.CallOption) (*AdminGetAuditEventListResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetAccountList(ctx context.Context, in *AdminGetAccountListRequest, opts ...grpc.CallOption) (*AdminGetAccountListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetAccountListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAccountList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateAccountRole(ctx context.Context, in *AdminUpdateAccountRoleRequest, opts ...grpc.CallOption) (*AdminUpdateAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DisableAccount(ctx context.Context, in *AdminDisableAccountRequest, opts ...grpc.CallOption) (*AdminDisableAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDisableAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_DisableAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EnableAccount(ctx context.Context, in *AdminEnableAccountRequest, opts ...grpc.CallOption) (*AdminEnableAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminEnableAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_EnableAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDownloadTaskList(ctx context.Context, in *AdminGetDownloadTaskListRequest, opts ...grpc.CallOption) (*AdminGetDownloadTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDownloadTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RetryDownloadTask(ctx context.Context, in *AdminRetryDownloadTaskRequest, opts ...grpc.CallOption) (*AdminRetryDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminRetryDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, AdminService_RetryDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelDownloadTask(ctx context.Context, in *AdminCancelDownloadTaskRequest, opts ...grpc.CallOption) (*AdminCancelDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCancelDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetSystemStats(ctx context.Context, in *AdminGetSystemStatsRequest, opts ...grpc.CallOption) (*AdminGetSystemStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetSystemStatsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetSystemStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnlockLogin(ctx context.Context, in *AdminUnlockLoginRequest, opts ...grpc.CallOption) (*AdminUnlockLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUnlockLoginResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnlockLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAuditEventList(ctx context.Context, in *AdminGetAuditEventListRequest, opts ...grpc.CallOption) (*AdminGetAuditEventListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetAuditEventListResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAuditEventList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+type AdminServiceServer interface {
+	GetAccountList(context.Context, *AdminGetAccountListRequest) (*AdminGetAccountListResponse, error)
+	UpdateAccountRole(context.Context, *AdminUpdateAccountRoleRequest) (*AdminUpdateAccountRoleResponse, error)
+	DisableAccount(context.Context, *AdminDisableAccountRequest) (*AdminDisableAccountResponse, error)
+	EnableAccount(context.Context, *AdminEnableAccountRequest) (*AdminEnableAccountResponse, error)
+	GetDownloadTaskList(context.Context, *AdminGetDownloadTaskListRequest) (*AdminGetDownloadTaskListResponse, error)
+	RetryDownloadTask(context.Context, *AdminRetryDownloadTaskRequest) (*AdminRetryDownloadTaskResponse, error)
+	CancelDownloadTask(context.Context, *AdminCancelDownloadTaskRequest) (*AdminCancelDownloadTaskResponse, error)
+	GetSystemStats(context.Context, *AdminGetSystemStatsRequest) (*AdminGetSystemStatsResponse, error)
+	UnlockLogin(context.Context, *AdminUnlockLoginRequest) (*AdminUnlockLoginResponse, error)
+	GetAuditEventList(context.Context, *AdminGetAuditEventListRequest) (*AdminGetAuditEventListResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) GetAccountList(context.Context, *AdminGetAccountListRequest) (*AdminGetAccountListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountList not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateAccountRole(context.Context, *AdminUpdateAccountRoleRequest) (*AdminUpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRole not implemented")
+}
+func (UnimplementedAdminServiceServer) DisableAccount(context.Context, *AdminDisableAccountRequest) (*AdminDisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) EnableAccount(context.Context, *AdminEnableAccountRequest) (*AdminEnableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDownloadTaskList(context.Context, *AdminGetDownloadTaskListRequest) (*AdminGetDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+}
+func (UnimplementedAdminServiceServer) RetryDownloadTask(context.Context, *AdminRetryDownloadTaskRequest) (*AdminRetryDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDownloadTask not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelDownloadTask(context.Context, *AdminCancelDownloadTaskRequest) (*AdminCancelDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDownloadTask not implemented")
+}
+func (UnimplementedAdminServiceServer) GetSystemStats(context.Context, *AdminGetSystemStatsRequest) (*AdminGetSystemStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
+}
+func (UnimplementedAdminServiceServer) UnlockLogin(context.Context, *AdminUnlockLoginRequest) (*AdminUnlockLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLogin not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAuditEventList(context.Context, *AdminGetAuditEventListRequest) (*AdminGetAuditEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEventList not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetAccountListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAccountList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAccountList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAccountList(ctx, req.(*AdminGetAccountListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateAccountRole(ctx, req.(*AdminUpdateAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDisableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DisableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DisableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DisableAccount(ctx, req.(*AdminDisableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EnableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminEnableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EnableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EnableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EnableAccount(ctx, req.(*AdminEnableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDownloadTaskList(ctx, req.(*AdminGetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RetryDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRetryDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RetryDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RetryDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RetryDownloadTask(ctx, req.(*AdminRetryDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCancelDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelDownloadTask(ctx, req.(*AdminCancelDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetSystemStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetSystemStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetSystemStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetSystemStats(ctx, req.(*AdminGetSystemStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUnlockLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnlockLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnlockLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnlockLogin(ctx, req.(*AdminUnlockLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAuditEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetAuditEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAuditEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAuditEventList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAuditEventList(ctx, req.(*AdminGetAuditEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "morgana.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccountList",
+			Handler:    _AdminService_GetAccountList_Handler,
+		},
+		{
+			MethodName: "UpdateAccountRole",
+			Handler:    _AdminService_UpdateAccountRole_Handler,
+		},
+		{
+			MethodName: "DisableAccount",
+			Handler:    _AdminService_DisableAccount_Handler,
+		},
+		{
+			MethodName: "EnableAccount",
+			Handler:    _AdminService_EnableAccount_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskList",
+			Handler:    _AdminService_GetDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "RetryDownloadTask",
+			Handler:    _AdminService_RetryDownloadTask_Handler,
+		},
+		{
+			MethodName: "CancelDownloadTask",
+			Handler:    _AdminService_CancelDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetSystemStats",
+			Handler:    _AdminService_GetSystemStats_Handler,
+		},
+		{
+			MethodName: "UnlockLogin",
+			Handler:    _AdminService_UnlockLogin_Handler,
+		},
+		{
+			MethodName: "GetAuditEventList",
+			Handler:    _AdminService_GetAuditEventList_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "morgana/v1/morgana.proto",
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/consumer"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"github.com/hoangdv99/morgana/internal/utils"
 	"go.uber.org/zap"
 )
 
@@ -35,6 +36,10 @@ func (r root) Start(ctx context.Context) error {
 	r.mqConsumer.RegisterHandler(
 		producer.MessageQueueDownloadTaskCreated,
 		func(ctx context.Context, queueName string, payload []byte) error {
+			// Every delivery of a message is a request of its own, which the log lines of its
+			// handler are tagged with.
+			ctx = utils.WithRequestID(ctx, utils.NewRequestID())
+
 			var event producer.DownloadTaskCreated
 			err := json.Unmarshal(payload, &event)
 			if err != nil {
@@ -0,0 +1,194 @@
+package grpc
+
+import (
+	"context"
+
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/logic"
+)
+
+type AdminHandler struct {
+	morgana.UnimplementedAdminServiceServer
+	adminLogic logic.Admin
+}
+
+func NewAdminHandler(adminLogic logic.Admin) morgana.AdminServiceServer {
+	return &AdminHandler{
+		adminLogic: adminLogic,
+	}
+}
+
+func (a AdminHandler) GetAccountList(
+	ctx context.Context,
+	request *morgana.AdminGetAccountListRequest,
+) (*morgana.AdminGetAccountListResponse, error) {
+	output, err := a.adminLogic.GetAccountList(ctx, logic.AdminGetAccountListParams{
+		Token:  getAuthTokenMetadata(ctx),
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminGetAccountListResponse{
+		AccountList:       output.AccountList,
+		TotalAccountCount: output.TotalAccountCount,
+	}, nil
+}
+
+func (a AdminHandler) UpdateAccountRole(
+	ctx context.Context,
+	request *morgana.AdminUpdateAccountRoleRequest,
+) (*morgana.AdminUpdateAccountRoleResponse, error) {
+	output, err := a.adminLogic.UpdateAccountRole(ctx, logic.AdminUpdateAccountRoleParams{
+		Token:     getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+		Role:      request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminUpdateAccountRoleResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a AdminHandler) DisableAccount(
+	ctx context.Context,
+	request *morgana.AdminDisableAccountRequest,
+) (*morgana.AdminDisableAccountResponse, error) {
+	err := a.adminLogic.DisableAccount(ctx, logic.AdminDisableAccountParams{
+		Token:     getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminDisableAccountResponse{}, nil
+}
+
+func (a AdminHandler) EnableAccount(
+	ctx context.Context,
+	request *morgana.AdminEnableAccountRequest,
+) (*morgana.AdminEnableAccountResponse, error) {
+	err := a.adminLogic.EnableAccount(ctx, logic.AdminEnableAccountParams{
+		Token:     getAuthTokenMetadata(ctx),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminEnableAccountResponse{}, nil
+}
+
+func (a AdminHandler) GetDownloadTaskList(
+	ctx context.Context,
+	request *morgana.AdminGetDownloadTaskListRequest,
+) (*morgana.AdminGetDownloadTaskListResponse, error) {
+	output, err := a.adminLogic.GetDownloadTaskList(ctx, logic.AdminGetDownloadTaskListParams{
+		Token:          getAuthTokenMetadata(ctx),
+		AccountID:      request.GetAccountId(),
+		DownloadStatus: request.GetDownloadStatus(),
+		Offset:         request.GetOffset(),
+		Limit:          request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminGetDownloadTaskListResponse{
+		DownloadTaskList:       output.DownloadTaskList,
+		TotalDownloadTaskCount: output.TotalDownloadTaskCount,
+	}, nil
+}
+
+func (a AdminHandler) RetryDownloadTask(
+	ctx context.Context,
+	request *morgana.AdminRetryDownloadTaskRequest,
+) (*morgana.AdminRetryDownloadTaskResponse, error) {
+	downloadTask, err := a.adminLogic.RetryDownloadTask(ctx, logic.AdminRetryDownloadTaskParams{
+		Token:          getAuthTokenMetadata(ctx),
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminRetryDownloadTaskResponse{
+		DownloadTask: downloadTask,
+	}, nil
+}
+
+func (a AdminHandler) CancelDownloadTask(
+	ctx context.Context,
+	request *morgana.AdminCancelDownloadTaskRequest,
+) (*morgana.AdminCancelDownloadTaskResponse, error) {
+	downloadTask, err := a.adminLogic.CancelDownloadTask(ctx, logic.AdminCancelDownloadTaskParams{
+		Token:          getAuthTokenMetadata(ctx),
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminCancelDownloadTaskResponse{
+		DownloadTask: downloadTask,
+	}, nil
+}
+
+func (a AdminHandler) GetSystemStats(
+	ctx context.Context,
+	request *morgana.AdminGetSystemStatsRequest,
+) (*morgana.AdminGetSystemStatsResponse, error) {
+	output, err := a.adminLogic.GetSystemStats(ctx, logic.AdminGetSystemStatsParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminGetSystemStatsResponse{
+		AccountCount:                output.AccountCount,
+		DisabledAccountCount:        output.DisabledAccountCount,
+		DownloadTaskStatusCountList: output.DownloadTaskStatusCountList,
+	}, nil
+}
+
+func (a AdminHandler) UnlockLogin(
+	ctx context.Context,
+	request *morgana.AdminUnlockLoginRequest,
+) (*morgana.AdminUnlockLoginResponse, error) {
+	err := a.adminLogic.UnlockLogin(ctx, logic.AdminUnlockLoginParams{
+		Token:       getAuthTokenMetadata(ctx),
+		AccountName: request.GetAccountName(),
+		IPAddress:   request.GetIpAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminUnlockLoginResponse{}, nil
+}
+
+func (a AdminHandler) GetAuditEventList(
+	ctx context.Context,
+	request *morgana.AdminGetAuditEventListRequest,
+) (*morgana.AdminGetAuditEventListResponse, error) {
+	output, err := a.adminLogic.GetAuditEventList(ctx, logic.AdminGetAuditEventListParams{
+		Token:  getAuthTokenMetadata(ctx),
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AdminGetAuditEventListResponse{
+		AuditEventList:       output.AuditEventList,
+		TotalAuditEventCount: output.TotalAuditEventCount,
+	}, nil
+}
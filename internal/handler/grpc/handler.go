@@ -4,36 +4,75 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
+	"net/netip"
+	"strings"
 
 	"github.com/hoangdv99/morgana/internal/configs"
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
 	"github.com/hoangdv99/morgana/internal/logic"
+	"github.com/hoangdv99/morgana/internal/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	//nolint:gosec // This is just to specify the metadata name
 	AuthTokenMetadataName = "MORGANA_AUTH"
+	//nolint:gosec // This is just to specify the metadata name
+	RefreshTokenMetadataName = "MORGANA_REFRESH"
+	// AcceptEncodingMetadataName lists the content encodings a client of GetDownloadTaskFile can
+	// decode, comma separated. The encoding of the returned data is sent back in the
+	// ContentEncodingMetadataName response header.
+	AcceptEncodingMetadataName  = "MORGANA_ACCEPT_ENCODING"
+	ContentEncodingMetadataName = "MORGANA_CONTENT_ENCODING"
+	// AuthorizationMetadataName carries a "Bearer" access token or API key. It is also where the
+	// HTTP gateway forwards the Authorization header.
+	AuthorizationMetadataName = "authorization"
+	// ForwardedForMetadataName is where the HTTP gateway forwards the X-Forwarded-For header, with
+	// the address of the HTTP client appended to it.
+	ForwardedForMetadataName = "x-forwarded-for"
 )
 
 type Handler struct {
 	morgana.UnimplementedMorganaServiceServer
 	accountLogic                                 logic.Account
+	twoFactorLogic                               logic.TwoFactor
+	apiKeyLogic                                  logic.APIKey
 	downloadTaskLogic                            logic.DownloadTask
+	organizationLogic                            logic.Organization
 	getDownloadTaskFileResponseBufferSizeInBytes uint64
+	trustedProxyPrefixList                       []netip.Prefix
 }
 
-func NewHandler(accountLogic logic.Account, downloadTaskLogic logic.DownloadTask, grpcConfig configs.GRPC) (morgana.MorganaServiceServer, error) {
+func NewHandler(
+	accountLogic logic.Account,
+	twoFactorLogic logic.TwoFactor,
+	apiKeyLogic logic.APIKey,
+	downloadTaskLogic logic.DownloadTask,
+	organizationLogic logic.Organization,
+	grpcConfig configs.GRPC,
+) (morgana.MorganaServiceServer, error) {
 	getDownloadTaskFileResponseBufferSizeInBytes, err := grpcConfig.GetDownloadTaskFile.GetResponseBufferSizeInBytes()
 	if err != nil {
 		return nil, err
 	}
 
+	trustedProxyPrefixList, err := grpcConfig.GetTrustedProxyPrefixList()
+	if err != nil {
+		return nil, err
+	}
+
 	return &Handler{
 		accountLogic:      accountLogic,
+		twoFactorLogic:    twoFactorLogic,
+		apiKeyLogic:       apiKeyLogic,
 		downloadTaskLogic: downloadTaskLogic,
+		organizationLogic: organizationLogic,
 		getDownloadTaskFileResponseBufferSizeInBytes: getDownloadTaskFileResponseBufferSizeInBytes,
+		trustedProxyPrefixList:                       trustedProxyPrefixList,
 	}, nil
 }
 
@@ -41,6 +80,7 @@ func (a Handler) CreateAccount(ctx context.Context, request *morgana.CreateAccou
 	output, err := a.accountLogic.CreateAccount(ctx, logic.CreateAccountParams{
 		AccountName: request.GetAccountName(),
 		Password:    request.GetPassword(),
+		Email:       request.GetEmail(),
 	})
 	if err != nil {
 		return nil, err
@@ -51,13 +91,13 @@ func (a Handler) CreateAccount(ctx context.Context, request *morgana.CreateAccou
 	}, nil
 }
 
-func (a Handler) getAuthTokenMetadata(ctx context.Context) string {
+func getMetadata(ctx context.Context, metadataName string) string {
 	metadata, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ""
 	}
 
-	metadataValues := metadata.Get(AuthTokenMetadataName)
+	metadataValues := metadata.Get(metadataName)
 	if len(metadataValues) == 0 {
 		return ""
 	}
@@ -65,11 +105,140 @@ func (a Handler) getAuthTokenMetadata(ctx context.Context) string {
 	return metadataValues[0]
 }
 
+func (a Handler) isTrustedProxy(address netip.Addr) bool {
+	if address.IsLoopback() {
+		return true
+	}
+
+	for _, trustedProxyPrefix := range a.trustedProxyPrefixList {
+		if trustedProxyPrefix.Contains(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getClientIPAddress returns the address of the client. Requests coming from a trusted proxy, or
+// from a loopback address where the HTTP gateway connects from, are forwarded for someone else, so
+// their X-Forwarded-For entries are walked from the right, skipping the ones added by trusted
+// proxies, and the first other entry is used instead.
+func (a Handler) getClientIPAddress(ctx context.Context) string {
+	requestPeer, ok := peer.FromContext(ctx)
+	if !ok || requestPeer.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(requestPeer.Addr.String())
+	if err != nil {
+		host = requestPeer.Addr.String()
+	}
+
+	clientIPAddress, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+
+	clientIPAddress = clientIPAddress.Unmap()
+
+	forwardedForEntryList := make([]string, 0)
+	for _, forwardedForValue := range metadata.ValueFromIncomingContext(ctx, ForwardedForMetadataName) {
+		forwardedForEntryList = append(forwardedForEntryList, strings.Split(forwardedForValue, ",")...)
+	}
+
+	for i := len(forwardedForEntryList) - 1; i >= 0 && a.isTrustedProxy(clientIPAddress); i-- {
+		forwardedIPAddress, err := netip.ParseAddr(strings.TrimSpace(forwardedForEntryList[i]))
+		if err != nil {
+			break
+		}
+
+		clientIPAddress = forwardedIPAddress.Unmap()
+	}
+
+	return clientIPAddress.String()
+}
+
+// getAuthTokenMetadata returns the credential of a request, preferring a bearer Authorization over
+// the MORGANA_AUTH metadata.
+func getAuthTokenMetadata(ctx context.Context) string {
+	if token, ok := utils.GetBearerToken(getMetadata(ctx, AuthorizationMetadataName)); ok {
+		return token
+	}
+
+	return getMetadata(ctx, AuthTokenMetadataName)
+}
+
+func (a Handler) getAcceptEncodingMetadata(ctx context.Context) []string {
+	metadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	acceptedContentEncodingList := make([]string, 0)
+	for _, metadataValue := range metadata.Get(AcceptEncodingMetadataName) {
+		for _, contentEncoding := range strings.Split(metadataValue, ",") {
+			if contentEncoding = strings.TrimSpace(contentEncoding); contentEncoding != "" {
+				acceptedContentEncodingList = append(acceptedContentEncodingList, contentEncoding)
+			}
+		}
+	}
+
+	return acceptedContentEncodingList
+}
+
+func (a Handler) CreateAPIKey(ctx context.Context, request *morgana.CreateAPIKeyRequest) (*morgana.CreateAPIKeyResponse, error) {
+	params := logic.CreateAPIKeyParams{
+		Token:     getAuthTokenMetadata(ctx),
+		Name:      request.GetName(),
+		ScopeList: request.GetScopeList(),
+	}
+	if request.GetExpiresAt() != nil {
+		params.ExpireTime = request.GetExpiresAt().AsTime()
+	}
+
+	output, err := a.apiKeyLogic.CreateAPIKey(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.CreateAPIKeyResponse{
+		ApiKey: output.APIKey,
+		Secret: output.Secret,
+	}, nil
+}
+
+func (a Handler) GetAPIKeyList(ctx context.Context, request *morgana.GetAPIKeyListRequest) (*morgana.GetAPIKeyListResponse, error) {
+	output, err := a.apiKeyLogic.GetAPIKeyList(ctx, logic.GetAPIKeyListParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.GetAPIKeyListResponse{
+		ApiKeyList: output.APIKeyList,
+	}, nil
+}
+
+func (a Handler) RevokeAPIKey(ctx context.Context, request *morgana.RevokeAPIKeyRequest) (*morgana.RevokeAPIKeyResponse, error) {
+	err := a.apiKeyLogic.RevokeAPIKey(ctx, logic.RevokeAPIKeyParams{
+		Token:    getAuthTokenMetadata(ctx),
+		APIKeyID: request.GetApiKeyId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.RevokeAPIKeyResponse{}, nil
+}
+
 func (a Handler) CreateDownloadTask(ctx context.Context, request *morgana.CreateDownloadTaskRequest) (*morgana.CreateDownloadTaskResponse, error) {
 	output, err := a.downloadTaskLogic.CreateDownloadTask(ctx, logic.CreateDownloadTaskParams{
-		Token:        a.getAuthTokenMetadata(ctx),
+		Token:        getAuthTokenMetadata(ctx),
 		DownloadType: request.GetDownloadType(),
 		URL:          request.GetUrl(),
+		FileName:     request.GetFileName(),
+		Owner:        request.GetOwner(),
 	})
 	if err != nil {
 		return nil, err
@@ -82,14 +251,27 @@ func (a Handler) CreateDownloadTask(ctx context.Context, request *morgana.Create
 
 func (a Handler) CreateSession(ctx context.Context, request *morgana.CreateSessionRequest) (*morgana.CreateSessionResponse, error) {
 	output, err := a.accountLogic.CreateSession(ctx, logic.CreateSessionParams{
-		AccountName: request.GetAccountName(),
-		Password:    request.GetPassword(),
+		AccountName:             request.GetAccountName(),
+		Password:                request.GetPassword(),
+		IPAddress:               a.getClientIPAddress(ctx),
+		TwoFactorChallengeToken: request.GetTwoFactorChallengeToken(),
+		TwoFactorCode:           request.GetTwoFactorCode(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = grpc.SetHeader(ctx, metadata.Pairs(AuthTokenMetadataName, output.Token))
+	if output.TwoFactorChallengeToken != "" {
+		return &morgana.CreateSessionResponse{
+			TwoFactorChallengeToken:      output.TwoFactorChallengeToken,
+			TwoFactorChallengeExpireTime: timestamppb.New(output.TwoFactorChallengeExpireTime),
+		}, nil
+	}
+
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, output.Token,
+		RefreshTokenMetadataName, output.RefreshToken,
+	))
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +281,167 @@ func (a Handler) CreateSession(ctx context.Context, request *morgana.CreateSessi
 	}, nil
 }
 
+func (a Handler) RefreshSession(ctx context.Context, request *morgana.RefreshSessionRequest) (*morgana.RefreshSessionResponse, error) {
+	refreshToken := request.GetRefreshToken()
+	if refreshToken == "" {
+		refreshToken = getMetadata(ctx, RefreshTokenMetadataName)
+	}
+
+	output, err := a.accountLogic.RefreshSession(ctx, logic.RefreshSessionParams{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, output.Token,
+		RefreshTokenMetadataName, output.RefreshToken,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.RefreshSessionResponse{}, nil
+}
+
+func (a Handler) DeleteSession(ctx context.Context, request *morgana.DeleteSessionRequest) (*morgana.DeleteSessionResponse, error) {
+	err := a.accountLogic.DeleteSession(ctx, logic.DeleteSessionParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty values tell the HTTP gateway to clear the cookies.
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, "",
+		RefreshTokenMetadataName, "",
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.DeleteSessionResponse{}, nil
+}
+
+func (a Handler) ListSessions(ctx context.Context, request *morgana.ListSessionsRequest) (*morgana.ListSessionsResponse, error) {
+	output, err := a.accountLogic.ListSessions(ctx, logic.ListSessionsParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.ListSessionsResponse{
+		SessionList: output.SessionList,
+	}, nil
+}
+
+func (a Handler) RevokeSession(ctx context.Context, request *morgana.RevokeSessionRequest) (*morgana.RevokeSessionResponse, error) {
+	err := a.accountLogic.RevokeSession(ctx, logic.RevokeSessionParams{
+		Token:     getAuthTokenMetadata(ctx),
+		SessionID: request.GetSessionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.RevokeSessionResponse{}, nil
+}
+
+func (a Handler) ChangePassword(ctx context.Context, request *morgana.ChangePasswordRequest) (*morgana.ChangePasswordResponse, error) {
+	err := a.accountLogic.ChangePassword(ctx, logic.ChangePasswordParams{
+		Token:           getAuthTokenMetadata(ctx),
+		CurrentPassword: request.GetCurrentPassword(),
+		NewPassword:     request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.ChangePasswordResponse{}, nil
+}
+
+func (a Handler) EnrollTwoFactor(
+	ctx context.Context,
+	_ *morgana.EnrollTwoFactorRequest,
+) (*morgana.EnrollTwoFactorResponse, error) {
+	output, err := a.twoFactorLogic.Enroll(ctx, logic.EnrollTwoFactorParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.EnrollTwoFactorResponse{
+		Secret:     output.Secret,
+		OtpauthUri: output.OTPAuthURI,
+		QrCodePng:  output.QRCodePNG,
+	}, nil
+}
+
+func (a Handler) ConfirmTwoFactor(
+	ctx context.Context,
+	request *morgana.ConfirmTwoFactorRequest,
+) (*morgana.ConfirmTwoFactorResponse, error) {
+	output, err := a.twoFactorLogic.Confirm(ctx, logic.ConfirmTwoFactorParams{
+		Token: getAuthTokenMetadata(ctx),
+		Code:  request.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.ConfirmTwoFactorResponse{
+		RecoveryCodeList: output.RecoveryCodeList,
+	}, nil
+}
+
+func (a Handler) DisableTwoFactor(
+	ctx context.Context,
+	request *morgana.DisableTwoFactorRequest,
+) (*morgana.DisableTwoFactorResponse, error) {
+	err := a.twoFactorLogic.Disable(ctx, logic.DisableTwoFactorParams{
+		Token: getAuthTokenMetadata(ctx),
+		Code:  request.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.DisableTwoFactorResponse{}, nil
+}
+
+func (a Handler) RequestPasswordReset(
+	ctx context.Context,
+	request *morgana.RequestPasswordResetRequest,
+) (*morgana.RequestPasswordResetResponse, error) {
+	err := a.accountLogic.RequestPasswordReset(ctx, logic.RequestPasswordResetParams{
+		AccountName: request.GetAccountName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.RequestPasswordResetResponse{}, nil
+}
+
+func (a Handler) ResetPassword(ctx context.Context, request *morgana.ResetPasswordRequest) (*morgana.ResetPasswordResponse, error) {
+	err := a.accountLogic.ResetPassword(ctx, logic.ResetPasswordParams{
+		PasswordResetToken: request.GetPasswordResetToken(),
+		NewPassword:        request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.ResetPasswordResponse{}, nil
+}
+
 func (a Handler) DeleteDownloadTask(ctx context.Context, request *morgana.DeleteDownloadTaskRequest) (*morgana.DeleteDownloadTaskResponse, error) {
 	err := a.downloadTaskLogic.DeleteDownloadTask(ctx, logic.DeleteDownloadTaskParams{
-		Token:          a.getAuthTokenMetadata(ctx),
+		Token:          getAuthTokenMetadata(ctx),
 		DownloadTaskID: request.GetDownloadTaskId(),
 	})
 	if err != nil {
@@ -112,16 +452,25 @@ func (a Handler) DeleteDownloadTask(ctx context.Context, request *morgana.Delete
 }
 
 func (a Handler) GetDownloadTaskFile(request *morgana.GetDownloadTaskFileRequest, server morgana.MorganaService_GetDownloadTaskFileServer) error {
-	outputReader, err := a.downloadTaskLogic.GetDownloadTaskFile(server.Context(), logic.GetDownloadTaskFileParams{
-		Token:          a.getAuthTokenMetadata(server.Context()),
-		DownloadTaskID: request.GetDownloadTaskId(),
+	output, err := a.downloadTaskLogic.GetDownloadTaskFile(server.Context(), logic.GetDownloadTaskFileParams{
+		Token:                       getAuthTokenMetadata(server.Context()),
+		DownloadTaskID:              request.GetDownloadTaskId(),
+		AcceptedContentEncodingList: a.getAcceptEncodingMetadata(server.Context()),
 	})
 	if err != nil {
 		return err
 	}
 
+	outputReader := output.FileReadCloser
 	defer outputReader.Close()
 
+	if output.ContentEncoding != "" {
+		err = server.SetHeader(metadata.Pairs(ContentEncodingMetadataName, output.ContentEncoding))
+		if err != nil {
+			return err
+		}
+	}
+
 	for {
 		dataBuffer := make([]byte, a.getDownloadTaskFileResponseBufferSizeInBytes)
 		readByteCount, readErr := outputReader.Read(dataBuffer)
@@ -147,9 +496,10 @@ func (a Handler) GetDownloadTaskFile(request *morgana.GetDownloadTaskFileRequest
 
 func (a Handler) GetDownloadTaskList(ctx context.Context, request *morgana.GetDownloadTaskListRequest) (*morgana.GetDownloadTaskListResponse, error) {
 	output, err := a.downloadTaskLogic.GetDownloadTaskList(ctx, logic.GetDownloadTaskListParams{
-		Token:  a.getAuthTokenMetadata(ctx),
+		Token:  getAuthTokenMetadata(ctx),
 		Offset: request.GetOffset(),
 		Limit:  request.GetLimit(),
+		Owner:  request.GetOwner(),
 	})
 	if err != nil {
 		return nil, err
@@ -163,7 +513,7 @@ func (a Handler) GetDownloadTaskList(ctx context.Context, request *morgana.GetDo
 
 func (a Handler) UpdateDownloadTask(ctx context.Context, request *morgana.UpdateDownloadTaskRequest) (*morgana.UpdateDownloadTaskResponse, error) {
 	output, err := a.downloadTaskLogic.UpdateDownloadTask(ctx, logic.UpdateDownloadTaskParams{
-		Token:          a.getAuthTokenMetadata(ctx),
+		Token:          getAuthTokenMetadata(ctx),
 		DownloadTaskID: request.GetDownloadTaskId(),
 		URL:            request.GetUrl(),
 	})
@@ -180,3 +530,124 @@ func (a Handler) UpdateDownloadTask(ctx context.Context, request *morgana.Update
 // func (a *Handler) mustEmbedUnimplementedGoLoadServiceServer() {
 // 	panic("unimplemented")
 // }
+
+func (a Handler) GetUsage(ctx context.Context, request *morgana.GetUsageRequest) (*morgana.GetUsageResponse, error) {
+	output, err := a.downloadTaskLogic.GetUsage(ctx, logic.GetUsageParams{
+		Token: getAuthTokenMetadata(ctx),
+		Owner: request.GetOwner(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.GetUsageResponse{
+		DownloadTaskCount:    output.DownloadTaskCount,
+		TotalFileSize:        output.TotalFileSize,
+		MaxDownloadTaskCount: output.MaxDownloadTaskCount,
+		MaxTotalFileSize:     output.MaxTotalFileSize,
+	}, nil
+}
+
+func (a Handler) CreateOrganization(
+	ctx context.Context,
+	request *morgana.CreateOrganizationRequest,
+) (*morgana.CreateOrganizationResponse, error) {
+	output, err := a.organizationLogic.CreateOrganization(ctx, logic.CreateOrganizationParams{
+		Token:            getAuthTokenMetadata(ctx),
+		OrganizationName: request.GetOrganizationName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.CreateOrganizationResponse{
+		Organization: output.Organization,
+	}, nil
+}
+
+func (a Handler) GetOrganizationList(
+	ctx context.Context,
+	request *morgana.GetOrganizationListRequest,
+) (*morgana.GetOrganizationListResponse, error) {
+	output, err := a.organizationLogic.GetOrganizationList(ctx, logic.GetOrganizationListParams{
+		Token: getAuthTokenMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.GetOrganizationListResponse{
+		OrganizationList: output.OrganizationList,
+	}, nil
+}
+
+func (a Handler) GetOrganizationMemberList(
+	ctx context.Context,
+	request *morgana.GetOrganizationMemberListRequest,
+) (*morgana.GetOrganizationMemberListResponse, error) {
+	output, err := a.organizationLogic.GetOrganizationMemberList(ctx, logic.GetOrganizationMemberListParams{
+		Token:          getAuthTokenMetadata(ctx),
+		OrganizationID: request.GetOrganizationId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.GetOrganizationMemberListResponse{
+		OrganizationMemberList: output.OrganizationMemberList,
+	}, nil
+}
+
+func (a Handler) AddOrganizationMember(
+	ctx context.Context,
+	request *morgana.AddOrganizationMemberRequest,
+) (*morgana.AddOrganizationMemberResponse, error) {
+	output, err := a.organizationLogic.AddOrganizationMember(ctx, logic.AddOrganizationMemberParams{
+		Token:          getAuthTokenMetadata(ctx),
+		OrganizationID: request.GetOrganizationId(),
+		AccountName:    request.GetAccountName(),
+		Role:           request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.AddOrganizationMemberResponse{
+		OrganizationMember: output.OrganizationMember,
+	}, nil
+}
+
+func (a Handler) UpdateOrganizationMember(
+	ctx context.Context,
+	request *morgana.UpdateOrganizationMemberRequest,
+) (*morgana.UpdateOrganizationMemberResponse, error) {
+	output, err := a.organizationLogic.UpdateOrganizationMember(ctx, logic.UpdateOrganizationMemberParams{
+		Token:          getAuthTokenMetadata(ctx),
+		OrganizationID: request.GetOrganizationId(),
+		AccountID:      request.GetAccountId(),
+		Role:           request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.UpdateOrganizationMemberResponse{
+		OrganizationMember: output.OrganizationMember,
+	}, nil
+}
+
+func (a Handler) RemoveOrganizationMember(
+	ctx context.Context,
+	request *morgana.RemoveOrganizationMemberRequest,
+) (*morgana.RemoveOrganizationMemberResponse, error) {
+	err := a.organizationLogic.RemoveOrganizationMember(ctx, logic.RemoveOrganizationMemberParams{
+		Token:          getAuthTokenMetadata(ctx),
+		OrganizationID: request.GetOrganizationId(),
+		AccountID:      request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &morgana.RemoveOrganizationMemberResponse{}, nil
+}
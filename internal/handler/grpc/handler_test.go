@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func newTestHandler(t *testing.T, trustedProxyList ...string) Handler {
+	t.Helper()
+
+	trustedProxyPrefixList, err := configs.GRPC{TrustedProxyList: trustedProxyList}.GetTrustedProxyPrefixList()
+	if err != nil {
+		t.Fatalf("failed to parse trusted proxies: %v", err)
+	}
+
+	return Handler{trustedProxyPrefixList: trustedProxyPrefixList}
+}
+
+func newTestRequestContext(peerAddress string, forwardedForValueList ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: net.TCPAddrFromAddrPort(netip.MustParseAddrPort(peerAddress)),
+	})
+
+	metadataPairList := make([]string, 0)
+	for _, forwardedForValue := range forwardedForValueList {
+		metadataPairList = append(metadataPairList, ForwardedForMetadataName, forwardedForValue)
+	}
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(metadataPairList...))
+}
+
+func TestGetClientIPAddress(t *testing.T) {
+	testCaseList := []struct {
+		name                  string
+		trustedProxyList      []string
+		peerAddress           string
+		forwardedForValueList []string
+		expectedIPAddress     string
+	}{
+		{
+			name:              "direct request",
+			peerAddress:       "203.0.113.7:50000",
+			expectedIPAddress: "203.0.113.7",
+		},
+		{
+			name:                  "direct request with a spoofed X-Forwarded-For",
+			peerAddress:           "203.0.113.7:50000",
+			forwardedForValueList: []string{"198.51.100.1"},
+			expectedIPAddress:     "203.0.113.7",
+		},
+		{
+			name:                  "request forwarded by the HTTP gateway",
+			peerAddress:           "127.0.0.1:50000",
+			forwardedForValueList: []string{"198.51.100.1, 203.0.113.7"},
+			expectedIPAddress:     "203.0.113.7",
+		},
+		{
+			name:              "request from the loopback address without X-Forwarded-For",
+			peerAddress:       "[::1]:50000",
+			expectedIPAddress: "::1",
+		},
+		{
+			name:                  "request forwarded by an untrusted proxy to the HTTP gateway",
+			peerAddress:           "127.0.0.1:50000",
+			forwardedForValueList: []string{"203.0.113.7, 10.0.0.2"},
+			expectedIPAddress:     "10.0.0.2",
+		},
+		{
+			name:                  "request forwarded by trusted proxies to the HTTP gateway",
+			trustedProxyList:      []string{"10.0.0.0/8", "192.0.2.10"},
+			peerAddress:           "127.0.0.1:50000",
+			forwardedForValueList: []string{"198.51.100.1, 203.0.113.7, 192.0.2.10", "10.0.0.2"},
+			expectedIPAddress:     "203.0.113.7",
+		},
+		{
+			name:                  "request forwarded by a trusted proxy to the gRPC server",
+			trustedProxyList:      []string{"10.0.0.0/8"},
+			peerAddress:           "10.0.0.2:50000",
+			forwardedForValueList: []string{"203.0.113.7"},
+			expectedIPAddress:     "203.0.113.7",
+		},
+		{
+			name:                  "request forwarded through trusted proxies only",
+			trustedProxyList:      []string{"10.0.0.0/8"},
+			peerAddress:           "127.0.0.1:50000",
+			forwardedForValueList: []string{"10.0.0.3, 10.0.0.2"},
+			expectedIPAddress:     "10.0.0.3",
+		},
+		{
+			name:                  "request with an invalid X-Forwarded-For entry",
+			trustedProxyList:      []string{"10.0.0.0/8"},
+			peerAddress:           "127.0.0.1:50000",
+			forwardedForValueList: []string{"203.0.113.7, unknown, 10.0.0.2"},
+			expectedIPAddress:     "10.0.0.2",
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := newTestHandler(t, testCase.trustedProxyList...)
+			ctx := newTestRequestContext(testCase.peerAddress, testCase.forwardedForValueList...)
+
+			if ipAddress := handler.getClientIPAddress(ctx); ipAddress != testCase.expectedIPAddress {
+				t.Fatalf("expected client address %q, got %q", testCase.expectedIPAddress, ipAddress)
+			}
+		})
+	}
+}
+
+func TestGetTrustedProxyPrefixListRejectsInvalidEntries(t *testing.T) {
+	if _, err := (configs.GRPC{TrustedProxyList: []string{"10.0.0.0/33"}}).GetTrustedProxyPrefixList(); err == nil {
+		t.Fatal("expected an invalid trusted proxy to be rejected")
+	}
+}
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func observeRequest(method string, startTime time.Time, err error) {
+	metrics.GRPCRequestsTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+	metrics.GRPCRequestDurationSeconds.WithLabelValues(method).Observe(time.Since(startTime).Seconds())
+}
+
+// metricsUnaryServerInterceptor records the count and latency of unary RPCs. It comes first in
+// the chain, so that requests rejected by the other interceptors are recorded as well.
+func metricsUnaryServerInterceptor(
+	ctx context.Context,
+	request any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	startTime := time.Now()
+	response, err := handler(ctx, request)
+	observeRequest(info.FullMethod, startTime, err)
+	return response, err
+}
+
+// metricsStreamServerInterceptor records the count and duration of streaming RPCs.
+func metricsStreamServerInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	startTime := time.Now()
+	err := handler(server, stream)
+	observeRequest(info.FullMethod, startTime, err)
+	return err
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	RequestIDMetadataName = "x-request-id"
+)
+
+// withRequestID marks ctx with the request ID sent by the caller, such as the HTTP gateway, or a
+// new one, and sends it back in the response header so that callers can report it.
+func withRequestID(ctx context.Context) context.Context {
+	requestID := ""
+	if requestIDList := metadata.ValueFromIncomingContext(ctx, RequestIDMetadataName); len(requestIDList) > 0 {
+		requestID = requestIDList[0]
+	}
+
+	if requestID == "" {
+		requestID = utils.NewRequestID()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataName, requestID))
+	return utils.WithRequestID(ctx, requestID)
+}
+
+func requestIDUnaryServerInterceptor(
+	ctx context.Context,
+	request any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(withRequestID(ctx), request)
+}
+
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func requestIDStreamServerInterceptor(
+	server any,
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(server, requestIDServerStream{
+		ServerStream: stream,
+		ctx:          withRequestID(stream.Context()),
+	})
+}
@@ -3,11 +3,14 @@ package grpc
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/validator"
 	"github.com/hoangdv99/morgana/internal/configs"
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
 	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -17,22 +20,62 @@ type Server interface {
 }
 
 type server struct {
-	handler    morgana.MorganaServiceServer
-	grpcConfig configs.GRPC
-	logger     *zap.Logger
+	handler        morgana.MorganaServiceServer
+	adminHandler   morgana.AdminServiceServer
+	grpcConfig     configs.GRPC
+	shutdownConfig configs.Shutdown
+	tracerProvider trace.TracerProvider
+	logger         *zap.Logger
 }
 
-func NewServer(handler morgana.MorganaServiceServer, grpcConfig configs.GRPC, logger *zap.Logger) Server {
+func NewServer(
+	handler morgana.MorganaServiceServer,
+	adminHandler morgana.AdminServiceServer,
+	grpcConfig configs.GRPC,
+	shutdownConfig configs.Shutdown,
+	tracerProvider trace.TracerProvider,
+	logger *zap.Logger,
+) Server {
 	return &server{
-		handler:    handler,
-		grpcConfig: grpcConfig,
-		logger:     logger,
+		handler:        handler,
+		adminHandler:   adminHandler,
+		grpcConfig:     grpcConfig,
+		shutdownConfig: shutdownConfig,
+		tracerProvider: tracerProvider,
+		logger:         logger,
 	}
 }
 
+// stop stops the server from accepting new RPCs, and waits for the pending ones to finish, up to
+// the shutdown timeout, before closing their connections.
+func (s *server) stop(ctx context.Context, grpcServer *grpc.Server, shutdownTimeout time.Duration) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	gracefullyStopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(gracefullyStopped)
+	}()
+
+	select {
+	case <-gracefullyStopped:
+		logger.Info("gRPC server stopped")
+	case <-time.After(shutdownTimeout):
+		logger.Warn("gRPC server did not stop before the shutdown timeout, stopping it forcefully")
+		grpcServer.Stop()
+	}
+}
+
+// Start serves RPCs until ctx is canceled, then stops the server gracefully.
 func (s *server) Start(ctx context.Context) error {
 	logger := utils.LoggerWithContext(ctx, s.logger)
 
+	shutdownTimeout, err := s.shutdownConfig.GetTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse shutdown timeout")
+		return err
+	}
+
 	listener, err := net.Listen("tcp", s.grpcConfig.Address)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to start gRPC server")
@@ -42,15 +85,34 @@ func (s *server) Start(ctx context.Context) error {
 	defer listener.Close()
 
 	server := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(s.tracerProvider))),
 		grpc.ChainUnaryInterceptor(
+			metricsUnaryServerInterceptor,
+			requestIDUnaryServerInterceptor,
 			validator.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			metricsStreamServerInterceptor,
+			requestIDStreamServerInterceptor,
 			validator.StreamServerInterceptor(),
 		),
 	)
 	morgana.RegisterMorganaServiceServer(server, s.handler)
+	morgana.RegisterAdminServiceServer(server, s.adminHandler)
 	logger.Info("gRPC server started", zap.String("address", s.grpcConfig.Address))
 
-	return server.Serve(listener)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		s.stop(ctx, server, shutdownTimeout)
+	}()
+
+	err = server.Serve(listener)
+	if err != nil {
+		return err
+	}
+
+	<-stopped
+	return nil
 }
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hoangdv99/morgana/internal/logic"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	downloadTaskFilePathPattern = "GET /download-tasks/{download_task_id}/file"
+	downloadTaskIDPathValueName = "download_task_id"
+
+	HTTPHeaderContentType        = "Content-Type"
+	HTTPHeaderContentDisposition = "Content-Disposition"
+	HTTPHeaderContentEncoding    = "Content-Encoding"
+	HTTPHeaderAcceptEncoding     = "Accept-Encoding"
+	HTTPHeaderVary               = "Vary"
+	HTTPHeaderAuthorization      = "Authorization"
+
+	defaultDownloadTaskFileContentType = "application/octet-stream"
+)
+
+// getAuthToken returns the credential of a request, preferring a bearer Authorization header over
+// the auth token cookie, the same way the gRPC handler does.
+func (s server) getAuthToken(r *http.Request) string {
+	if token, ok := utils.GetBearerToken(r.Header.Get(HTTPHeaderAuthorization)); ok {
+		return token
+	}
+
+	cookie, err := r.Cookie(AuthTokenCookieName)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+// getAcceptedContentEncodingList returns the content encodings listed in the Accept-Encoding
+// headers of a request, leaving out the ones explicitly refused with a quality value of 0.
+func (s server) getAcceptedContentEncodingList(r *http.Request) []string {
+	acceptedContentEncodingList := make([]string, 0)
+	for _, headerValue := range r.Header.Values(HTTPHeaderAcceptEncoding) {
+		for _, item := range strings.Split(headerValue, ",") {
+			contentEncoding, params, _ := strings.Cut(item, ";")
+			contentEncoding = strings.ToLower(strings.TrimSpace(contentEncoding))
+			if contentEncoding == "" {
+				continue
+			}
+
+			quality, hasQuality := strings.CutPrefix(strings.TrimSpace(params), "q=")
+			if hasQuality {
+				qualityValue, err := strconv.ParseFloat(quality, 64)
+				if err != nil || qualityValue == 0 {
+					continue
+				}
+			}
+
+			acceptedContentEncodingList = append(acceptedContentEncodingList, contentEncoding)
+		}
+	}
+
+	return acceptedContentEncodingList
+}
+
+func (s server) writeError(w http.ResponseWriter, err error) {
+	errStatus := status.Convert(err)
+	http.Error(w, errStatus.Message(), runtime.HTTPStatusFromCode(errStatus.Code()))
+}
+
+func (s server) getDownloadTaskFile(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	downloadTaskID, err := strconv.ParseUint(r.PathValue(downloadTaskIDPathValueName), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid download task id", http.StatusBadRequest)
+		return
+	}
+
+	output, err := s.downloadTaskLogic.GetDownloadTaskFile(r.Context(), logic.GetDownloadTaskFileParams{
+		Token:                       s.getAuthToken(r),
+		DownloadTaskID:              downloadTaskID,
+		AcceptedContentEncodingList: s.getAcceptedContentEncodingList(r),
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	defer output.FileReadCloser.Close()
+
+	contentType := output.ContentType
+	if contentType == "" {
+		contentType = defaultDownloadTaskFileContentType
+	}
+
+	contentDisposition := "attachment"
+	if output.FileName != "" {
+		contentDisposition = mime.FormatMediaType(contentDisposition, map[string]string{"filename": output.FileName})
+	}
+
+	w.Header().Set(HTTPHeaderContentType, contentType)
+	w.Header().Set(HTTPHeaderContentDisposition, contentDisposition)
+	w.Header().Set(HTTPHeaderVary, HTTPHeaderAcceptEncoding)
+	if output.ContentEncoding != "" {
+		w.Header().Set(HTTPHeaderContentEncoding, output.ContentEncoding)
+	}
+
+	_, err = io.Copy(w, output.FileReadCloser)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to write download task file to response")
+	}
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	jsonWebKeySetPathPattern = "GET /.well-known/jwks.json"
+
+	HTTPHeaderCacheControl = "Cache-Control"
+
+	jsonWebKeySetContentType = "application/json"
+)
+
+func (s server) getJSONWebKeySet(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	output, err := s.tokenLogic.GetJSONWebKeySet(r.Context())
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	w.Header().Set(HTTPHeaderContentType, jsonWebKeySetContentType)
+	w.Header().Set(HTTPHeaderCacheControl, fmt.Sprintf("public, max-age=%d", int64(output.MaxAge.Seconds())))
+
+	err = json.NewEncoder(w).Encode(output)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to write json web key set to response")
+	}
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/metrics"
+)
+
+// statusRecordingResponseWriter remembers the status code of the response it writes.
+type statusRecordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusRecordingResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *statusRecordingResponseWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush is forwarded, since the gRPC gateway streams responses of server streaming RPCs.
+func (w *statusRecordingResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *statusRecordingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// withMetrics records the count and latency of the requests handled by handler, labeled with the
+// pattern it is registered with rather than the request path, which would be unbounded.
+func withMetrics(pattern string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		statusRecordingWriter := &statusRecordingResponseWriter{ResponseWriter: w}
+
+		handler.ServeHTTP(statusRecordingWriter, r)
+
+		statusCode := statusRecordingWriter.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		metrics.HTTPRequestsTotal.WithLabelValues(pattern, r.Method, strconv.Itoa(statusCode)).Inc()
+		metrics.HTTPRequestDurationSeconds.WithLabelValues(pattern, r.Method).Observe(time.Since(startTime).Seconds())
+	})
+}
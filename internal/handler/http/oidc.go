@@ -0,0 +1,305 @@
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/handler/http/servemuxoptions"
+	"github.com/hoangdv99/morgana/internal/logic"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	oidcLoginPathPattern    = "GET /auth/oidc/login"
+	oidcCallbackPathPattern = "GET /auth/oidc/callback"
+
+	//nolint:gosec // This is just to specify the cookie name
+	oidcStateCookieName   = "MORGANA_OIDC"
+	oidcStateCookiePath   = "/auth/oidc"
+	oidcStateCookieMaxAge = 10 * time.Minute
+	oidcStateByteCount    = 32
+
+	oidcHTTPClientTimeout           = 30 * time.Second
+	oidcDefaultPostLoginRedirectURL = "/"
+
+	oidcTwoFactorChallengeTokenFragmentName      = "two_factor_challenge_token"
+	oidcTwoFactorChallengeExpireTimeFragmentName = "two_factor_challenge_expire_time"
+)
+
+var (
+	errOIDCLoginFailed  = status.Error(codes.Unauthenticated, "oidc login failed")
+	errInvalidOIDCState = status.Error(codes.InvalidArgument, "invalid oidc state")
+)
+
+// oidcState is kept in a short lived cookie between the redirect to the provider and the callback.
+// It does not need to be signed: the state is only compared with the one the provider sends back
+// to the same browser, and the nonce and code verifier are only useful to that browser.
+type oidcState struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// oidcClient discovers the provider on first use instead of at startup, so that the server can
+// start while the provider is unreachable. Discovery is retried on the next login if it fails.
+type oidcClient struct {
+	oidcConfig   configs.OIDC
+	httpClient   *http.Client
+	mutex        sync.Mutex
+	oauth2Config *oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+}
+
+func newOIDCClient(oidcConfig configs.OIDC) *oidcClient {
+	return &oidcClient{
+		oidcConfig: oidcConfig,
+		httpClient: &http.Client{Timeout: oidcHTTPClientTimeout},
+	}
+}
+
+func (o *oidcClient) getContext(ctx context.Context) context.Context {
+	return oidc.ClientContext(ctx, o.httpClient)
+}
+
+func (o *oidcClient) get() (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.oauth2Config != nil {
+		return o.oauth2Config, o.verifier, nil
+	}
+
+	// The provider keeps using this context to refresh its key set, so it must not be the
+	// context of the request that triggered the discovery.
+	provider, err := oidc.NewProvider(o.getContext(context.Background()), o.oidcConfig.IssuerURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scopes := o.oidcConfig.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+
+	o.oauth2Config = &oauth2.Config{
+		ClientID:     o.oidcConfig.ClientID,
+		ClientSecret: o.oidcConfig.ClientSecret,
+		RedirectURL:  o.oidcConfig.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	o.verifier = provider.Verifier(&oidc.Config{ClientID: o.oidcConfig.ClientID})
+
+	return o.oauth2Config, o.verifier, nil
+}
+
+func generateOIDCRandomString() (string, error) {
+	randomBytes := make([]byte, oidcStateByteCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+func (s server) setOIDCStateCookie(w http.ResponseWriter, value string, maxAge time.Duration) {
+	cookie := &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    value,
+		Path:     oidcStateCookiePath,
+		HttpOnly: true,
+		// The callback is a top level navigation coming from the provider, which strict cookies
+		// are not sent with.
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   int(maxAge.Seconds()),
+	}
+
+	if value == "" {
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(w, cookie)
+}
+
+func (s server) getOIDCState(r *http.Request) (oidcState, error) {
+	cookie, err := r.Cookie(oidcStateCookieName)
+	if err != nil {
+		return oidcState{}, err
+	}
+
+	stateBytes, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return oidcState{}, err
+	}
+
+	state := oidcState{}
+	err = json.Unmarshal(stateBytes, &state)
+	if err != nil {
+		return oidcState{}, err
+	}
+
+	return state, nil
+}
+
+func (s server) startOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	oauth2Config, _, err := s.oidcClient.get()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to discover oidc provider")
+		s.writeError(w, status.Error(codes.Unavailable, "oidc provider is not available"))
+		return
+	}
+
+	state := oidcState{CodeVerifier: oauth2.GenerateVerifier()}
+	if state.State, err = generateOIDCRandomString(); err == nil {
+		state.Nonce, err = generateOIDCRandomString()
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate oidc state")
+		s.writeError(w, status.Error(codes.Internal, "failed to generate oidc state"))
+		return
+	}
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal oidc state")
+		s.writeError(w, status.Error(codes.Internal, "failed to generate oidc state"))
+		return
+	}
+
+	s.setOIDCStateCookie(w, base64.RawURLEncoding.EncodeToString(stateBytes), oidcStateCookieMaxAge)
+	http.Redirect(w, r, oauth2Config.AuthCodeURL(
+		state.State,
+		oidc.Nonce(state.Nonce),
+		oauth2.S256ChallengeOption(state.CodeVerifier),
+	), http.StatusFound)
+}
+
+func (s server) finishOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	// The state can only be used once, whatever the outcome of the callback.
+	state, err := s.getOIDCState(r)
+	s.setOIDCStateCookie(w, "", 0)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to get oidc state from cookie")
+		s.writeError(w, errInvalidOIDCState)
+		return
+	}
+
+	query := r.URL.Query()
+	if errorCode := query.Get("error"); errorCode != "" {
+		logger.
+			With(zap.String("error", errorCode)).
+			With(zap.String("error_description", query.Get("error_description"))).
+			Warn("oidc provider returned an error")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state.State)) != 1 {
+		s.writeError(w, errInvalidOIDCState)
+		return
+	}
+
+	oauth2Config, verifier, err := s.oidcClient.get()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to discover oidc provider")
+		s.writeError(w, status.Error(codes.Unavailable, "oidc provider is not available"))
+		return
+	}
+
+	ctx := s.oidcClient.getContext(r.Context())
+	oauth2Token, err := oauth2Config.Exchange(ctx, query.Get("code"), oauth2.VerifierOption(state.CodeVerifier))
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to exchange oidc authorization code")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		logger.Warn("oidc token response does not contain an id token")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to verify oidc id token")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(state.Nonce)) != 1 {
+		logger.Warn("oidc id token nonce does not match")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	claims := struct {
+		Email             string `json:"email"`
+		EmailVerified     bool   `json:"email_verified"`
+		PreferredUsername string `json:"preferred_username"`
+	}{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse oidc id token claims")
+		s.writeError(w, errOIDCLoginFailed)
+		return
+	}
+
+	output, err := s.accountLogic.CreateOIDCSession(r.Context(), logic.CreateOIDCSessionParams{
+		Issuer:            idToken.Issuer,
+		Subject:           idToken.Subject,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		PreferredUsername: claims.PreferredUsername,
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	postLoginRedirectURL := s.authConfig.OIDC.PostLoginRedirectURL
+	if postLoginRedirectURL == "" {
+		postLoginRedirectURL = oidcDefaultPostLoginRedirectURL
+	}
+
+	if output.TwoFactorChallengeToken != "" {
+		http.Redirect(w, r, s.getOIDCTwoFactorChallengeRedirectURL(postLoginRedirectURL, output), http.StatusFound)
+		return
+	}
+
+	servemuxoptions.SetAuthCookie(w, AuthTokenCookieName, output.Token, time.Until(output.TokenExpireTime))
+	servemuxoptions.SetAuthCookie(w, RefreshTokenCookieName, output.RefreshToken, time.Until(output.RefreshTokenExpireTime))
+
+	http.Redirect(w, r, postLoginRedirectURL, http.StatusFound)
+}
+
+// getOIDCTwoFactorChallengeRedirectURL passes the two-factor challenge of a login to the post login
+// page in the fragment of its URL, which browsers do not send to servers, so that the page can
+// complete the login with CreateSession.
+func (s server) getOIDCTwoFactorChallengeRedirectURL(postLoginRedirectURL string, output logic.CreateSessionOutput) string {
+	fragment := url.Values{}
+	fragment.Set(oidcTwoFactorChallengeTokenFragmentName, output.TwoFactorChallengeToken)
+	fragment.Set(oidcTwoFactorChallengeExpireTimeFragmentName, output.TwoFactorChallengeExpireTime.UTC().Format(time.RFC3339))
+
+	postLoginRedirectURL, _, _ = strings.Cut(postLoginRedirectURL, "#")
+	return postLoginRedirectURL + "#" + fragment.Encode()
+}
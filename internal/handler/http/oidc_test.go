@@ -0,0 +1,376 @@
+package http
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/logic"
+	"go.uber.org/zap"
+)
+
+const (
+	mockOIDCProviderKeyID        = "test-key"
+	mockOIDCProviderClientID     = "morgana-test"
+	mockOIDCProviderCode         = "test-code"
+	mockOIDCProviderSubject      = "test-subject"
+	mockOIDCPostLoginRedirectURL = "/after-login"
+)
+
+// mockOIDCProvider is an OIDC provider that issues an ID token for a single authorization code,
+// signed with a key generated for the test.
+type mockOIDCProvider struct {
+	t          *testing.T
+	server     *httptest.Server
+	privateKey *rsa.PrivateKey
+
+	mutex         sync.Mutex
+	nonce         string
+	codeChallenge string
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	provider := &mockOIDCProvider{
+		t:          t,
+		privateKey: privateKey,
+	}
+
+	serveMux := http.NewServeMux()
+	serveMux.HandleFunc("GET /.well-known/openid-configuration", provider.getConfiguration)
+	serveMux.HandleFunc("GET /jwks", provider.getJSONWebKeySet)
+	serveMux.HandleFunc("POST /token", provider.createToken)
+	provider.server = httptest.NewServer(serveMux)
+	t.Cleanup(provider.server.Close)
+
+	return provider
+}
+
+func (m *mockOIDCProvider) writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set(HTTPHeaderContentType, "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		m.t.Errorf("failed to write response: %v", err)
+	}
+}
+
+func (m *mockOIDCProvider) getConfiguration(w http.ResponseWriter, _ *http.Request) {
+	m.writeJSON(w, map[string]any{
+		"issuer":                                m.server.URL,
+		"authorization_endpoint":                m.server.URL + "/authorize",
+		"token_endpoint":                        m.server.URL + "/token",
+		"jwks_uri":                              m.server.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (m *mockOIDCProvider) getJSONWebKeySet(w http.ResponseWriter, _ *http.Request) {
+	m.writeJSON(w, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": mockOIDCProviderKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(m.privateKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.privateKey.E)).Bytes()),
+		}},
+	})
+}
+
+// setAuthorizationRequest records what the browser would have sent to the authorization endpoint.
+func (m *mockOIDCProvider) setAuthorizationRequest(nonce, codeChallenge string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.nonce = nonce
+	m.codeChallenge = codeChallenge
+}
+
+func (m *mockOIDCProvider) signIDToken(claims map[string]any) string {
+	headerBytes, err := json.Marshal(map[string]string{"alg": "RS256", "kid": mockOIDCProviderKeyID, "typ": "JWT"})
+	if err != nil {
+		m.t.Fatalf("failed to marshal id token header: %v", err)
+	}
+
+	claimsBytes, err := json.Marshal(claims)
+	if err != nil {
+		m.t.Fatalf("failed to marshal id token claims: %v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBytes) + "." + base64.RawURLEncoding.EncodeToString(claimsBytes)
+	signingInputHash := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, m.privateKey, crypto.SHA256, signingInputHash[:])
+	if err != nil {
+		m.t.Fatalf("failed to sign id token: %v", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (m *mockOIDCProvider) createToken(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	codeVerifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if r.PostForm.Get("code") != mockOIDCProviderCode ||
+		base64.RawURLEncoding.EncodeToString(codeVerifierHash[:]) != m.codeChallenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	m.writeJSON(w, map[string]any{
+		"access_token": "test-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token": m.signIDToken(map[string]any{
+			"iss":                m.server.URL,
+			"sub":                mockOIDCProviderSubject,
+			"aud":                mockOIDCProviderClientID,
+			"iat":                now.Unix(),
+			"exp":                now.Add(time.Hour).Unix(),
+			"nonce":              m.nonce,
+			"email":              "user@example.com",
+			"email_verified":     true,
+			"preferred_username": "user",
+		}),
+	})
+}
+
+// fakeOIDCAccountLogic records the OIDC logins it is asked to create sessions for.
+// It answers with a two-factor challenge when twoFactorChallengeToken is set.
+type fakeOIDCAccountLogic struct {
+	logic.Account
+
+	twoFactorChallengeToken string
+	paramsList              []logic.CreateOIDCSessionParams
+}
+
+func (f *fakeOIDCAccountLogic) CreateOIDCSession(
+	_ context.Context,
+	params logic.CreateOIDCSessionParams,
+) (logic.CreateSessionOutput, error) {
+	f.paramsList = append(f.paramsList, params)
+	if f.twoFactorChallengeToken != "" {
+		return logic.CreateSessionOutput{
+			TwoFactorChallengeToken:      f.twoFactorChallengeToken,
+			TwoFactorChallengeExpireTime: time.Now().Add(5 * time.Minute),
+		}, nil
+	}
+
+	return logic.CreateSessionOutput{
+		Token:                  "test-token",
+		TokenExpireTime:        time.Now().Add(time.Hour),
+		RefreshToken:           "test-refresh-token",
+		RefreshTokenExpireTime: time.Now().Add(24 * time.Hour),
+	}, nil
+}
+
+func newOIDCTestServer(provider *mockOIDCProvider, accountLogic logic.Account) server {
+	oidcConfig := configs.OIDC{
+		Enabled:              true,
+		IssuerURL:            provider.server.URL,
+		ClientID:             mockOIDCProviderClientID,
+		ClientSecret:         "test-secret",
+		RedirectURL:          "http://morgana.test/auth/oidc/callback",
+		PostLoginRedirectURL: mockOIDCPostLoginRedirectURL,
+	}
+
+	return server{
+		accountLogic: accountLogic,
+		authConfig:   configs.Auth{OIDC: oidcConfig},
+		oidcClient:   newOIDCClient(oidcConfig),
+		logger:       zap.NewNop(),
+	}
+}
+
+// startTestOIDCLogin starts a login and returns the callback request the provider would redirect
+// the browser to, with the state cookie of the login.
+func startTestOIDCLogin(t *testing.T, s server, provider *mockOIDCProvider) *http.Request {
+	t.Helper()
+
+	loginRecorder := httptest.NewRecorder()
+	s.startOIDCLogin(loginRecorder, httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil))
+	if loginRecorder.Code != http.StatusFound {
+		t.Fatalf("expected login to redirect, got status %d: %s", loginRecorder.Code, loginRecorder.Body.String())
+	}
+
+	authorizationURL, err := url.Parse(loginRecorder.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("failed to parse authorization url: %v", err)
+	}
+
+	authorizationQuery := authorizationURL.Query()
+	if !strings.HasPrefix(authorizationURL.String(), provider.server.URL+"/authorize") ||
+		authorizationQuery.Get("client_id") != mockOIDCProviderClientID ||
+		authorizationQuery.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected authorization url %s", authorizationURL)
+	}
+
+	provider.setAuthorizationRequest(authorizationQuery.Get("nonce"), authorizationQuery.Get("code_challenge"))
+
+	callbackQuery := url.Values{}
+	callbackQuery.Set("code", mockOIDCProviderCode)
+	callbackQuery.Set("state", authorizationQuery.Get("state"))
+	callbackRequest := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+callbackQuery.Encode(), nil)
+	for _, cookie := range loginRecorder.Result().Cookies() {
+		callbackRequest.AddCookie(cookie)
+	}
+
+	return callbackRequest
+}
+
+func getResponseCookie(recorder *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+
+	return nil
+}
+
+func TestOIDCLogin(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	accountLogic := &fakeOIDCAccountLogic{}
+	s := newOIDCTestServer(provider, accountLogic)
+
+	callbackRecorder := httptest.NewRecorder()
+	s.finishOIDCLogin(callbackRecorder, startTestOIDCLogin(t, s, provider))
+
+	if callbackRecorder.Code != http.StatusFound {
+		t.Fatalf("expected callback to redirect, got status %d: %s", callbackRecorder.Code, callbackRecorder.Body.String())
+	}
+
+	if location := callbackRecorder.Header().Get("Location"); location != mockOIDCPostLoginRedirectURL {
+		t.Fatalf("expected redirect to %s, got %s", mockOIDCPostLoginRedirectURL, location)
+	}
+
+	expectedParams := logic.CreateOIDCSessionParams{
+		Issuer:            provider.server.URL,
+		Subject:           mockOIDCProviderSubject,
+		Email:             "user@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "user",
+	}
+	if len(accountLogic.paramsList) != 1 || accountLogic.paramsList[0] != expectedParams {
+		t.Fatalf("expected one oidc session for %+v, got %+v", expectedParams, accountLogic.paramsList)
+	}
+
+	authCookie := getResponseCookie(callbackRecorder, AuthTokenCookieName)
+	if authCookie == nil || authCookie.Value != "test-token" || !authCookie.HttpOnly {
+		t.Fatalf("unexpected auth token cookie %v", authCookie)
+	}
+
+	refreshCookie := getResponseCookie(callbackRecorder, RefreshTokenCookieName)
+	if refreshCookie == nil || refreshCookie.Value != "test-refresh-token" || !refreshCookie.HttpOnly {
+		t.Fatalf("unexpected refresh token cookie %v", refreshCookie)
+	}
+
+	stateCookie := getResponseCookie(callbackRecorder, oidcStateCookieName)
+	if stateCookie == nil || stateCookie.MaxAge >= 0 {
+		t.Fatalf("expected state cookie to be removed, got %v", stateCookie)
+	}
+}
+
+func TestOIDCLoginWithTwoFactorChallenge(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	accountLogic := &fakeOIDCAccountLogic{twoFactorChallengeToken: "test-challenge-token"}
+	s := newOIDCTestServer(provider, accountLogic)
+
+	callbackRecorder := httptest.NewRecorder()
+	s.finishOIDCLogin(callbackRecorder, startTestOIDCLogin(t, s, provider))
+
+	if callbackRecorder.Code != http.StatusFound {
+		t.Fatalf("expected callback to redirect, got status %d: %s", callbackRecorder.Code, callbackRecorder.Body.String())
+	}
+
+	location, err := url.Parse(callbackRecorder.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("failed to parse redirect url: %v", err)
+	}
+
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		t.Fatalf("failed to parse redirect url fragment: %v", err)
+	}
+
+	if location.Path != mockOIDCPostLoginRedirectURL || location.RawQuery != "" ||
+		fragment.Get(oidcTwoFactorChallengeTokenFragmentName) != "test-challenge-token" ||
+		fragment.Get(oidcTwoFactorChallengeExpireTimeFragmentName) == "" {
+		t.Fatalf("unexpected redirect url %s", location)
+	}
+
+	for _, cookieName := range []string{AuthTokenCookieName, RefreshTokenCookieName} {
+		if cookie := getResponseCookie(callbackRecorder, cookieName); cookie != nil {
+			t.Fatalf("expected no %s cookie before the two-factor challenge is completed, got %v", cookieName, cookie)
+		}
+	}
+}
+
+func TestOIDCLoginRejectsMismatchedState(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	accountLogic := &fakeOIDCAccountLogic{}
+	s := newOIDCTestServer(provider, accountLogic)
+
+	callbackRequest := startTestOIDCLogin(t, s, provider)
+	callbackQuery := callbackRequest.URL.Query()
+	callbackQuery.Set("state", "forged-state")
+	callbackRequest.URL.RawQuery = callbackQuery.Encode()
+
+	callbackRecorder := httptest.NewRecorder()
+	s.finishOIDCLogin(callbackRecorder, callbackRequest)
+
+	if callbackRecorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, callbackRecorder.Code)
+	}
+
+	if len(accountLogic.paramsList) != 0 {
+		t.Fatalf("expected no oidc session, got %+v", accountLogic.paramsList)
+	}
+}
+
+func TestOIDCLoginRejectsMismatchedNonce(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	accountLogic := &fakeOIDCAccountLogic{}
+	s := newOIDCTestServer(provider, accountLogic)
+
+	callbackRequest := startTestOIDCLogin(t, s, provider)
+	provider.mutex.Lock()
+	provider.nonce = "replayed-nonce"
+	provider.mutex.Unlock()
+
+	callbackRecorder := httptest.NewRecorder()
+	s.finishOIDCLogin(callbackRecorder, callbackRequest)
+
+	if callbackRecorder.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, callbackRecorder.Code)
+	}
+
+	if len(accountLogic.paramsList) != 0 {
+		t.Fatalf("expected no oidc session, got %+v", accountLogic.paramsList)
+	}
+}
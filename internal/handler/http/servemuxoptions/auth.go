@@ -3,9 +3,13 @@ package servemuxoptions
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/hoangdv99/morgana/internal/logic"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 )
@@ -23,6 +27,26 @@ func WithAuthCookieToAuthMetadata(authCookieName, authMetadataName string) runti
 	})
 }
 
+// SetAuthCookie sets a cookie holding a credential for expiresInDuration, or removes it when value is
+// empty.
+func SetAuthCookie(w http.ResponseWriter, authCookieName, value string, expiresInDuration time.Duration) {
+	cookie := &http.Cookie{
+		Name:     authCookieName,
+		Value:    value,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Now().Add(expiresInDuration),
+	}
+
+	// An empty value is sent on logout, and means that the cookie has to be removed.
+	if value == "" {
+		cookie.Expires = time.Time{}
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(w, cookie)
+}
+
 func WithAuthMetadataToAuthCookie(authMetadataName, authCookieName string, expiresInDuration time.Duration) runtime.ServeMuxOption {
 	return runtime.WithForwardResponseOption(func(ctx context.Context, w http.ResponseWriter, m proto.Message) error {
 		metadata, ok := runtime.ServerMetadataFromContext(ctx)
@@ -35,24 +59,122 @@ func WithAuthMetadataToAuthCookie(authMetadataName, authCookieName string, expir
 			return nil
 		}
 
-		http.SetCookie(w, &http.Cookie{
-			Name:     authCookieName,
-			Value:    authMetadataValues[0],
-			HttpOnly: true,
-			SameSite: http.SameSiteStrictMode,
-			Expires:  time.Now().Add(expiresInDuration),
-		})
+		SetAuthCookie(w, authCookieName, authMetadataValues[0], expiresInDuration)
 
 		return nil
 	})
 }
 
-func WithRemoveGoAuthMetadata(authMetadataName string) runtime.ServeMuxOption {
+func WithRemoveGoAuthMetadata(authMetadataNameList ...string) runtime.ServeMuxOption {
 	return runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) {
-		if s == authMetadataName {
-			return "", false
+		// gRPC metadata names are lower cased on the wire.
+		for _, authMetadataName := range authMetadataNameList {
+			if strings.EqualFold(s, authMetadataName) {
+				return "", false
+			}
 		}
 
 		return runtime.DefaultHeaderMatcher(s)
 	})
 }
+
+// TransparentSessionRefresher refreshes the session of a request carrying a refresh token cookie
+// whose access token cookie is missing, invalid or about to expire, so that browser clients never
+// have to call RefreshSession themselves. The new cookies are set on the response and replace the
+// old ones in the request before it is handled. If the refresh fails, the request is handled
+// unchanged.
+type TransparentSessionRefresher struct {
+	authCookieName                      string
+	refreshCookieName                   string
+	tokenLogic                          logic.Token
+	accountLogic                        logic.Account
+	regenerateTokenBeforeExpiryDuration time.Duration
+	tokenExpiresInDuration              time.Duration
+	refreshTokenExpiresInDuration       time.Duration
+	logger                              *zap.Logger
+}
+
+func NewTransparentSessionRefresher(
+	authCookieName string,
+	refreshCookieName string,
+	tokenLogic logic.Token,
+	accountLogic logic.Account,
+	regenerateTokenBeforeExpiryDuration time.Duration,
+	tokenExpiresInDuration time.Duration,
+	refreshTokenExpiresInDuration time.Duration,
+	logger *zap.Logger,
+) TransparentSessionRefresher {
+	return TransparentSessionRefresher{
+		authCookieName:                      authCookieName,
+		refreshCookieName:                   refreshCookieName,
+		tokenLogic:                          tokenLogic,
+		accountLogic:                        accountLogic,
+		regenerateTokenBeforeExpiryDuration: regenerateTokenBeforeExpiryDuration,
+		tokenExpiresInDuration:              tokenExpiresInDuration,
+		refreshTokenExpiresInDuration:       refreshTokenExpiresInDuration,
+		logger:                              logger,
+	}
+}
+
+func (t TransparentSessionRefresher) shouldRefresh(r *http.Request) bool {
+	authCookie, err := r.Cookie(t.authCookieName)
+	if err != nil || authCookie.Value == "" {
+		return true
+	}
+
+	tokenClaims, err := t.tokenLogic.GetTokenClaims(r.Context(), authCookie.Value)
+	if err != nil {
+		return true
+	}
+
+	return time.Until(tokenClaims.ExpireTime) < t.regenerateTokenBeforeExpiryDuration
+}
+
+func (t TransparentSessionRefresher) refresh(w http.ResponseWriter, r *http.Request) {
+	refreshCookie, err := r.Cookie(t.refreshCookieName)
+	if err != nil || refreshCookie.Value == "" || !t.shouldRefresh(r) {
+		return
+	}
+
+	output, err := t.accountLogic.RefreshSession(r.Context(), logic.RefreshSessionParams{
+		RefreshToken: refreshCookie.Value,
+	})
+	if err != nil {
+		utils.LoggerWithContext(r.Context(), t.logger).
+			With(zap.Error(err)).
+			Debug("failed to refresh session transparently")
+		return
+	}
+
+	SetAuthCookie(w, t.authCookieName, output.Token, t.tokenExpiresInDuration)
+	SetAuthCookie(w, t.refreshCookieName, output.RefreshToken, t.refreshTokenExpiresInDuration)
+
+	cookieList := r.Cookies()
+	r.Header.Del("Cookie")
+	for _, cookie := range cookieList {
+		if cookie.Name != t.authCookieName && cookie.Name != t.refreshCookieName {
+			r.AddCookie(cookie)
+		}
+	}
+
+	r.AddCookie(&http.Cookie{Name: t.authCookieName, Value: output.Token})
+	r.AddCookie(&http.Cookie{Name: t.refreshCookieName, Value: output.RefreshToken})
+}
+
+// Handler refreshes sessions for the routes that are served directly instead of through the
+// gateway, such as file downloads.
+func (t TransparentSessionRefresher) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.refresh(w, r)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func WithTransparentSessionRefresh(transparentSessionRefresher TransparentSessionRefresher) runtime.ServeMuxOption {
+	return runtime.WithMiddlewares(func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			transparentSessionRefresher.refresh(w, r)
+			next(w, r, pathParams)
+		}
+	})
+}
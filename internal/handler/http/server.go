@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -10,7 +11,10 @@ import (
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
 	handlerGPRC "github.com/hoangdv99/morgana/internal/handler/grpc"
 	"github.com/hoangdv99/morgana/internal/handler/http/servemuxoptions"
+	"github.com/hoangdv99/morgana/internal/logic"
 	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -19,6 +23,8 @@ import (
 const (
 	//nolint:gosec // This is just to specify the cookie name
 	AuthTokenCookieName = "MORGANA_AUTH"
+	//nolint:gosec // This is just to specify the cookie name
+	RefreshTokenCookieName = "MORGANA_REFRESH"
 )
 
 type Server interface {
@@ -26,45 +32,109 @@ type Server interface {
 }
 
 type server struct {
-	grpcConfig configs.GRPC
-	httpConfig configs.HTTP
-	authConfig configs.Auth
-	logger     *zap.Logger
+	accountLogic      logic.Account
+	downloadTaskLogic logic.DownloadTask
+	tokenLogic        logic.Token
+	grpcConfig        configs.GRPC
+	httpConfig        configs.HTTP
+	authConfig        configs.Auth
+	shutdownConfig    configs.Shutdown
+	tracerProvider    trace.TracerProvider
+	oidcClient        *oidcClient
+	logger            *zap.Logger
 }
 
 func NewServer(
+	accountLogic logic.Account,
+	downloadTaskLogic logic.DownloadTask,
+	tokenLogic logic.Token,
 	grpcConfig configs.GRPC,
 	httpConfig configs.HTTP,
 	authConfig configs.Auth,
+	shutdownConfig configs.Shutdown,
+	tracerProvider trace.TracerProvider,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		grpcConfig: grpcConfig,
-		httpConfig: httpConfig,
-		authConfig: authConfig,
-		logger:     logger,
+		accountLogic:      accountLogic,
+		downloadTaskLogic: downloadTaskLogic,
+		tokenLogic:        tokenLogic,
+		grpcConfig:        grpcConfig,
+		httpConfig:        httpConfig,
+		authConfig:        authConfig,
+		shutdownConfig:    shutdownConfig,
+		tracerProvider:    tracerProvider,
+		oidcClient:        newOIDCClient(authConfig.OIDC),
+		logger:            logger,
+	}
+}
+
+func (s server) getTransparentSessionRefresher() (servemuxoptions.TransparentSessionRefresher, error) {
+	tokenExpiresInDuration, err := s.authConfig.Token.GetExpiresInDuration()
+	if err != nil {
+		return servemuxoptions.TransparentSessionRefresher{}, err
+	}
+
+	regenerateTokenBeforeExpiryDuration, err := s.authConfig.Token.GetRegenerateTokenBeforeExpiryDuration()
+	if err != nil {
+		return servemuxoptions.TransparentSessionRefresher{}, err
+	}
+
+	refreshTokenExpiresInDuration, err := s.authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		return servemuxoptions.TransparentSessionRefresher{}, err
 	}
+
+	return servemuxoptions.NewTransparentSessionRefresher(
+		AuthTokenCookieName,
+		RefreshTokenCookieName,
+		s.tokenLogic,
+		s.accountLogic,
+		regenerateTokenBeforeExpiryDuration,
+		tokenExpiresInDuration,
+		refreshTokenExpiresInDuration,
+		s.logger,
+	), nil
 }
 
-func (s server) getGRPCGatewayHandler(ctx context.Context) (http.Handler, error) {
+func (s server) getGRPCGatewayHandler(
+	ctx context.Context,
+	transparentSessionRefresher servemuxoptions.TransparentSessionRefresher,
+) (http.Handler, error) {
 	tokenExpiresInDuration, err := s.authConfig.Token.GetExpiresInDuration()
 	if err != nil {
 		return nil, err
 	}
 
+	refreshTokenExpiresInDuration, err := s.authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		return nil, err
+	}
+
 	grpcMux := runtime.NewServeMux(
+		servemuxoptions.WithTransparentSessionRefresh(transparentSessionRefresher),
 		servemuxoptions.WithAuthCookieToAuthMetadata(AuthTokenCookieName, handlerGPRC.AuthTokenMetadataName),
+		servemuxoptions.WithAuthCookieToAuthMetadata(RefreshTokenCookieName, handlerGPRC.RefreshTokenMetadataName),
 		servemuxoptions.WithAuthMetadataToAuthCookie(handlerGPRC.AuthTokenMetadataName, AuthTokenCookieName, tokenExpiresInDuration),
-		servemuxoptions.WithRemoveGoAuthMetadata(handlerGPRC.AuthTokenMetadataName),
-	)
-	err = morgana.RegisterMorganaServiceHandlerFromEndpoint(
-		ctx,
-		grpcMux,
-		s.grpcConfig.Address,
-		[]grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		},
+		servemuxoptions.WithAuthMetadataToAuthCookie(
+			handlerGPRC.RefreshTokenMetadataName,
+			RefreshTokenCookieName,
+			refreshTokenExpiresInDuration,
+		),
+		servemuxoptions.WithRemoveGoAuthMetadata(handlerGPRC.AuthTokenMetadataName, handlerGPRC.RefreshTokenMetadataName),
+		runtime.WithMetadata(requestIDToMetadata),
 	)
+	dialOptionList := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithTracerProvider(s.tracerProvider))),
+	}
+
+	err = morgana.RegisterMorganaServiceHandlerFromEndpoint(ctx, grpcMux, s.grpcConfig.Address, dialOptionList)
+	if err != nil {
+		return nil, err
+	}
+
+	err = morgana.RegisterAdminServiceHandlerFromEndpoint(ctx, grpcMux, s.grpcConfig.Address, dialOptionList)
 	if err != nil {
 		return nil, err
 	}
@@ -72,21 +142,81 @@ func (s server) getGRPCGatewayHandler(ctx context.Context) (http.Handler, error)
 	return grpcMux, nil
 }
 
+// stop stops the server from accepting new requests, and waits for the in-flight ones to finish,
+// up to the shutdown timeout, before closing their connections.
+func (s server) stop(ctx context.Context, httpServer *http.Server, shutdownTimeout time.Duration) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.With(zap.Error(err)).Warn("HTTP server did not stop before the shutdown timeout, stopping it forcefully")
+		httpServer.Close()
+		return
+	}
+
+	logger.Info("HTTP server stopped")
+}
+
+// Start serves requests until ctx is canceled, then stops the server gracefully.
 func (s server) Start(ctx context.Context) error {
 	logger := utils.LoggerWithContext(ctx, s.logger)
 
-	grpcGatewayHandler, err := s.getGRPCGatewayHandler(ctx)
+	shutdownTimeout, err := s.shutdownConfig.GetTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse shutdown timeout")
+		return err
+	}
+
+	// The connection of the gateway to the gRPC server is closed with this context, so it has to
+	// outlive ctx until the requests in flight when the server is stopped are finished.
+	grpcGatewayCtx, cancelGRPCGateway := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelGRPCGateway()
+
+	transparentSessionRefresher, err := s.getTransparentSessionRefresher()
 	if err != nil {
 		return err
 	}
 
+	grpcGatewayHandler, err := s.getGRPCGatewayHandler(grpcGatewayCtx, transparentSessionRefresher)
+	if err != nil {
+		return err
+	}
+
+	httpServeMux := http.NewServeMux()
+	handle := func(pattern string, handler http.Handler) {
+		httpServeMux.Handle(pattern, withMetrics(pattern, s.withTracing(pattern, withRequestID(handler))))
+	}
+
+	handle(downloadTaskFilePathPattern, transparentSessionRefresher.Handler(http.HandlerFunc(s.getDownloadTaskFile)))
+	handle(jsonWebKeySetPathPattern, http.HandlerFunc(s.getJSONWebKeySet))
+	if s.authConfig.OIDC.Enabled {
+		handle(oidcLoginPathPattern, http.HandlerFunc(s.startOIDCLogin))
+		handle(oidcCallbackPathPattern, http.HandlerFunc(s.finishOIDCLogin))
+	}
+	handle("/", grpcGatewayHandler)
+
 	httpServer := http.Server{
 		Addr:              s.httpConfig.Address,
-		Handler:           grpcGatewayHandler,
+		Handler:           httpServeMux,
 		ReadHeaderTimeout: time.Minute,
 	}
 
 	logger.With(zap.String("address", s.httpConfig.Address)).Info("starting HTTP server")
 
-	return httpServer.ListenAndServe()
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		s.stop(ctx, &httpServer, shutdownTimeout)
+	}()
+
+	err = httpServer.ListenAndServe()
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	<-stopped
+	return nil
 }
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	handlerGPRC "github.com/hoangdv99/morgana/internal/handler/grpc"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	RequestIDHeaderName = "X-Request-Id"
+)
+
+// withRequestID marks the context of requests with the request ID sent by the client, or a new
+// one, and sends it back in the response header.
+func withRequestID(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeaderName)
+		if requestID == "" {
+			requestID = utils.NewRequestID()
+		}
+
+		w.Header().Set(RequestIDHeaderName, requestID)
+		handler.ServeHTTP(w, r.WithContext(utils.WithRequestID(r.Context(), requestID)))
+	})
+}
+
+// withTracing starts a span for the requests handled by handler, named after the pattern it is
+// registered with, continuing the trace of the client if it sent one.
+func (s server) withTracing(pattern string, handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, pattern, otelhttp.WithTracerProvider(s.tracerProvider))
+}
+
+// requestIDToMetadata forwards the request ID to the gRPC server, so that both share it.
+func requestIDToMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	return metadata.Pairs(handlerGPRC.RequestIDMetadataName, utils.GetRequestID(ctx))
+}
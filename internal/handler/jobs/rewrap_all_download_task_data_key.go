@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/hoangdv99/morgana/internal/logic"
+)
+
+type RewrapAllDownloadTaskDataKey interface {
+	Run(ctx context.Context) error
+}
+
+type rewrapAllDownloadTaskDataKey struct {
+	downloadTaskLogic logic.DownloadTask
+}
+
+func NewRewrapAllDownloadTaskDataKey(
+	downloadTaskLogic logic.DownloadTask,
+) RewrapAllDownloadTaskDataKey {
+	return &rewrapAllDownloadTaskDataKey{
+		downloadTaskLogic: downloadTaskLogic,
+	}
+}
+
+func (r rewrapAllDownloadTaskDataKey) Run(ctx context.Context) error {
+	return r.downloadTaskLogic.RewrapAllDownloadTaskDataKey(ctx)
+}
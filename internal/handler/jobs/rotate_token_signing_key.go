@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/hoangdv99/morgana/internal/logic"
+)
+
+type RotateTokenSigningKey interface {
+	Run(ctx context.Context) error
+}
+
+type rotateTokenSigningKey struct {
+	tokenLogic logic.Token
+}
+
+func NewRotateTokenSigningKey(
+	tokenLogic logic.Token,
+) RotateTokenSigningKey {
+	return &rotateTokenSigningKey{
+		tokenLogic: tokenLogic,
+	}
+}
+
+func (r rotateTokenSigningKey) Run(ctx context.Context) error {
+	return r.tokenLogic.RotateSigningKey(ctx)
+}
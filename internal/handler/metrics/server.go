@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const (
+	metricsPath = "/metrics"
+)
+
+// Server exposes the Prometheus metrics of the process. It is run by every role, on its own
+// address, so that workers and schedulers can be scraped even though they serve no API.
+type Server interface {
+	Start(ctx context.Context) error
+}
+
+type server struct {
+	metricsConfig  configs.Metrics
+	shutdownConfig configs.Shutdown
+	logger         *zap.Logger
+}
+
+func NewServer(
+	metricsConfig configs.Metrics,
+	shutdownConfig configs.Shutdown,
+	logger *zap.Logger,
+) Server {
+	return &server{
+		metricsConfig:  metricsConfig,
+		shutdownConfig: shutdownConfig,
+		logger:         logger,
+	}
+}
+
+// Start serves the metrics until ctx is canceled. It returns right away if metrics are disabled.
+func (s server) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	if !s.metricsConfig.Enabled {
+		return nil
+	}
+
+	shutdownTimeout, err := s.shutdownConfig.GetTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse shutdown timeout")
+		return err
+	}
+
+	httpServeMux := http.NewServeMux()
+	httpServeMux.Handle(metricsPath, promhttp.Handler())
+
+	httpServer := http.Server{
+		Addr:              s.metricsConfig.Address,
+		Handler:           httpServeMux,
+		ReadHeaderTimeout: time.Minute,
+	}
+
+	logger.With(zap.String("address", s.metricsConfig.Address)).Info("starting metrics server")
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.With(zap.Error(err)).Warn("metrics server did not stop before the shutdown timeout, stopping it forcefully")
+			httpServer.Close()
+		}
+	}()
+
+	err = httpServer.ListenAndServe()
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	<-stopped
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hoangdv99/morgana/internal/handler/grpc"
 	"github.com/hoangdv99/morgana/internal/handler/http"
 	"github.com/hoangdv99/morgana/internal/handler/jobs"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
 )
 
 var WireSet = wire.NewSet(
@@ -13,4 +14,5 @@ var WireSet = wire.NewSet(
 	http.WireSet,
 	consumers.WireSet,
 	jobs.WireSet,
+	metrics.WireSet,
 )
@@ -2,21 +2,59 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
 	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
 	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/dataaccess/notifier"
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
 	"github.com/hoangdv99/morgana/internal/utils"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	refreshTokenByteCount = 32
+	// refreshTokenReuseGracePeriod is how long a refresh token that was just used is still
+	// tolerated without being treated as stolen, so that concurrent refreshes, for example from
+	// several browser tabs, do not log the user out.
+	refreshTokenReuseGracePeriod     = 30 * time.Second
+	passwordResetTokenByteCount      = 32
+	twoFactorChallengeTokenByteCount = 32
+	// oidcAccountCreationAttemptCount is how many times the first login of an identity is tried
+	// when a concurrent login takes the account name or creates the identity first.
+	oidcAccountCreationAttemptCount = 3
+)
+
+var (
+	errInvalidRefreshToken       = status.Error(codes.Unauthenticated, "invalid refresh token")
+	errRefreshTokenReused        = status.Error(codes.Unauthenticated, "refresh token has already been used")
+	errIncorrectPassword         = status.Error(codes.Unauthenticated, "incorrect password")
+	errInvalidCredentials        = status.Error(codes.Unauthenticated, "invalid account name or password")
+	errAccountHasNoPassword      = status.Error(codes.FailedPrecondition, "account does not have a password")
+	errInvalidEmail              = status.Error(codes.InvalidArgument, "invalid email")
+	errInvalidPasswordResetToken = status.Error(codes.InvalidArgument, "invalid or expired password reset token")
+	errInvalidTwoFactorChallenge = status.Error(codes.Unauthenticated, "invalid or expired two-factor challenge token")
 )
 
 type CreateAccountParams struct {
 	AccountName string
 	Password    string
+	// Email is optional, but required to reset a forgotten password.
+	Email string
 }
 
 type CreateAccountOutput struct {
@@ -27,46 +65,184 @@ type CreateAccountOutput struct {
 type CreateSessionParams struct {
 	AccountName string
 	Password    string
+	// IPAddress is the address of the client, failed logins are throttled by it when it is set.
+	IPAddress string
+	// TwoFactorChallengeToken and TwoFactorCode complete a login that was answered with a
+	// two-factor challenge, the account name and the password are not needed then.
+	TwoFactorChallengeToken string
+	TwoFactorCode           string
 }
 
+// CreateSessionOutput only has the two-factor challenge set when the login still has to be
+// completed with a two-factor code.
 type CreateSessionOutput struct {
-	Account *morgana.Account
-	Token   string
+	Account                      *morgana.Account
+	Token                        string
+	TokenExpireTime              time.Time
+	RefreshToken                 string
+	RefreshTokenExpireTime       time.Time
+	TwoFactorChallengeToken      string
+	TwoFactorChallengeExpireTime time.Time
+}
+
+type CreateOIDCSessionParams struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+}
+
+type RefreshSessionParams struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	Token                  string
+	TokenExpireTime        time.Time
+	RefreshToken           string
+	RefreshTokenExpireTime time.Time
+}
+
+type DeleteSessionParams struct {
+	Token string
+}
+
+type ListSessionsParams struct {
+	Token string
+}
+
+type ListSessionsOutput struct {
+	SessionList []*morgana.Session
+}
+
+type RevokeSessionParams struct {
+	Token     string
+	SessionID uint64
+}
+
+type ChangePasswordParams struct {
+	Token           string
+	CurrentPassword string
+	NewPassword     string
+}
+
+type RequestPasswordResetParams struct {
+	AccountName string
+}
+
+type ResetPasswordParams struct {
+	PasswordResetToken string
+	NewPassword        string
 }
 
 type Account interface {
 	CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error)
+	CreateOIDCSession(ctx context.Context, params CreateOIDCSessionParams) (CreateSessionOutput, error)
+	RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error)
+	DeleteSession(ctx context.Context, params DeleteSessionParams) error
+	ListSessions(ctx context.Context, params ListSessionsParams) (ListSessionsOutput, error)
+	RevokeSession(ctx context.Context, params RevokeSessionParams) error
+	ChangePassword(ctx context.Context, params ChangePasswordParams) error
+	RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error
+	ResetPassword(ctx context.Context, params ResetPasswordParams) error
 }
 
 type account struct {
-	goquDatabase                *goqu.Database
-	takenAccountNameCache       cache.TakenAccountName
-	accountDataAccessor         database.AccountDataAccessor
-	accountPasswordDataAccessor database.AccountPasswordDataAccessor
-	hashLogic                   Hash
-	tokenLogic                  Token
-	logger                      *zap.Logger
+	goquDatabase                   *goqu.Database
+	takenAccountNameCache          cache.TakenAccountName
+	twoFactorChallengeCache        cache.TwoFactorChallenge
+	accountDataAccessor            database.AccountDataAccessor
+	accountPasswordDataAccessor    database.AccountPasswordDataAccessor
+	accountIdentityDataAccessor    database.AccountIdentityDataAccessor
+	sessionDataAccessor            database.SessionDataAccessor
+	refreshTokenDataAccessor       database.RefreshTokenDataAccessor
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor
+	notifier                       notifier.Notifier
+	hashLogic                      Hash
+	tokenLogic                     Token
+	passwordPolicyLogic            PasswordPolicy
+	loginThrottleLogic             LoginThrottle
+	twoFactorLogic                 TwoFactor
+	dummyPasswordHash              string
+	refreshTokenExpiresIn          time.Duration
+	passwordResetTokenExpiresIn    time.Duration
+	twoFactorChallengeExpiresIn    time.Duration
+	oidcConfig                     configs.OIDC
+	passwordResetConfig            configs.PasswordReset
+	logger                         *zap.Logger
 }
 
 func NewAccount(
 	goquDatabase *goqu.Database,
 	takenAccountNameCache cache.TakenAccountName,
+	twoFactorChallengeCache cache.TwoFactorChallenge,
 	accountDataAccessor database.AccountDataAccessor,
 	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	accountIdentityDataAccessor database.AccountIdentityDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	refreshTokenDataAccessor database.RefreshTokenDataAccessor,
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor,
+	notifier notifier.Notifier,
 	hashLogic Hash,
 	tokenLogic Token,
+	passwordPolicyLogic PasswordPolicy,
+	loginThrottleLogic LoginThrottle,
+	twoFactorLogic TwoFactor,
+	authConfig configs.Auth,
 	logger *zap.Logger,
-) Account {
-	return &account{
-		goquDatabase:                goquDatabase,
-		takenAccountNameCache:       takenAccountNameCache,
-		accountDataAccessor:         accountDataAccessor,
-		accountPasswordDataAccessor: accountPasswordDataAccessor,
-		hashLogic:                   hashLogic,
-		tokenLogic:                  tokenLogic,
-		logger:                      logger,
+) (Account, error) {
+	refreshTokenExpiresIn, err := authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get refresh token expires in duration")
+		return nil, err
 	}
+
+	passwordResetTokenExpiresIn, err := authConfig.PasswordReset.GetTokenExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get password reset token expires in duration")
+		return nil, err
+	}
+
+	twoFactorChallengeExpiresIn, err := authConfig.TwoFactor.GetChallengeExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get two-factor challenge expires in duration")
+		return nil, err
+	}
+
+	// Logins to accounts that do not exist, or that have no password, are checked against this
+	// hash, so that they take as long as logins with a wrong password.
+	dummyPasswordHash, err := hashLogic.Hash(context.Background(), "dummy password")
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to hash dummy password")
+		return nil, err
+	}
+
+	return &account{
+		goquDatabase:                   goquDatabase,
+		takenAccountNameCache:          takenAccountNameCache,
+		twoFactorChallengeCache:        twoFactorChallengeCache,
+		accountDataAccessor:            accountDataAccessor,
+		accountPasswordDataAccessor:    accountPasswordDataAccessor,
+		accountIdentityDataAccessor:    accountIdentityDataAccessor,
+		sessionDataAccessor:            sessionDataAccessor,
+		refreshTokenDataAccessor:       refreshTokenDataAccessor,
+		passwordResetTokenDataAccessor: passwordResetTokenDataAccessor,
+		notifier:                       notifier,
+		hashLogic:                      hashLogic,
+		tokenLogic:                     tokenLogic,
+		passwordPolicyLogic:            passwordPolicyLogic,
+		loginThrottleLogic:             loginThrottleLogic,
+		twoFactorLogic:                 twoFactorLogic,
+		dummyPasswordHash:              dummyPasswordHash,
+		refreshTokenExpiresIn:          refreshTokenExpiresIn,
+		passwordResetTokenExpiresIn:    passwordResetTokenExpiresIn,
+		twoFactorChallengeExpiresIn:    twoFactorChallengeExpiresIn,
+		oidcConfig:                     authConfig.OIDC,
+		passwordResetConfig:            authConfig.PasswordReset,
+		logger:                         logger,
+	}, nil
 }
 
 func (a account) isAccountNameTaken(ctx context.Context, accountName string) (bool, error) {
@@ -97,6 +273,21 @@ func (a account) isAccountNameTaken(ctx context.Context, accountName string) (bo
 }
 
 func (a account) CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error) {
+	err := a.passwordPolicyLogic.Check(ctx, params.Password)
+	if err != nil {
+		return CreateAccountOutput{}, err
+	}
+
+	email := sql.NullString{}
+	if params.Email != "" {
+		emailAddress, parseErr := mail.ParseAddress(params.Email)
+		if parseErr != nil {
+			return CreateAccountOutput{}, errInvalidEmail
+		}
+
+		email = sql.NullString{String: emailAddress.Address, Valid: true}
+	}
+
 	accountNameTaken, err := a.isAccountNameTaken(ctx, params.AccountName)
 	if err != nil {
 		return CreateAccountOutput{}, status.Error(codes.AlreadyExists, "account name already taken")
@@ -119,6 +310,7 @@ func (a account) CreateAccount(ctx context.Context, params CreateAccountParams)
 
 		accountID, err = a.accountDataAccessor.WithDatabase(td).CreateAccount(ctx, database.Account{
 			AccountName: params.AccountName,
+			Email:       email,
 		})
 
 		if err != nil {
@@ -158,33 +350,814 @@ func (a account) databaseAccountToProtoAccount(account database.Account) *morgan
 	}
 }
 
+// CreateSession logs in with a password. Unknown accounts, accounts without a password and wrong
+// passwords all fail the same way, so that the result does not tell which account names exist.
 func (a account) CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error) {
-	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+	if params.TwoFactorChallengeToken != "" {
+		return a.completeTwoFactorChallenge(ctx, params)
+	}
+
+	err := a.loginThrottleLogic.CheckLoginAllowed(ctx, params.AccountName, params.IPAddress)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
-	existingAccountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(ctx, existingAccount.ID)
+	existingAccount, passwordHash, err := a.getAccountAndPasswordHash(ctx, params.AccountName)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
-	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, params.Password, existingAccountPassword.Hash)
+	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, params.Password, passwordHash)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
-	if !isHashEqual {
-		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "incorrect password")
+	if !isHashEqual || existingAccount.ID == 0 {
+		a.loginThrottleLogic.RecordLoginFailure(ctx, params.AccountName, params.IPAddress)
+		return CreateSessionOutput{}, errInvalidCredentials
+	}
+
+	a.rehashPasswordIfNeeded(ctx, existingAccount.ID, params.Password, passwordHash)
+
+	isTwoFactorEnabled, err := a.twoFactorLogic.IsEnabled(ctx, existingAccount.ID)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	if isTwoFactorEnabled && !existingAccount.DisabledAt.Valid {
+		return a.createTwoFactorChallenge(ctx, existingAccount)
+	}
+
+	a.loginThrottleLogic.RecordLoginSuccess(ctx, params.AccountName)
+	return a.createSessionOfAccount(ctx, existingAccount)
+}
+
+// rehashPasswordIfNeeded replaces a password hash made with an outdated algorithm or outdated
+// parameters, now that the password is known. The hash is left alone if it changed in the
+// meantime, and failing to replace it does not fail the login.
+func (a account) rehashPasswordIfNeeded(ctx context.Context, accountID uint64, password, passwordHash string) {
+	if !a.hashLogic.NeedsRehash(ctx, passwordHash) {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	newPasswordHash, err := a.hashLogic.Hash(ctx, password)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to rehash password")
+		return
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountPassword, err := a.accountPasswordDataAccessor.WithDatabase(td).GetAccountPasswordWithXLock(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if accountPassword.Hash != passwordHash {
+			return nil
+		}
+
+		accountPassword.Hash = newPasswordHash
+		return a.accountPasswordDataAccessor.WithDatabase(td).UpdateAccountPassword(ctx, accountPassword)
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Warn("failed to update rehashed password")
+		return
+	}
+
+	logger.Info("password rehashed")
+}
+
+func (a account) createTwoFactorChallenge(ctx context.Context, existingAccount database.Account) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	challengeTokenBytes := make([]byte, twoFactorChallengeTokenByteCount)
+	if _, err := rand.Read(challengeTokenBytes); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate two-factor challenge token")
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to generate two-factor challenge token")
+	}
+
+	challengeToken := base64.RawURLEncoding.EncodeToString(challengeTokenBytes)
+	err := a.twoFactorChallengeCache.Add(ctx, hashRandomToken(challengeToken), existingAccount.ID, a.twoFactorChallengeExpiresIn)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	return CreateSessionOutput{
+		TwoFactorChallengeToken:      challengeToken,
+		TwoFactorChallengeExpireTime: time.Now().Add(a.twoFactorChallengeExpiresIn),
+	}, nil
+}
+
+// completeTwoFactorChallenge is the second step of a login to an account with two-factor
+// authentication. Wrong codes count as failed logins, and a challenge can only be completed once.
+func (a account) completeTwoFactorChallenge(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error) {
+	challengeTokenHash := hashRandomToken(params.TwoFactorChallengeToken)
+	accountID, err := a.twoFactorChallengeCache.Get(ctx, challengeTokenHash)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return CreateSessionOutput{}, errInvalidTwoFactorChallenge
+		}
+		return CreateSessionOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	err = a.loginThrottleLogic.CheckLoginAllowed(ctx, existingAccount.AccountName, params.IPAddress)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	isCodeValid, err := a.twoFactorLogic.VerifyCode(ctx, existingAccount.ID, params.TwoFactorCode)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	if !isCodeValid {
+		a.loginThrottleLogic.RecordLoginFailure(ctx, existingAccount.AccountName, params.IPAddress)
+		return CreateSessionOutput{}, errInvalidTwoFactorCode
 	}
 
-	token, _, err := a.tokenLogic.GetToken(ctx, existingAccount.ID)
+	err = a.twoFactorChallengeCache.Delete(ctx, challengeTokenHash)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	a.loginThrottleLogic.RecordLoginSuccess(ctx, existingAccount.AccountName)
+	return a.createSessionOfAccount(ctx, existingAccount)
+}
+
+// getAccountAndPasswordHash returns an empty account and the dummy password hash when the account
+// does not exist or has no password.
+func (a account) getAccountAndPasswordHash(ctx context.Context, accountName string) (database.Account, string, error) {
+	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, accountName)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			return database.Account{}, a.dummyPasswordHash, nil
+		}
+		return database.Account{}, "", err
+	}
+
+	existingAccountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(ctx, existingAccount.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return database.Account{}, a.dummyPasswordHash, nil
+		}
+		return database.Account{}, "", err
+	}
+
+	return existingAccount, existingAccountPassword.Hash, nil
+}
+
+func (a account) createSessionOfAccount(ctx context.Context, existingAccount database.Account) (CreateSessionOutput, error) {
+	if existingAccount.DisabledAt.Valid {
+		return CreateSessionOutput{}, errAccountDisabled
+	}
+
+	refreshToken, refreshTokenHash, err := a.generateRefreshToken(ctx)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	refreshTokenExpireTime := time.Now().Add(a.refreshTokenExpiresIn)
+	var sessionID uint64
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		sessionID, err = a.sessionDataAccessor.WithDatabase(td).CreateSession(ctx, database.Session{
+			AccountID:   existingAccount.ID,
+			RefreshedAt: time.Now(),
+			ExpiresAt:   refreshTokenExpireTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		return a.refreshTokenDataAccessor.WithDatabase(td).CreateRefreshToken(ctx, database.RefreshToken{
+			TokenHash: refreshTokenHash,
+			SessionID: sessionID,
+		})
+	})
+	if txErr != nil {
+		return CreateSessionOutput{}, txErr
+	}
+
+	token, tokenExpireTime, err := a.tokenLogic.GetToken(ctx, existingAccount.ID, sessionID)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
 	return CreateSessionOutput{
-		Account: a.databaseAccountToProtoAccount(existingAccount),
-		Token:   token,
+		Account:                a.databaseAccountToProtoAccount(existingAccount),
+		Token:                  token,
+		TokenExpireTime:        tokenExpireTime,
+		RefreshToken:           refreshToken,
+		RefreshTokenExpireTime: refreshTokenExpireTime,
 	}, nil
 }
+
+// getOIDCAccountName picks the name of an account created for an OIDC identity. The subject is
+// the only claim guaranteed to be present, but it is usually not readable, so it is only used when
+// the provider shares neither a username nor an email, or to tell apart identities that would
+// otherwise get the name of an existing account on later attempts.
+func (a account) getOIDCAccountName(params CreateOIDCSessionParams, attempt int) string {
+	identityHash := sha256.Sum256([]byte(params.Issuer + "\x00" + params.Subject))
+	identitySuffix := hex.EncodeToString(identityHash[:])[:8]
+
+	accountName := params.PreferredUsername
+	if accountName == "" {
+		accountName = params.Email
+	}
+
+	if accountName == "" {
+		accountName = "oidc"
+		attempt++
+	}
+
+	switch attempt {
+	case 0:
+		return accountName
+	case 1:
+		return accountName + "-" + identitySuffix
+	default:
+		return fmt.Sprintf("%s-%s-%d", accountName, identitySuffix, attempt)
+	}
+}
+
+// getOIDCVerifiedEmail returns the normalized email of an identity if its provider verified it,
+// and an empty string otherwise.
+func (a account) getOIDCVerifiedEmail(params CreateOIDCSessionParams) string {
+	if params.Email == "" || !params.EmailVerified {
+		return ""
+	}
+
+	emailAddress, err := mail.ParseAddress(params.Email)
+	if err != nil {
+		return ""
+	}
+
+	return emailAddress.Address
+}
+
+// getOrCreateOIDCAccount returns the account linked to an OIDC identity, linking it first to the
+// only account with the same verified email, or to a new account. Only emails verified by Morgana
+// are matched, since anyone can register an account with the email, or under the name, of
+// somebody else.
+func (a account) getOrCreateOIDCAccount(
+	ctx context.Context,
+	params CreateOIDCSessionParams,
+	attempt int,
+) (database.Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("issuer", params.Issuer)).
+		With(zap.String("subject", params.Subject))
+
+	accountIdentity, err := a.accountIdentityDataAccessor.GetAccountIdentity(ctx, params.Issuer, params.Subject)
+	if err == nil {
+		return a.accountDataAccessor.GetAccountByID(ctx, accountIdentity.AccountID)
+	}
+
+	if !errors.Is(err, database.ErrAccountIdentityNotFound) {
+		return database.Account{}, err
+	}
+
+	verifiedEmail := a.getOIDCVerifiedEmail(params)
+
+	var existingAccount database.Account
+	if a.oidcConfig.LinkAccountsByVerifiedEmail && verifiedEmail != "" {
+		accountList, err := a.accountDataAccessor.GetAccountListByVerifiedEmail(ctx, verifiedEmail)
+		if err != nil {
+			return database.Account{}, err
+		}
+
+		switch {
+		case len(accountList) == 1:
+			existingAccount = accountList[0]
+		case len(accountList) > 1:
+			logger.Warn("several accounts have the verified email of the oidc identity, not linking any of them")
+		}
+	}
+
+	if existingAccount.ID == 0 {
+		existingAccount.AccountName = a.getOIDCAccountName(params, attempt)
+		if verifiedEmail != "" {
+			existingAccount.Email = sql.NullString{String: verifiedEmail, Valid: true}
+			existingAccount.EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		}
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if existingAccount.ID == 0 {
+			existingAccount.ID, err = a.accountDataAccessor.WithDatabase(td).CreateAccount(ctx, existingAccount)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = a.accountIdentityDataAccessor.WithDatabase(td).CreateAccountIdentity(ctx, database.AccountIdentity{
+			AccountID: existingAccount.ID,
+			Issuer:    params.Issuer,
+			Subject:   params.Subject,
+		})
+		return err
+	})
+	if txErr != nil {
+		return database.Account{}, txErr
+	}
+
+	logger.
+		With(zap.Uint64("account_id", existingAccount.ID)).
+		With(zap.String("account_name", existingAccount.AccountName)).
+		Info("linked oidc identity to account")
+
+	err = a.takenAccountNameCache.Add(ctx, existingAccount.AccountName)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to add account name to taken account names cache")
+	}
+
+	return existingAccount, nil
+}
+
+// linkOIDCIdentity returns the account of an OIDC identity. Account names are unique in the
+// database, so when concurrent first logins take the same account name, or link the same identity,
+// the ones that lose are tried again.
+func (a account) linkOIDCIdentity(ctx context.Context, params CreateOIDCSessionParams) (database.Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("issuer", params.Issuer)).
+		With(zap.String("subject", params.Subject))
+
+	for attempt := 0; ; attempt++ {
+		existingAccount, err := a.getOrCreateOIDCAccount(ctx, params, attempt)
+		if err == nil {
+			return existingAccount, nil
+		}
+
+		isConflict := errors.Is(err, database.ErrAccountNameTaken) || errors.Is(err, database.ErrAccountIdentityAlreadyExists)
+		if !isConflict || attempt+1 >= oidcAccountCreationAttemptCount {
+			return database.Account{}, err
+		}
+
+		logger.With(zap.Error(err)).With(zap.Int("attempt", attempt)).Info("retrying conflicting oidc login")
+	}
+}
+
+// CreateOIDCSession logs in with an identity of an OIDC provider. Like password logins, logins to
+// accounts with two-factor authentication are answered with a two-factor challenge, which is
+// completed with CreateSession.
+func (a account) CreateOIDCSession(ctx context.Context, params CreateOIDCSessionParams) (CreateSessionOutput, error) {
+	existingAccount, err := a.linkOIDCIdentity(ctx, params)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	isTwoFactorEnabled, err := a.twoFactorLogic.IsEnabled(ctx, existingAccount.ID)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	if isTwoFactorEnabled && !existingAccount.DisabledAt.Valid {
+		return a.createTwoFactorChallenge(ctx, existingAccount)
+	}
+
+	return a.createSessionOfAccount(ctx, existingAccount)
+}
+
+func (a account) generateRefreshToken(ctx context.Context) (string, string, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	refreshTokenBytes := make([]byte, refreshTokenByteCount)
+	if _, err := rand.Read(refreshTokenBytes); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate refresh token")
+		return "", "", status.Error(codes.Internal, "failed to generate refresh token")
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(refreshTokenBytes)
+	return refreshToken, hashRandomToken(refreshToken), nil
+}
+
+// Refresh tokens, password reset tokens and the other random tokens are random enough for a plain
+// sha256 to be safe to store, unlike passwords.
+func hashRandomToken(randomToken string) string {
+	randomTokenHash := sha256.Sum256([]byte(randomToken))
+	return hex.EncodeToString(randomTokenHash[:])
+}
+
+func (a account) RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	if params.RefreshToken == "" {
+		return RefreshSessionOutput{}, errInvalidRefreshToken
+	}
+
+	newRefreshToken, newRefreshTokenHash, err := a.generateRefreshToken(ctx)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	var (
+		session                database.Session
+		reuseDetected          bool
+		refreshTokenExpireTime = time.Now().Add(a.refreshTokenExpiresIn)
+	)
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		refreshToken, err := a.refreshTokenDataAccessor.WithDatabase(td).
+			GetRefreshTokenWithXLock(ctx, hashRandomToken(params.RefreshToken))
+		if err != nil {
+			if errors.Is(err, database.ErrRefreshTokenNotFound) {
+				return errInvalidRefreshToken
+			}
+			return err
+		}
+
+		session, err = a.sessionDataAccessor.WithDatabase(td).GetSessionWithXLock(ctx, refreshToken.SessionID)
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return errInvalidRefreshToken
+			}
+			return err
+		}
+
+		if !session.ExpiresAt.After(time.Now()) {
+			return errInvalidRefreshToken
+		}
+
+		if refreshToken.UsedAt.Valid {
+			if time.Since(refreshToken.UsedAt.Time) < refreshTokenReuseGracePeriod {
+				return errRefreshTokenReused
+			}
+
+			// A refresh token used again long after it was rotated means that it was most likely
+			// stolen, and there is no way to tell which party is the legitimate one, so the whole
+			// session is ended. The deletion has to be committed, so this is not returned as an
+			// error from the transaction.
+			reuseDetected = true
+			return a.sessionDataAccessor.WithDatabase(td).DeleteSession(ctx, session.ID)
+		}
+
+		refreshToken.UsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		err = a.refreshTokenDataAccessor.WithDatabase(td).UpdateRefreshToken(ctx, refreshToken)
+		if err != nil {
+			return err
+		}
+
+		err = a.refreshTokenDataAccessor.WithDatabase(td).CreateRefreshToken(ctx, database.RefreshToken{
+			TokenHash: newRefreshTokenHash,
+			SessionID: session.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		session.RefreshedAt = time.Now()
+		session.ExpiresAt = refreshTokenExpireTime
+		return a.sessionDataAccessor.WithDatabase(td).UpdateSession(ctx, session)
+	})
+	if txErr != nil {
+		return RefreshSessionOutput{}, txErr
+	}
+
+	if reuseDetected {
+		logger.
+			With(zap.Uint64("account_id", session.AccountID)).
+			With(zap.Uint64("session_id", session.ID)).
+			Warn("refresh token reuse detected, session revoked")
+		if err := a.tokenLogic.RevokeSession(ctx, session.ID); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to add session to revoked sessions")
+		}
+
+		return RefreshSessionOutput{}, errInvalidRefreshToken
+	}
+
+	token, tokenExpireTime, err := a.tokenLogic.GetToken(ctx, session.AccountID, session.ID)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	return RefreshSessionOutput{
+		Token:                  token,
+		TokenExpireTime:        tokenExpireTime,
+		RefreshToken:           newRefreshToken,
+		RefreshTokenExpireTime: refreshTokenExpireTime,
+	}, nil
+}
+
+func (a account) deleteAndRevokeSession(ctx context.Context, sessionID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("session_id", sessionID))
+
+	err := a.sessionDataAccessor.DeleteSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	// Access tokens are still checked against the database when the revoked sessions cache is
+	// not available, so failing to update it is not fatal.
+	err = a.tokenLogic.RevokeSession(ctx, sessionID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to add session to revoked sessions")
+	}
+
+	return nil
+}
+
+func (a account) DeleteSession(ctx context.Context, params DeleteSessionParams) error {
+	tokenClaims, err := a.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	return a.deleteAndRevokeSession(ctx, tokenClaims.SessionID)
+}
+
+func (a account) databaseSessionToProtoSession(session database.Session, currentSessionID uint64) *morgana.Session {
+	return &morgana.Session{
+		Id:          session.ID,
+		CreatedAt:   timestamppb.New(session.CreatedAt),
+		RefreshedAt: timestamppb.New(session.RefreshedAt),
+		ExpiresAt:   timestamppb.New(session.ExpiresAt),
+		Current:     session.ID == currentSessionID,
+	}
+}
+
+func (a account) ListSessions(ctx context.Context, params ListSessionsParams) (ListSessionsOutput, error) {
+	tokenClaims, err := a.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return ListSessionsOutput{}, err
+	}
+
+	sessionList, err := a.sessionDataAccessor.GetUnexpiredSessionListOfAccount(ctx, tokenClaims.AccountID)
+	if err != nil {
+		return ListSessionsOutput{}, err
+	}
+
+	protoSessionList := make([]*morgana.Session, 0, len(sessionList))
+	for _, session := range sessionList {
+		protoSessionList = append(protoSessionList, a.databaseSessionToProtoSession(session, tokenClaims.SessionID))
+	}
+
+	return ListSessionsOutput{
+		SessionList: protoSessionList,
+	}, nil
+}
+
+func (a account) RevokeSession(ctx context.Context, params RevokeSessionParams) error {
+	tokenClaims, err := a.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	session, err := a.sessionDataAccessor.GetSession(ctx, params.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.AccountID != tokenClaims.AccountID {
+		return database.ErrSessionNotFound
+	}
+
+	return a.deleteAndRevokeSession(ctx, session.ID)
+}
+
+// deleteAndRevokeSessionListOfAccount ends all the sessions of an account but the given one, which
+// can be zero to end them all.
+func (a account) deleteAndRevokeSessionListOfAccount(ctx context.Context, accountID, keptSessionID uint64) error {
+	sessionList, err := a.sessionDataAccessor.GetUnexpiredSessionListOfAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessionList {
+		if session.ID == keptSessionID {
+			continue
+		}
+
+		err = a.deleteAndRevokeSession(ctx, session.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChangePassword requires a session rather than an API key, and ends every other session of the
+// account, so that changing a password that leaked also logs out whoever used it.
+func (a account) ChangePassword(ctx context.Context, params ChangePasswordParams) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenClaims, err := a.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	accountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(ctx, tokenClaims.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errAccountHasNoPassword
+		}
+		return err
+	}
+
+	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, params.CurrentPassword, accountPassword.Hash)
+	if err != nil {
+		return err
+	}
+
+	if !isHashEqual {
+		return errIncorrectPassword
+	}
+
+	err = a.passwordPolicyLogic.Check(ctx, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	accountPassword.Hash, err = a.hashLogic.Hash(ctx, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = a.accountPasswordDataAccessor.UpdateAccountPassword(ctx, accountPassword)
+	if err != nil {
+		return err
+	}
+
+	err = a.deleteAndRevokeSessionListOfAccount(ctx, tokenClaims.AccountID, tokenClaims.SessionID)
+	if err != nil {
+		return err
+	}
+
+	logger.With(zap.Uint64("account_id", tokenClaims.AccountID)).Info("account password changed")
+
+	return nil
+}
+
+func (a account) getPasswordResetURL(passwordResetToken string) (string, error) {
+	passwordResetURL, err := url.Parse(a.passwordResetConfig.URL)
+	if err != nil {
+		return "", err
+	}
+
+	query := passwordResetURL.Query()
+	query.Set("token", passwordResetToken)
+	passwordResetURL.RawQuery = query.Encode()
+
+	return passwordResetURL.String(), nil
+}
+
+// RequestPasswordReset sends a password reset link to the email of an account. It succeeds
+// whether or not the account exists and has an email, so that it cannot be used to find out which
+// account names are registered.
+func (a account) RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", params.AccountName))
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			logger.Info("password reset requested for an account that does not exist")
+			return nil
+		}
+		return err
+	}
+
+	if !existingAccount.Email.Valid || existingAccount.DisabledAt.Valid {
+		logger.Info("password reset requested for an account that cannot reset its password")
+		return nil
+	}
+
+	passwordResetTokenBytes := make([]byte, passwordResetTokenByteCount)
+	if _, err = rand.Read(passwordResetTokenBytes); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate password reset token")
+		return status.Error(codes.Internal, "failed to generate password reset token")
+	}
+
+	passwordResetToken := base64.RawURLEncoding.EncodeToString(passwordResetTokenBytes)
+	passwordResetURL, err := a.getPasswordResetURL(passwordResetToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to build password reset url")
+		return status.Error(codes.Internal, "failed to build password reset url")
+	}
+
+	err = a.passwordResetTokenDataAccessor.CreatePasswordResetToken(ctx, database.PasswordResetToken{
+		TokenHash: hashRandomToken(passwordResetToken),
+		AccountID: existingAccount.ID,
+		ExpiresAt: time.Now().Add(a.passwordResetTokenExpiresIn),
+	})
+	if err != nil {
+		return err
+	}
+
+	// A failure to send the email is not returned either, since only existing accounts with an
+	// email get that far.
+	err = a.notifier.Notify(ctx, notifier.Message{
+		Recipient: existingAccount.Email.String,
+		Subject:   "Reset your Morgana password",
+		Body: fmt.Sprintf(
+			"A password reset was requested for the account %s.\n\n"+
+				"Open the following link within %s to choose a new password:\n\n%s\n\n"+
+				"If you did not request it, you can ignore this email.\n",
+			existingAccount.AccountName,
+			a.passwordResetTokenExpiresIn,
+			passwordResetURL,
+		),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to send password reset email")
+	}
+
+	return nil
+}
+
+func (a account) markAccountEmailVerified(ctx context.Context, td *goqu.TxDatabase, accountID uint64) error {
+	existingAccount, err := a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if !existingAccount.Email.Valid || existingAccount.EmailVerifiedAt.Valid {
+		return nil
+	}
+
+	existingAccount.EmailVerifiedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, existingAccount)
+}
+
+// ResetPassword sets a new password with a token sent by RequestPasswordReset. Every session of
+// the account is ended, since whoever the password is reset because of may be logged in.
+func (a account) ResetPassword(ctx context.Context, params ResetPasswordParams) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	if params.PasswordResetToken == "" {
+		return errInvalidPasswordResetToken
+	}
+
+	err := a.passwordPolicyLogic.Check(ctx, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := a.hashLogic.Hash(ctx, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	var passwordResetToken database.PasswordResetToken
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		passwordResetToken, err = a.passwordResetTokenDataAccessor.WithDatabase(td).
+			GetPasswordResetTokenWithXLock(ctx, hashRandomToken(params.PasswordResetToken))
+		if err != nil {
+			if errors.Is(err, database.ErrPasswordResetTokenNotFound) {
+				return errInvalidPasswordResetToken
+			}
+			return err
+		}
+
+		if passwordResetToken.UsedAt.Valid || !passwordResetToken.ExpiresAt.After(time.Now()) {
+			return errInvalidPasswordResetToken
+		}
+
+		passwordResetToken.UsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		err = a.passwordResetTokenDataAccessor.WithDatabase(td).UpdatePasswordResetToken(ctx, passwordResetToken)
+		if err != nil {
+			return err
+		}
+
+		// The token was sent to the email of the account, so using it proves that the email is
+		// owned by whoever owns the account.
+		err = a.markAccountEmailVerified(ctx, td, passwordResetToken.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// Accounts created through single sign-on do not have a password yet.
+		_, err = a.accountPasswordDataAccessor.WithDatabase(td).GetAccountPassword(ctx, passwordResetToken.AccountID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return a.accountPasswordDataAccessor.WithDatabase(td).CreateAccountPassword(ctx, database.AccountPassword{
+				AccountID: passwordResetToken.AccountID,
+				Hash:      hashedPassword,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return a.accountPasswordDataAccessor.WithDatabase(td).UpdateAccountPassword(ctx, database.AccountPassword{
+			AccountID: passwordResetToken.AccountID,
+			Hash:      hashedPassword,
+		})
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	err = a.deleteAndRevokeSessionListOfAccount(ctx, passwordResetToken.AccountID, 0)
+	if err != nil {
+		return err
+	}
+
+	logger.With(zap.Uint64("account_id", passwordResetToken.AccountID)).Info("account password reset")
+
+	return nil
+}
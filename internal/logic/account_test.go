@@ -0,0 +1,378 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/dataaccess/notifier"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testOIDCIssuer = "https://issuer.example.com"
+
+// fakeTwoFactor reports two-factor authentication as enabled for the accounts in
+// enabledAccountIDSet.
+type fakeTwoFactor struct {
+	TwoFactor
+
+	enabledAccountIDSet map[uint64]bool
+}
+
+func (f *fakeTwoFactor) IsEnabled(_ context.Context, accountID uint64) (bool, error) {
+	return f.enabledAccountIDSet[accountID], nil
+}
+
+// newTestGoquDatabase migrates up a SQLite database in a temporary file, the database of the single
+// binary mode.
+func newTestGoquDatabase(t *testing.T) *goqu.Database {
+	t.Helper()
+
+	databaseConfig := configs.Database{
+		Type:     configs.DatabaseTypeSQLite,
+		Database: filepath.Join(t.TempDir(), "morgana.db"),
+	}
+
+	db, cleanup, err := database.InitializeAndMigrateUpDB(databaseConfig, noop.NewTracerProvider(), zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to initialize database: %v", err)
+	}
+	t.Cleanup(cleanup)
+
+	goquDatabase, err := database.InitializeGoquDB(db, databaseConfig)
+	if err != nil {
+		t.Fatalf("failed to initialize goqu database: %v", err)
+	}
+
+	return goquDatabase
+}
+
+// newTestAccount returns an account logic backed by a temporary SQLite database, with only what
+// linking OIDC identities to accounts and requesting password resets need.
+func newTestAccount(t *testing.T, accountNotifier notifier.Notifier) account {
+	t.Helper()
+
+	goquDatabase := newTestGoquDatabase(t)
+	logger := zap.NewNop()
+
+	return account{
+		goquDatabase:                   goquDatabase,
+		takenAccountNameCache:          cache.NewTakenAccountName(cache.NewInMemoryClient(configs.Cache{}, logger), logger),
+		accountDataAccessor:            database.NewAccountDataAccessor(goquDatabase, logger),
+		accountIdentityDataAccessor:    database.NewAccountIdentityDataAccessor(goquDatabase, logger),
+		twoFactorChallengeCache:        cache.NewTwoFactorChallenge(cache.NewInMemoryClient(configs.Cache{}, logger), logger),
+		passwordResetTokenDataAccessor: database.NewPasswordResetTokenDataAccessor(goquDatabase, logger),
+		notifier:                       accountNotifier,
+		twoFactorLogic:                 &fakeTwoFactor{},
+		passwordResetTokenExpiresIn:    time.Hour,
+		twoFactorChallengeExpiresIn:    time.Minute,
+		oidcConfig:                     configs.OIDC{LinkAccountsByVerifiedEmail: true},
+		passwordResetConfig:            configs.PasswordReset{URL: "https://morgana.example.com/reset-password"},
+		logger:                         logger,
+	}
+}
+
+func createTestAccount(t *testing.T, a account, testAccount database.Account) database.Account {
+	t.Helper()
+
+	var err error
+	testAccount.ID, err = a.accountDataAccessor.CreateAccount(context.Background(), testAccount)
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	return testAccount
+}
+
+func TestOIDCLoginDoesNotLinkAccountNamedAfterEmail(t *testing.T) {
+	a := newTestAccount(t, notifier.NewLogNotifier(zap.NewNop()))
+	squattingAccount := createTestAccount(t, a, database.Account{AccountName: "victim@example.com"})
+
+	oidcAccount, err := a.linkOIDCIdentity(context.Background(), CreateOIDCSessionParams{
+		Issuer:        testOIDCIssuer,
+		Subject:       "victim",
+		Email:         "victim@example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+
+	if oidcAccount.ID == squattingAccount.ID {
+		t.Fatalf("identity was linked to the account named after its email")
+	}
+
+	if oidcAccount.AccountName == squattingAccount.AccountName {
+		t.Fatalf("new account got the name of an existing account")
+	}
+}
+
+func TestOIDCLoginDoesNotLinkAccountWithUnverifiedEmail(t *testing.T) {
+	a := newTestAccount(t, notifier.NewLogNotifier(zap.NewNop()))
+	unverifiedAccount := createTestAccount(t, a, database.Account{
+		AccountName: "squatter",
+		Email:       sql.NullString{String: "victim@example.com", Valid: true},
+	})
+
+	oidcAccount, err := a.linkOIDCIdentity(context.Background(), CreateOIDCSessionParams{
+		Issuer:        testOIDCIssuer,
+		Subject:       "victim",
+		Email:         "victim@example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+
+	if oidcAccount.ID == unverifiedAccount.ID {
+		t.Fatalf("identity was linked to an account whose email is not verified")
+	}
+}
+
+func TestOIDCLoginLinksAccountWithVerifiedEmail(t *testing.T) {
+	a := newTestAccount(t, notifier.NewLogNotifier(zap.NewNop()))
+	verifiedAccount := createTestAccount(t, a, database.Account{
+		AccountName:     "user",
+		Email:           sql.NullString{String: "user@example.com", Valid: true},
+		EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+
+	params := CreateOIDCSessionParams{
+		Issuer:        testOIDCIssuer,
+		Subject:       "user",
+		Email:         "user@example.com",
+		EmailVerified: true,
+	}
+	for range 2 {
+		oidcAccount, err := a.linkOIDCIdentity(context.Background(), params)
+		if err != nil {
+			t.Fatalf("failed to log in: %v", err)
+		}
+
+		if oidcAccount.ID != verifiedAccount.ID {
+			t.Fatalf("expected identity to be linked to account %d, got %d", verifiedAccount.ID, oidcAccount.ID)
+		}
+	}
+
+	params.EmailVerified = false
+	params.Subject = "other"
+	oidcAccount, err := a.linkOIDCIdentity(context.Background(), params)
+	if err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+
+	if oidcAccount.ID == verifiedAccount.ID {
+		t.Fatalf("identity whose email the provider did not verify was linked to an account")
+	}
+}
+
+func TestOIDCLoginConcurrentFirstLogins(t *testing.T) {
+	a := newTestAccount(t, notifier.NewLogNotifier(zap.NewNop()))
+
+	const loginCount = 3
+	accountIDList := make([]uint64, loginCount)
+	errList := make([]error, loginCount)
+	waitGroup := sync.WaitGroup{}
+	for i := range loginCount {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			// Every identity asks for the same account name, and the first one logs in twice.
+			params := CreateOIDCSessionParams{
+				Issuer:            testOIDCIssuer,
+				Subject:           []string{"first", "first", "second"}[i],
+				PreferredUsername: "user",
+			}
+			oidcAccount, err := a.linkOIDCIdentity(context.Background(), params)
+			accountIDList[i], errList[i] = oidcAccount.ID, err
+		}()
+	}
+	waitGroup.Wait()
+
+	for i, err := range errList {
+		if err != nil {
+			t.Fatalf("login %d failed: %v", i, err)
+		}
+	}
+
+	if accountIDList[0] != accountIDList[1] {
+		t.Fatalf("expected logins of the same identity to get the same account, got %d and %d", accountIDList[0], accountIDList[1])
+	}
+
+	if accountIDList[0] == accountIDList[2] {
+		t.Fatalf("expected logins of different identities to get different accounts")
+	}
+}
+
+// TestRequestPasswordResetDuringMailOutage checks that a mail outage does not tell existing
+// accounts apart from the others.
+func TestRequestPasswordResetDuringMailOutage(t *testing.T) {
+	// Nothing listens on the address once the listener is closed.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	smtpAddress := listener.Addr().String()
+	listener.Close()
+
+	smtpNotifier, err := notifier.NewSMTPNotifier(configs.SMTP{
+		Address: smtpAddress,
+		From:    "morgana@example.com",
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create smtp notifier: %v", err)
+	}
+
+	a := newTestAccount(t, smtpNotifier)
+	createTestAccount(t, a, database.Account{
+		AccountName: "user",
+		Email:       sql.NullString{String: "user@example.com", Valid: true},
+	})
+
+	for _, accountName := range []string{"user", "missing"} {
+		err = a.RequestPasswordReset(context.Background(), RequestPasswordResetParams{AccountName: accountName})
+		if err != nil {
+			t.Fatalf("expected password reset request of %s to succeed, got %v", accountName, err)
+		}
+	}
+}
+
+func TestOIDCLoginToAccountWithTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAccount(t, notifier.NewLogNotifier(zap.NewNop()))
+	a.twoFactorChallengeExpiresIn = 50 * time.Millisecond
+	twoFactorAccount := createTestAccount(t, a, database.Account{
+		AccountName:     "user",
+		Email:           sql.NullString{String: "user@example.com", Valid: true},
+		EmailVerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	a.twoFactorLogic = &fakeTwoFactor{enabledAccountIDSet: map[uint64]bool{twoFactorAccount.ID: true}}
+
+	output, err := a.CreateOIDCSession(ctx, CreateOIDCSessionParams{
+		Issuer:        testOIDCIssuer,
+		Subject:       "user",
+		Email:         "user@example.com",
+		EmailVerified: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to log in: %v", err)
+	}
+
+	if output.Token != "" || output.RefreshToken != "" || output.TwoFactorChallengeToken == "" {
+		t.Fatalf("expected a two-factor challenge instead of a session, got %+v", output)
+	}
+
+	challengeTokenHash := hashRandomToken(output.TwoFactorChallengeToken)
+	accountID, err := a.twoFactorChallengeCache.Get(ctx, challengeTokenHash)
+	if err != nil || accountID != twoFactorAccount.ID {
+		t.Fatalf("expected challenge of account %d, got %d, %v", twoFactorAccount.ID, accountID, err)
+	}
+
+	time.Sleep(2 * a.twoFactorChallengeExpiresIn)
+
+	if _, err = a.twoFactorChallengeCache.Get(ctx, challengeTokenHash); !errors.Is(err, cache.ErrCacheMiss) {
+		t.Fatalf("expected challenge to expire, got %v", err)
+	}
+}
+
+func TestCreateSessionRehashesOutdatedPasswordHash(t *testing.T) {
+	argon2idHashConfig := configs.Hash{Algorithm: configs.HashAlgorithmArgon2id, Argon2id: newTestArgon2idConfig(2)}
+	testCaseList := []struct {
+		name             string
+		oldHashConfig    configs.Hash
+		expectedRehashed bool
+	}{
+		{
+			name:             "bcrypt hash",
+			oldHashConfig:    configs.Hash{Cost: bcrypt.MinCost, Argon2id: newTestArgon2idConfig(1)},
+			expectedRehashed: true,
+		},
+		{
+			name:             "argon2id hash with outdated parameters",
+			oldHashConfig:    configs.Hash{Algorithm: configs.HashAlgorithmArgon2id, Argon2id: newTestArgon2idConfig(1)},
+			expectedRehashed: true,
+		},
+		{
+			name:          "argon2id hash with the configured parameters",
+			oldHashConfig: argon2idHashConfig,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			logger := zap.NewNop()
+			a := newTestAccount(t, notifier.NewLogNotifier(logger))
+			a.accountPasswordDataAccessor = database.NewAccountPasswordDataAccessor(a.goquDatabase, logger)
+			a.hashLogic = newTestHash(t, argon2idHashConfig)
+			a.loginThrottleLogic = &loginThrottle{logger: logger}
+
+			// Logins to accounts with two-factor authentication stop at the challenge, after the
+			// password was checked, so no token has to be issued.
+			testAccount := createTestAccount(t, a, database.Account{AccountName: "user"})
+			a.twoFactorLogic = &fakeTwoFactor{enabledAccountIDSet: map[uint64]bool{testAccount.ID: true}}
+
+			oldPasswordHash, err := newTestHash(t, testCase.oldHashConfig).Hash(ctx, "password")
+			if err != nil {
+				t.Fatalf("failed to hash password: %v", err)
+			}
+
+			err = a.accountPasswordDataAccessor.CreateAccountPassword(ctx, database.AccountPassword{
+				AccountID: testAccount.ID,
+				Hash:      oldPasswordHash,
+			})
+			if err != nil {
+				t.Fatalf("failed to create account password: %v", err)
+			}
+
+			_, err = a.CreateSession(ctx, CreateSessionParams{AccountName: "user", Password: "wrong password"})
+			if !errors.Is(err, errInvalidCredentials) {
+				t.Fatalf("expected invalid credentials, got %v", err)
+			}
+
+			if passwordHash := getTestAccountPasswordHash(t, a, testAccount.ID); passwordHash != oldPasswordHash {
+				t.Fatal("expected a failed login not to rehash the password")
+			}
+
+			if _, err = a.CreateSession(ctx, CreateSessionParams{AccountName: "user", Password: "password"}); err != nil {
+				t.Fatalf("failed to log in: %v", err)
+			}
+
+			passwordHash := getTestAccountPasswordHash(t, a, testAccount.ID)
+			if isRehashed := passwordHash != oldPasswordHash; isRehashed != testCase.expectedRehashed {
+				t.Fatalf("expected the password to be rehashed to be %v, got %v", testCase.expectedRehashed, isRehashed)
+			}
+
+			if a.hashLogic.NeedsRehash(ctx, passwordHash) {
+				t.Fatal("expected the password hash to be made with the configured parameters after the login")
+			}
+
+			if _, err = a.CreateSession(ctx, CreateSessionParams{AccountName: "user", Password: "password"}); err != nil {
+				t.Fatalf("failed to log in with the rehashed password: %v", err)
+			}
+		})
+	}
+}
+
+func getTestAccountPasswordHash(t *testing.T, a account, accountID uint64) string {
+	t.Helper()
+
+	accountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(context.Background(), accountID)
+	if err != nil {
+		t.Fatalf("failed to get account password: %v", err)
+	}
+
+	return accountPassword.Hash
+}
@@ -0,0 +1,566 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errAccountDisabled          = status.Error(codes.PermissionDenied, "account is disabled")
+	errInsufficientRole         = status.Error(codes.PermissionDenied, "account does not have the required role")
+	errCannotUpdateOwnRole      = status.Error(codes.FailedPrecondition, "cannot change the role of your own account")
+	errCannotDisableOwnAccount  = status.Error(codes.FailedPrecondition, "cannot disable your own account")
+	errInvalidAccountRole       = status.Error(codes.InvalidArgument, "invalid account role")
+	errInvalidUnlockLoginTarget = status.Error(codes.InvalidArgument, "an account name or a valid ip address is required")
+)
+
+type AdminGetAccountListParams struct {
+	Token  string
+	Offset uint64
+	Limit  uint64
+}
+
+type AdminGetAccountListOutput struct {
+	AccountList       []*morgana.AdminAccount
+	TotalAccountCount uint64
+}
+
+type AdminUpdateAccountRoleParams struct {
+	Token     string
+	AccountID uint64
+	Role      morgana.AccountRole
+}
+
+type AdminUpdateAccountRoleOutput struct {
+	Account *morgana.AdminAccount
+}
+
+type AdminDisableAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+
+type AdminEnableAccountParams struct {
+	Token     string
+	AccountID uint64
+}
+
+type AdminGetDownloadTaskListParams struct {
+	Token          string
+	AccountID      uint64
+	DownloadStatus morgana.DownloadStatus
+	Offset         uint64
+	Limit          uint64
+}
+
+type AdminRetryDownloadTaskParams struct {
+	Token          string
+	DownloadTaskID uint64
+}
+
+type AdminCancelDownloadTaskParams struct {
+	Token          string
+	DownloadTaskID uint64
+}
+
+type AdminGetSystemStatsParams struct {
+	Token string
+}
+
+type AdminGetSystemStatsOutput struct {
+	AccountCount                uint64
+	DisabledAccountCount        uint64
+	DownloadTaskStatusCountList []*morgana.DownloadTaskStatusCount
+}
+
+type AdminUnlockLoginParams struct {
+	Token       string
+	AccountName string
+	IPAddress   string
+}
+
+type AdminGetAuditEventListParams struct {
+	Token  string
+	Offset uint64
+	Limit  uint64
+}
+
+type AdminGetAuditEventListOutput struct {
+	AuditEventList       []*morgana.AuditEvent
+	TotalAuditEventCount uint64
+}
+
+// Admin serves the AdminService. Operators can look at everything and retry or cancel download
+// tasks, while managing accounts is reserved to admins. Every method checks the role of the
+// caller itself, so that the service cannot be exposed without authorization by mistake.
+type Admin interface {
+	GetAccountList(ctx context.Context, params AdminGetAccountListParams) (AdminGetAccountListOutput, error)
+	UpdateAccountRole(ctx context.Context, params AdminUpdateAccountRoleParams) (AdminUpdateAccountRoleOutput, error)
+	DisableAccount(ctx context.Context, params AdminDisableAccountParams) error
+	EnableAccount(ctx context.Context, params AdminEnableAccountParams) error
+	GetDownloadTaskList(ctx context.Context, params AdminGetDownloadTaskListParams) (GetDownloadTaskListOutput, error)
+	RetryDownloadTask(ctx context.Context, params AdminRetryDownloadTaskParams) (*morgana.DownloadTask, error)
+	CancelDownloadTask(ctx context.Context, params AdminCancelDownloadTaskParams) (*morgana.DownloadTask, error)
+	GetSystemStats(ctx context.Context, params AdminGetSystemStatsParams) (AdminGetSystemStatsOutput, error)
+	UnlockLogin(ctx context.Context, params AdminUnlockLoginParams) error
+	GetAuditEventList(ctx context.Context, params AdminGetAuditEventListParams) (AdminGetAuditEventListOutput, error)
+}
+
+type admin struct {
+	goquDatabase             *goqu.Database
+	accountDataAccessor      database.AccountDataAccessor
+	sessionDataAccessor      database.SessionDataAccessor
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor
+	auditEventDataAccessor   database.AuditEventDataAccessor
+	tokenLogic               Token
+	downloadTaskLogic        DownloadTask
+	loginThrottleLogic       LoginThrottle
+	authConfig               configs.Auth
+	logger                   *zap.Logger
+}
+
+func NewAdmin(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	auditEventDataAccessor database.AuditEventDataAccessor,
+	tokenLogic Token,
+	downloadTaskLogic DownloadTask,
+	loginThrottleLogic LoginThrottle,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) Admin {
+	return &admin{
+		goquDatabase:             goquDatabase,
+		accountDataAccessor:      accountDataAccessor,
+		sessionDataAccessor:      sessionDataAccessor,
+		downloadTaskDataAccessor: downloadTaskDataAccessor,
+		auditEventDataAccessor:   auditEventDataAccessor,
+		tokenLogic:               tokenLogic,
+		downloadTaskLogic:        downloadTaskLogic,
+		loginThrottleLogic:       loginThrottleLogic,
+		authConfig:               authConfig,
+		logger:                   logger,
+	}
+}
+
+// getAccountRole returns the role an account effectively has. Roles are ordered, so that a role
+// is allowed to do everything the roles below it can.
+func getAccountRole(account database.Account, authConfig configs.Auth) morgana.AccountRole {
+	if slices.Contains(authConfig.AdminAccountNames, account.AccountName) {
+		return morgana.AccountRole_ACCOUNT_ROLE_ADMIN
+	}
+
+	if account.Role == morgana.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return morgana.AccountRole_ACCOUNT_ROLE_USER
+	}
+
+	return account.Role
+}
+
+func (a admin) authorizeAccount(ctx context.Context, token string, minimumRole morgana.AccountRole) (database.Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	// Administration is only allowed with a session, API keys do not carry roles.
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
+	if err != nil {
+		return database.Account{}, err
+	}
+
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return database.Account{}, err
+	}
+
+	if account.DisabledAt.Valid {
+		return database.Account{}, errAccountDisabled
+	}
+
+	if getAccountRole(account, a.authConfig) < minimumRole {
+		logger.
+			With(zap.Uint64("account_id", accountID)).
+			With(zap.String("minimum_role", minimumRole.String())).
+			Warn("account does not have the required role")
+		return database.Account{}, errInsufficientRole
+	}
+
+	return account, nil
+}
+
+func (a admin) databaseAccountToProtoAdminAccount(account database.Account) *morgana.AdminAccount {
+	protoAccount := &morgana.AdminAccount{
+		Account: &morgana.Account{
+			Id:          account.ID,
+			AccountName: account.AccountName,
+		},
+		Role:     getAccountRole(account, a.authConfig),
+		Disabled: account.DisabledAt.Valid,
+	}
+
+	if account.DisabledAt.Valid {
+		protoAccount.DisabledAt = timestamppb.New(account.DisabledAt.Time)
+	}
+
+	return protoAccount
+}
+
+func (a admin) GetAccountList(ctx context.Context, params AdminGetAccountListParams) (AdminGetAccountListOutput, error) {
+	_, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return AdminGetAccountListOutput{}, err
+	}
+
+	totalAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx)
+	if err != nil {
+		return AdminGetAccountListOutput{}, err
+	}
+
+	accountList, err := a.accountDataAccessor.GetAccountList(ctx, params.Offset, params.Limit)
+	if err != nil {
+		return AdminGetAccountListOutput{}, err
+	}
+
+	return AdminGetAccountListOutput{
+		AccountList: lo.Map(accountList, func(item database.Account, _ int) *morgana.AdminAccount {
+			return a.databaseAccountToProtoAdminAccount(item)
+		}),
+		TotalAccountCount: totalAccountCount,
+	}, nil
+}
+
+func (a admin) UpdateAccountRole(ctx context.Context, params AdminUpdateAccountRoleParams) (AdminUpdateAccountRoleOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_ADMIN)
+	if err != nil {
+		return AdminUpdateAccountRoleOutput{}, err
+	}
+
+	if actorAccount.ID == params.AccountID {
+		return AdminUpdateAccountRoleOutput{}, errCannotUpdateOwnRole
+	}
+
+	if _, ok := morgana.AccountRole_name[int32(params.Role)]; !ok ||
+		params.Role == morgana.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return AdminUpdateAccountRoleOutput{}, errInvalidAccountRole
+	}
+
+	var account database.Account
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		account, err = a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		account.Role = params.Role
+		return a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account)
+	})
+	if txErr != nil {
+		return AdminUpdateAccountRoleOutput{}, txErr
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccount.ID)).
+		With(zap.Uint64("account_id", account.ID)).
+		With(zap.String("role", params.Role.String())).
+		Info("account role updated")
+
+	return AdminUpdateAccountRoleOutput{
+		Account: a.databaseAccountToProtoAdminAccount(account),
+	}, nil
+}
+
+func (a admin) DisableAccount(ctx context.Context, params AdminDisableAccountParams) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_ADMIN)
+	if err != nil {
+		return err
+	}
+
+	if actorAccount.ID == params.AccountID {
+		return errCannotDisableOwnAccount
+	}
+
+	var sessionList []database.Session
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		account, err := a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.DisabledAt.Valid {
+			return nil
+		}
+
+		account.DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+		err = a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		sessionList, err = a.sessionDataAccessor.WithDatabase(td).GetUnexpiredSessionListOfAccount(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, session := range sessionList {
+			err = a.sessionDataAccessor.WithDatabase(td).DeleteSession(ctx, session.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// Access tokens already issued to the account stop working right away instead of when they
+	// expire.
+	for _, session := range sessionList {
+		err = a.tokenLogic.RevokeSession(ctx, session.ID)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to add session to revoked sessions")
+		}
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccount.ID)).
+		With(zap.Uint64("account_id", params.AccountID)).
+		Info("account disabled")
+
+	return nil
+}
+
+func (a admin) EnableAccount(ctx context.Context, params AdminEnableAccountParams) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_ADMIN)
+	if err != nil {
+		return err
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		account, err := a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if !account.DisabledAt.Valid {
+			return nil
+		}
+
+		account.DisabledAt = sql.NullTime{}
+		return a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccount.ID)).
+		With(zap.Uint64("account_id", params.AccountID)).
+		Info("account enabled")
+
+	return nil
+}
+
+func (a admin) GetDownloadTaskList(ctx context.Context, params AdminGetDownloadTaskListParams) (GetDownloadTaskListOutput, error) {
+	_, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	return a.downloadTaskLogic.GetDownloadTaskListOfAllAccounts(ctx, GetDownloadTaskListOfAllAccountsParams{
+		AccountID:      params.AccountID,
+		DownloadStatus: params.DownloadStatus,
+		Offset:         params.Offset,
+		Limit:          params.Limit,
+	})
+}
+
+func (a admin) RetryDownloadTask(ctx context.Context, params AdminRetryDownloadTaskParams) (*morgana.DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadTask, err := a.downloadTaskLogic.RetryDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccount.ID)).
+		With(zap.Uint64("download_task_id", params.DownloadTaskID)).
+		Info("download task retried")
+
+	return downloadTask, nil
+}
+
+func (a admin) CancelDownloadTask(ctx context.Context, params AdminCancelDownloadTaskParams) (*morgana.DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadTask, err := a.downloadTaskLogic.CancelDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccount.ID)).
+		With(zap.Uint64("download_task_id", params.DownloadTaskID)).
+		Info("download task canceled")
+
+	return downloadTask, nil
+}
+
+func (a admin) GetSystemStats(ctx context.Context, params AdminGetSystemStatsParams) (AdminGetSystemStatsOutput, error) {
+	_, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return AdminGetSystemStatsOutput{}, err
+	}
+
+	accountCount, err := a.accountDataAccessor.GetAccountCount(ctx)
+	if err != nil {
+		return AdminGetSystemStatsOutput{}, err
+	}
+
+	disabledAccountCount, err := a.accountDataAccessor.GetDisabledAccountCount(ctx)
+	if err != nil {
+		return AdminGetSystemStatsOutput{}, err
+	}
+
+	downloadTaskStatusCountList, err := a.downloadTaskDataAccessor.GetDownloadTaskStatusCountList(ctx)
+	if err != nil {
+		return AdminGetSystemStatsOutput{}, err
+	}
+
+	return AdminGetSystemStatsOutput{
+		AccountCount:         accountCount,
+		DisabledAccountCount: disabledAccountCount,
+		DownloadTaskStatusCountList: lo.Map(
+			downloadTaskStatusCountList,
+			func(item database.DownloadTaskStatusCount, _ int) *morgana.DownloadTaskStatusCount {
+				return &morgana.DownloadTaskStatusCount{
+					DownloadStatus: item.DownloadStatus,
+					Count:          item.Count,
+				}
+			},
+		),
+	}, nil
+}
+
+func (a admin) UnlockLogin(ctx context.Context, params AdminUnlockLoginParams) error {
+	actorAccount, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_ADMIN)
+	if err != nil {
+		return err
+	}
+
+	ipAddress := ""
+	if params.IPAddress != "" {
+		parsedIPAddress := net.ParseIP(params.IPAddress)
+		if parsedIPAddress == nil {
+			return errInvalidUnlockLoginTarget
+		}
+
+		ipAddress = parsedIPAddress.String()
+	}
+
+	if params.AccountName == "" && ipAddress == "" {
+		return errInvalidUnlockLoginTarget
+	}
+
+	return a.loginThrottleLogic.UnlockLogin(ctx, actorAccount.ID, params.AccountName, ipAddress)
+}
+
+func (a admin) databaseAuditEventToProtoAuditEvent(
+	auditEvent database.AuditEvent,
+	actorAccountMap map[uint64]database.Account,
+) *morgana.AuditEvent {
+	protoAuditEvent := &morgana.AuditEvent{
+		Id:          auditEvent.ID,
+		EventType:   auditEvent.EventType,
+		AccountName: auditEvent.AccountName,
+		IpAddress:   auditEvent.IPAddress,
+		CreatedAt:   timestamppb.New(auditEvent.CreatedAt),
+	}
+
+	if actorAccount, ok := actorAccountMap[auditEvent.ActorAccountID.V]; auditEvent.ActorAccountID.Valid && ok {
+		protoAuditEvent.ActorAccount = &morgana.Account{
+			Id:          actorAccount.ID,
+			AccountName: actorAccount.AccountName,
+		}
+	}
+
+	if auditEvent.ExpiresAt.Valid {
+		protoAuditEvent.ExpiresAt = timestamppb.New(auditEvent.ExpiresAt.Time)
+	}
+
+	return protoAuditEvent
+}
+
+func (a admin) GetAuditEventList(ctx context.Context, params AdminGetAuditEventListParams) (AdminGetAuditEventListOutput, error) {
+	_, err := a.authorizeAccount(ctx, params.Token, morgana.AccountRole_ACCOUNT_ROLE_OPERATOR)
+	if err != nil {
+		return AdminGetAuditEventListOutput{}, err
+	}
+
+	totalAuditEventCount, err := a.auditEventDataAccessor.GetAuditEventCount(ctx)
+	if err != nil {
+		return AdminGetAuditEventListOutput{}, err
+	}
+
+	auditEventList, err := a.auditEventDataAccessor.GetAuditEventList(ctx, params.Offset, params.Limit)
+	if err != nil {
+		return AdminGetAuditEventListOutput{}, err
+	}
+
+	actorAccountMap := make(map[uint64]database.Account)
+	for _, auditEvent := range auditEventList {
+		if !auditEvent.ActorAccountID.Valid {
+			continue
+		}
+
+		if _, ok := actorAccountMap[auditEvent.ActorAccountID.V]; ok {
+			continue
+		}
+
+		actorAccount, err := a.accountDataAccessor.GetAccountByID(ctx, auditEvent.ActorAccountID.V)
+		if err != nil {
+			return AdminGetAuditEventListOutput{}, err
+		}
+
+		actorAccountMap[actorAccount.ID] = actorAccount
+	}
+
+	return AdminGetAuditEventListOutput{
+		AuditEventList: lo.Map(auditEventList, func(item database.AuditEvent, _ int) *morgana.AuditEvent {
+			return a.databaseAuditEventToProtoAuditEvent(item, actorAccountMap)
+		}),
+		TotalAuditEventCount: totalAuditEventCount,
+	}, nil
+}
@@ -0,0 +1,276 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeTasksRead  APIKeyScope = "tasks:read"
+	APIKeyScopeTasksWrite APIKeyScope = "tasks:write"
+	APIKeyScopeFilesRead  APIKeyScope = "files:read"
+)
+
+const (
+	// apiKeyPrefix makes API keys easy to tell apart from access tokens, both for the server and
+	// for secret scanners.
+	apiKeyPrefix            = "mgn_"
+	apiKeySecretByteCount   = 32
+	apiKeyDisplayPrefixSize = 12
+	// last_used_at is only written once in a while, so that a busy pipeline does not turn every
+	// request into a database write.
+	apiKeyLastUsedAtUpdateInterval = time.Minute
+)
+
+var (
+	apiKeyScopeList = []APIKeyScope{
+		APIKeyScopeTasksRead,
+		APIKeyScopeTasksWrite,
+		APIKeyScopeFilesRead,
+	}
+
+	errInvalidAPIKey           = status.Error(codes.Unauthenticated, "invalid api key")
+	errAPIKeyScopeNotGranted   = status.Error(codes.PermissionDenied, "api key does not have the required scope")
+	errInvalidAPIKeyName       = status.Error(codes.InvalidArgument, "api key name must be between 1 and 256 characters")
+	errInvalidAPIKeyScopeList  = status.Error(codes.InvalidArgument, "api key scopes must be a non empty list of tasks:read, tasks:write and files:read")
+	errInvalidAPIKeyExpireTime = status.Error(codes.InvalidArgument, "api key expire time must be in the future")
+)
+
+type CreateAPIKeyParams struct {
+	Token      string
+	Name       string
+	ScopeList  []string
+	ExpireTime time.Time
+}
+
+type CreateAPIKeyOutput struct {
+	APIKey *morgana.APIKey
+	Secret string
+}
+
+type GetAPIKeyListParams struct {
+	Token string
+}
+
+type GetAPIKeyListOutput struct {
+	APIKeyList []*morgana.APIKey
+}
+
+type RevokeAPIKeyParams struct {
+	Token    string
+	APIKeyID uint64
+}
+
+// IsAPIKey tells whether a credential sent by a client is an API key rather than an access token.
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, apiKeyPrefix)
+}
+
+type APIKey interface {
+	CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error)
+	GetAPIKeyList(ctx context.Context, params GetAPIKeyListParams) (GetAPIKeyListOutput, error)
+	RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error
+	GetAccountID(ctx context.Context, apiKey string, scope APIKeyScope) (uint64, error)
+}
+
+type apiKey struct {
+	apiKeyDataAccessor  database.APIKeyDataAccessor
+	accountDataAccessor database.AccountDataAccessor
+	tokenLogic          Token
+	logger              *zap.Logger
+}
+
+func NewAPIKey(
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	tokenLogic Token,
+	logger *zap.Logger,
+) APIKey {
+	return &apiKey{
+		apiKeyDataAccessor:  apiKeyDataAccessor,
+		accountDataAccessor: accountDataAccessor,
+		tokenLogic:          tokenLogic,
+		logger:              logger,
+	}
+}
+
+func (a apiKey) hashAPIKey(apiKey string) string {
+	apiKeyHash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(apiKeyHash[:])
+}
+
+func (a apiKey) databaseAPIKeyToProtoAPIKey(apiKey database.APIKey) *morgana.APIKey {
+	protoAPIKey := &morgana.APIKey{
+		Id:        apiKey.ID,
+		Name:      apiKey.Name,
+		KeyPrefix: apiKey.KeyPrefix,
+		ScopeList: strings.Split(apiKey.Scopes, ","),
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+
+	if apiKey.ExpiresAt.Valid {
+		protoAPIKey.ExpiresAt = timestamppb.New(apiKey.ExpiresAt.Time)
+	}
+
+	if apiKey.LastUsedAt.Valid {
+		protoAPIKey.LastUsedAt = timestamppb.New(apiKey.LastUsedAt.Time)
+	}
+
+	return protoAPIKey
+}
+
+func (a apiKey) CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	// API keys can only be managed with a session, so that a leaked key cannot be used to mint
+	// more keys.
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	if len(params.Name) == 0 || len(params.Name) > 256 {
+		return CreateAPIKeyOutput{}, errInvalidAPIKeyName
+	}
+
+	scopeList := lo.Uniq(params.ScopeList)
+	if len(scopeList) == 0 {
+		return CreateAPIKeyOutput{}, errInvalidAPIKeyScopeList
+	}
+
+	for _, scope := range scopeList {
+		if !slices.Contains(apiKeyScopeList, APIKeyScope(scope)) {
+			return CreateAPIKeyOutput{}, errInvalidAPIKeyScopeList
+		}
+	}
+
+	expiresAt := sql.NullTime{}
+	if !params.ExpireTime.IsZero() {
+		if !params.ExpireTime.After(time.Now()) {
+			return CreateAPIKeyOutput{}, errInvalidAPIKeyExpireTime
+		}
+
+		expiresAt = sql.NullTime{Time: params.ExpireTime, Valid: true}
+	}
+
+	secretBytes := make([]byte, apiKeySecretByteCount)
+	if _, err = rand.Read(secretBytes); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate api key")
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to generate api key")
+	}
+
+	secret := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secretBytes)
+	databaseAPIKey := database.APIKey{
+		AccountID:  accountID,
+		Name:       params.Name,
+		KeyPrefix:  secret[:apiKeyDisplayPrefixSize],
+		SecretHash: a.hashAPIKey(secret),
+		Scopes:     strings.Join(scopeList, ","),
+		CreatedAt:  time.Now(),
+		ExpiresAt:  expiresAt,
+	}
+
+	databaseAPIKey.ID, err = a.apiKeyDataAccessor.CreateAPIKey(ctx, databaseAPIKey)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	return CreateAPIKeyOutput{
+		APIKey: a.databaseAPIKeyToProtoAPIKey(databaseAPIKey),
+		Secret: secret,
+	}, nil
+}
+
+func (a apiKey) GetAPIKeyList(ctx context.Context, params GetAPIKeyListParams) (GetAPIKeyListOutput, error) {
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetAPIKeyListOutput{}, err
+	}
+
+	apiKeyList, err := a.apiKeyDataAccessor.GetAPIKeyListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetAPIKeyListOutput{}, err
+	}
+
+	return GetAPIKeyListOutput{
+		APIKeyList: lo.Map(apiKeyList, func(item database.APIKey, _ int) *morgana.APIKey {
+			return a.databaseAPIKeyToProtoAPIKey(item)
+		}),
+	}, nil
+}
+
+func (a apiKey) RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error {
+	accountID, _, err := a.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	existingAPIKey, err := a.apiKeyDataAccessor.GetAPIKey(ctx, params.APIKeyID)
+	if err != nil {
+		return err
+	}
+
+	if existingAPIKey.AccountID != accountID {
+		return database.ErrAPIKeyNotFound
+	}
+
+	return a.apiKeyDataAccessor.DeleteAPIKey(ctx, existingAPIKey.ID)
+}
+
+func (a apiKey) GetAccountID(ctx context.Context, apiKey string, scope APIKeyScope) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	existingAPIKey, err := a.apiKeyDataAccessor.GetAPIKeyBySecretHash(ctx, a.hashAPIKey(apiKey))
+	if err != nil {
+		if errors.Is(err, database.ErrAPIKeyNotFound) {
+			return 0, errInvalidAPIKey
+		}
+		return 0, err
+	}
+
+	if existingAPIKey.ExpiresAt.Valid && !existingAPIKey.ExpiresAt.Time.After(time.Now()) {
+		return 0, errInvalidAPIKey
+	}
+
+	if !slices.Contains(strings.Split(existingAPIKey.Scopes, ","), string(scope)) {
+		return 0, errAPIKeyScopeNotGranted
+	}
+
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, existingAPIKey.AccountID)
+	if err != nil {
+		return 0, err
+	}
+
+	if account.DisabledAt.Valid {
+		return 0, errAccountDisabled
+	}
+
+	utils.SetAccountID(ctx, existingAPIKey.AccountID)
+	if !existingAPIKey.LastUsedAt.Valid || time.Since(existingAPIKey.LastUsedAt.Time) >= apiKeyLastUsedAtUpdateInterval {
+		err = a.apiKeyDataAccessor.UpdateAPIKeyLastUsedAt(ctx, existingAPIKey.ID, time.Now())
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to update api key last used at")
+		}
+	}
+
+	return existingAPIKey.AccountID, nil
+}
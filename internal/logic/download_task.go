@@ -2,8 +2,15 @@ package logic
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/gammazero/workerpool"
@@ -12,21 +19,42 @@ import (
 	"github.com/hoangdv99/morgana/internal/dataaccess/file"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
 	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/metrics"
 	"github.com/hoangdv99/morgana/internal/utils"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 const (
-	downloadTaskMetadataFieldNameFileName = "file-name"
+	downloadTaskMetadataFieldNameFileName         = "file-name"
+	downloadTaskMetadataFieldNameOriginalFileName = "original-file-name"
+	downloadTaskMetadataFieldNameContentType      = "content-type"
+	downloadTaskMetadataFieldNameCodec            = "codec"
+	downloadTaskMetadataFieldNameMasterKeyID      = "master-key-id"
+	downloadTaskMetadataFieldNameDataKey          = "data-key"
+
+	rewrapDownloadTaskDataKeyBatchSize = 100
+
+	tracerName = "github.com/hoangdv99/morgana/internal/logic"
+)
+
+var (
+	errDownloadTaskCountQuotaExceeded = status.Error(codes.ResourceExhausted, "download task count quota exceeded")
+	errTotalFileSizeQuotaExceeded     = status.Error(codes.ResourceExhausted, "total file size quota exceeded")
 )
 
 type CreateDownloadTaskParams struct {
 	Token        string
 	DownloadType morgana.DownloadType
 	URL          string
+	FileName     string
+	// Owner defaults to the account of the token.
+	Owner *morgana.DownloadTaskOwner
 }
 
 type CreateDownloadTaskOutput struct {
@@ -37,6 +65,7 @@ type GetDownloadTaskListParams struct {
 	Token  string
 	Offset uint64
 	Limit  uint64
+	Owner  *morgana.DownloadTaskOwner
 }
 
 type GetDownloadTaskListOutput struct {
@@ -62,6 +91,37 @@ type DeleteDownloadTaskParams struct {
 type GetDownloadTaskFileParams struct {
 	Token          string
 	DownloadTaskID uint64
+	// AcceptedContentEncodingList lists the content encodings the caller can decode itself. If the
+	// file is stored with one of them, it is returned as is instead of being decompressed.
+	AcceptedContentEncodingList []string
+}
+
+type GetDownloadTaskListOfAllAccountsParams struct {
+	AccountID      uint64
+	DownloadStatus morgana.DownloadStatus
+	Offset         uint64
+	Limit          uint64
+}
+
+type GetUsageParams struct {
+	Token string
+	Owner *morgana.DownloadTaskOwner
+}
+
+// GetUsageOutput reports the usage of an owner along with its quota, where zero means that the
+// quota is not limited.
+type GetUsageOutput struct {
+	DownloadTaskCount    uint64
+	TotalFileSize        uint64
+	MaxDownloadTaskCount uint64
+	MaxTotalFileSize     uint64
+}
+
+type GetDownloadTaskFileOutput struct {
+	FileReadCloser  io.ReadCloser
+	FileName        string
+	ContentType     string
+	ContentEncoding string
 }
 
 type DownloadTask interface {
@@ -70,49 +130,254 @@ type DownloadTask interface {
 	UpdateDownloadTask(ctx context.Context, params UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error)
 	DeleteDownloadTask(ctx context.Context, params DeleteDownloadTaskParams) error
 	ExecuteDownloadTask(ctx context.Context, id uint64) error
-	GetDownloadTaskFile(ctx context.Context, params GetDownloadTaskFileParams) (io.ReadCloser, error)
+	GetDownloadTaskFile(ctx context.Context, params GetDownloadTaskFileParams) (GetDownloadTaskFileOutput, error)
 	ExecuteAllPendingDownloadTask(ctx context.Context) error
 	UpdateDownloadingAndFailedDownloadTaskStatusToPending(ctx context.Context) error
+	RewrapAllDownloadTaskDataKey(ctx context.Context) error
+	GetDownloadTaskListOfAllAccounts(ctx context.Context, params GetDownloadTaskListOfAllAccountsParams) (GetDownloadTaskListOutput, error)
+	RetryDownloadTask(ctx context.Context, id uint64) (*morgana.DownloadTask, error)
+	CancelDownloadTask(ctx context.Context, id uint64) (*morgana.DownloadTask, error)
+	GetUsage(ctx context.Context, params GetUsageParams) (GetUsageOutput, error)
+}
+
+type quota struct {
+	maxDownloadTaskCount uint64
+	maxTotalFileSize     uint64
+}
+
+func newQuota(ownerQuotaConfig configs.OwnerQuota) (quota, error) {
+	maxTotalFileSize, err := ownerQuotaConfig.GetMaxTotalFileSizeInBytes()
+	if err != nil {
+		return quota{}, err
+	}
+
+	return quota{
+		maxDownloadTaskCount: ownerQuotaConfig.MaxDownloadTaskCount,
+		maxTotalFileSize:     maxTotalFileSize,
+	}, nil
+}
+
+// check tells whether one more download task fits in the quota. File sizes are only known once
+// downloaded, so the total file size quota stops new download tasks from being created once it is
+// reached, rather than bounding the size of the files themselves.
+func (q quota) check(downloadTaskUsage database.DownloadTaskUsage) error {
+	if q.maxDownloadTaskCount > 0 && downloadTaskUsage.DownloadTaskCount >= q.maxDownloadTaskCount {
+		return errDownloadTaskCountQuotaExceeded
+	}
+
+	if q.maxTotalFileSize > 0 && downloadTaskUsage.TotalFileSize >= q.maxTotalFileSize {
+		return errTotalFileSizeQuotaExceeded
+	}
+
+	return nil
+}
+
+// byteCountingWriter counts the bytes of a downloaded file, before they are compressed and
+// encrypted.
+type byteCountingWriter struct {
+	writer    io.Writer
+	byteCount uint64
+}
+
+func (b *byteCountingWriter) Write(p []byte) (int, error) {
+	n, err := b.writer.Write(p)
+	b.byteCount += uint64(n)
+	return n, err
 }
 
 type downloadTask struct {
-	tokenLogic                  Token
-	accountDataAccessor         database.AccountDataAccessor
-	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer
-	goquDatabase                *goqu.Database
-	fileClient                  file.Client
-	logger                      *zap.Logger
-	cronConfig                  configs.Cron
+	tokenLogic                     Token
+	apiKeyLogic                    APIKey
+	accountDataAccessor            database.AccountDataAccessor
+	organizationDataAccessor       database.OrganizationDataAccessor
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor
+	downloadTaskDataAccessor       database.DownloadTaskDataAccessor
+	downloadTaskCreatedProducer    producer.DownloadTaskCreatedProducer
+	goquDatabase                   *goqu.Database
+	fileClient                     file.Client
+	fileCompressor                 file.Compressor
+	fileEncryptor                  file.Encryptor
+	httpClient                     *http.Client
+	tracer                         trace.Tracer
+	logger                         *zap.Logger
+	cronConfig                     configs.Cron
+	accountQuota                   quota
+	organizationQuota              quota
 }
 
 func NewDownloadTask(
 	tokenLogic Token,
+	apiKeyLogic APIKey,
 	accountDataAccessor database.AccountDataAccessor,
+	organizationDataAccessor database.OrganizationDataAccessor,
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor,
 	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
 	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer,
 	goquDatabase *goqu.Database,
 	fileClient file.Client,
+	fileCompressor file.Compressor,
+	fileEncryptor file.Encryptor,
+	tracerProvider trace.TracerProvider,
 	logger *zap.Logger,
 	cronConfig configs.Cron,
-) DownloadTask {
+	quotaConfig configs.Quota,
+) (DownloadTask, error) {
+	accountQuota, err := newQuota(quotaConfig.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationQuota, err := newQuota(quotaConfig.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests made by downloads are traced as part of the span of their download task.
+	httpClient := &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport, otelhttp.WithTracerProvider(tracerProvider)),
+	}
+
 	return &downloadTask{
-		tokenLogic:                  tokenLogic,
-		accountDataAccessor:         accountDataAccessor,
-		downloadTaskDataAccessor:    downloadTaskDataAccessor,
-		downloadTaskCreatedProducer: downloadTaskCreatedProducer,
-		goquDatabase:                goquDatabase,
-		fileClient:                  fileClient,
-		logger:                      logger,
-		cronConfig:                  cronConfig,
+		tokenLogic:                     tokenLogic,
+		apiKeyLogic:                    apiKeyLogic,
+		accountDataAccessor:            accountDataAccessor,
+		organizationDataAccessor:       organizationDataAccessor,
+		organizationMemberDataAccessor: organizationMemberDataAccessor,
+		downloadTaskDataAccessor:       downloadTaskDataAccessor,
+		downloadTaskCreatedProducer:    downloadTaskCreatedProducer,
+		goquDatabase:                   goquDatabase,
+		fileClient:                     fileClient,
+		fileCompressor:                 fileCompressor,
+		fileEncryptor:                  fileEncryptor,
+		httpClient:                     httpClient,
+		tracer:                         tracerProvider.Tracer(tracerName),
+		logger:                         logger,
+		cronConfig:                     cronConfig,
+		accountQuota:                   accountQuota,
+		organizationQuota:              organizationQuota,
+	}, nil
+}
+
+// getAccountID accepts both access tokens, which are allowed to do anything, and API keys, which
+// have to be granted the scope of the operation.
+func (d downloadTask) getAccountID(ctx context.Context, token string, scope APIKeyScope) (uint64, error) {
+	if IsAPIKey(token) {
+		return d.apiKeyLogic.GetAccountID(ctx, token, scope)
+	}
+
+	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, token)
+	return accountID, err
+}
+
+// authorizeDownloadTaskOwner resolves the owner of download tasks an account is acting on, and
+// returns the ID of its organization, or zero when the owner is the account itself. Accounts can
+// only act on their own download tasks, and on the ones of organizations they have at least the
+// given role in.
+func (d downloadTask) authorizeDownloadTaskOwner(
+	ctx context.Context,
+	accountID uint64,
+	owner *morgana.DownloadTaskOwner,
+	minimumRole morgana.OrganizationRole,
+) (uint64, error) {
+	switch owner.GetOwner().(type) {
+	case *morgana.DownloadTaskOwner_OrganizationId:
+		_, err := authorizeOrganizationMember(
+			ctx, d.organizationMemberDataAccessor, owner.GetOrganizationId(), accountID, minimumRole,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		return owner.GetOrganizationId(), nil
+	case *morgana.DownloadTaskOwner_AccountId:
+		if owner.GetAccountId() != accountID {
+			return 0, status.Error(codes.PermissionDenied, "trying to access download tasks of another account")
+		}
 	}
+
+	return 0, nil
 }
 
+// canAccessDownloadTask tells whether an account owns a download task itself, or has at least the
+// given role in the organization that owns it.
+func (d downloadTask) canAccessDownloadTask(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	accountID uint64,
+	minimumRole morgana.OrganizationRole,
+) (bool, error) {
+	if !downloadTask.OrganizationID.Valid {
+		return downloadTask.AccountID == accountID, nil
+	}
+
+	_, err := authorizeOrganizationMember(
+		ctx, d.organizationMemberDataAccessor, downloadTask.OrganizationID.V, accountID, minimumRole,
+	)
+	if errors.Is(err, errNotOrganizationMember) || errors.Is(err, errInsufficientOrganizationRole) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (d downloadTask) getDownloadTaskMetadata(downloadTask database.DownloadTask) map[string]any {
+	downloadTaskMetadata, ok := downloadTask.Metadata.Data.(map[string]any)
+	if !ok {
+		return make(map[string]any)
+	}
+
+	return downloadTaskMetadata
+}
+
+func (d downloadTask) getDownloadTaskMetadataString(downloadTask database.DownloadTask, fieldName string) string {
+	value, ok := d.getDownloadTaskMetadata(downloadTask)[fieldName].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+func (d downloadTask) getDownloadTaskWrappedDataKey(downloadTask database.DownloadTask) (file.WrappedDataKey, error) {
+	masterKeyID := d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameMasterKeyID)
+	if masterKeyID == "" {
+		return file.WrappedDataKey{}, nil
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(
+		d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameDataKey),
+	)
+	if err != nil {
+		return file.WrappedDataKey{}, status.Error(codes.Internal, "download task metadata contains an invalid data key")
+	}
+
+	return file.WrappedDataKey{
+		MasterKeyID: masterKeyID,
+		Ciphertext:  ciphertext,
+	}, nil
+}
+
+func (d downloadTask) setDownloadTaskWrappedDataKey(metadata map[string]any, wrappedDataKey file.WrappedDataKey) {
+	if wrappedDataKey.IsEmpty() {
+		return
+	}
+
+	metadata[downloadTaskMetadataFieldNameMasterKeyID] = wrappedDataKey.MasterKeyID
+	metadata[downloadTaskMetadataFieldNameDataKey] = base64.StdEncoding.EncodeToString(wrappedDataKey.Ciphertext)
+}
+
+// databaseDownloadTaskToProtoDownloadTask converts a download task along with the account that
+// created it, and its organization when it is owned by one.
 func (d downloadTask) databaseDownloadTaskToProtoDownloadTask(
 	downloadTask database.DownloadTask,
 	account database.Account,
+	organization database.Organization,
 ) *morgana.DownloadTask {
-	return &morgana.DownloadTask{
+	protoDownloadTask := &morgana.DownloadTask{
 		Id: downloadTask.ID,
 		Account: &morgana.Account{
 			Id:          account.ID,
@@ -120,32 +385,137 @@ func (d downloadTask) databaseDownloadTaskToProtoDownloadTask(
 		},
 		DownloadType:   downloadTask.DownloadType,
 		Url:            downloadTask.URL,
-		DownloadStatus: morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		DownloadStatus: downloadTask.DownloadStatus,
+		FileName:       d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameOriginalFileName),
+		FileSize:       downloadTask.FileSize,
+	}
+
+	if downloadTask.OrganizationID.Valid {
+		protoDownloadTask.Organization = databaseOrganizationToProtoOrganization(organization)
+	}
+
+	return protoDownloadTask
+}
+
+func (d downloadTask) databaseDownloadTaskListToProtoDownloadTaskList(
+	ctx context.Context,
+	downloadTaskList []database.DownloadTask,
+) ([]*morgana.DownloadTask, error) {
+	var err error
+
+	accountMap := make(map[uint64]database.Account)
+	for _, accountID := range lo.Uniq(lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) uint64 {
+		return item.AccountID
+	})) {
+		accountMap[accountID], err = d.accountDataAccessor.GetAccountByID(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	organizationMap := make(map[uint64]database.Organization)
+	for _, organizationID := range lo.Uniq(lo.FilterMap(downloadTaskList, func(item database.DownloadTask, _ int) (uint64, bool) {
+		return item.OrganizationID.V, item.OrganizationID.Valid
+	})) {
+		organizationMap[organizationID], err = d.organizationDataAccessor.GetOrganizationByID(ctx, organizationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) *morgana.DownloadTask {
+		return d.databaseDownloadTaskToProtoDownloadTask(item, accountMap[item.AccountID], organizationMap[item.OrganizationID.V])
+	}), nil
+}
+
+func (d downloadTask) getProtoDownloadTask(ctx context.Context, downloadTask database.DownloadTask) (*morgana.DownloadTask, error) {
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, []database.DownloadTask{downloadTask})
+	if err != nil {
+		return nil, err
+	}
+
+	return protoDownloadTaskList[0], nil
+}
+
+// getDownloadTaskUsageAndQuota returns the usage and quota of an account, or of an organization
+// when organizationID is not zero.
+func (d downloadTask) getDownloadTaskUsageAndQuota(
+	ctx context.Context,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	accountID uint64,
+	organizationID uint64,
+) (database.DownloadTaskUsage, quota, error) {
+	if organizationID != 0 {
+		downloadTaskUsage, err := downloadTaskDataAccessor.GetDownloadTaskUsageOfOrganization(ctx, organizationID)
+		return downloadTaskUsage, d.organizationQuota, err
+	}
+
+	downloadTaskUsage, err := downloadTaskDataAccessor.GetDownloadTaskUsageOfAccount(ctx, accountID)
+	return downloadTaskUsage, d.accountQuota, err
 }
 
 func (d downloadTask) CreateDownloadTask(ctx context.Context, params CreateDownloadTaskParams) (CreateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeTasksWrite)
 	if err != nil {
 		return CreateDownloadTaskOutput{}, err
 	}
 
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	organizationID, err := d.authorizeDownloadTaskOwner(
+		ctx, accountID, params.Owner, morgana.OrganizationRole_ORGANIZATION_ROLE_MEMBER,
+	)
 	if err != nil {
 		return CreateDownloadTaskOutput{}, err
 	}
 
+	downloadTaskMetadata := make(map[string]any)
+	if fileName := sanitizeFileName(params.FileName); fileName != "" {
+		downloadTaskMetadata[downloadTaskMetadataFieldNameOriginalFileName] = fileName
+	}
+
 	downloadTask := database.DownloadTask{
 		AccountID:      accountID,
 		DownloadType:   params.DownloadType,
 		URL:            params.URL,
 		DownloadStatus: morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING,
 		Metadata: database.JSON{
-			Data: make(map[string]interface{}),
+			Data: downloadTaskMetadata,
 		},
+		OrganizationID: sql.Null[uint64]{V: organizationID, Valid: organizationID != 0},
 	}
 
+	var (
+		account      database.Account
+		organization database.Organization
+	)
 	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		// Locking the owner makes concurrent creations check its quota one after the other.
+		var getOwnerErr error
+		if organizationID != 0 {
+			organization, getOwnerErr = d.organizationDataAccessor.WithDatabase(td).GetOrganizationByIDWithXLock(ctx, organizationID)
+			if getOwnerErr != nil {
+				return getOwnerErr
+			}
+
+			account, getOwnerErr = d.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, accountID)
+		} else {
+			account, getOwnerErr = d.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+		}
+
+		if getOwnerErr != nil {
+			return getOwnerErr
+		}
+
+		downloadTaskUsage, ownerQuota, getUsageErr := d.getDownloadTaskUsageAndQuota(
+			ctx, d.downloadTaskDataAccessor.WithDatabase(td), accountID, organizationID,
+		)
+		if getUsageErr != nil {
+			return getUsageErr
+		}
+
+		if checkQuotaErr := ownerQuota.check(downloadTaskUsage); checkQuotaErr != nil {
+			return checkQuotaErr
+		}
+
 		downloadTaskID, createDownloadTaskErr := d.downloadTaskDataAccessor.WithDatabase(td).CreateDownloadTask(ctx, downloadTask)
 		if createDownloadTaskErr != nil {
 			return createDownloadTaskErr
@@ -167,82 +537,129 @@ func (d downloadTask) CreateDownloadTask(ctx context.Context, params CreateDownl
 	}
 
 	return CreateDownloadTaskOutput{
-		DownloadTask: d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account),
+		DownloadTask: d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account, organization),
 	}, nil
 }
 
 func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownloadTaskParams) error {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeTasksWrite)
 	if err != nil {
 		return err
 	}
 
-	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
+	var downloadTask database.DownloadTask
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var getDownloadTaskWithXLockErr error
+		downloadTask, getDownloadTaskWithXLockErr = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
 		if getDownloadTaskWithXLockErr != nil {
 			return getDownloadTaskWithXLockErr
 		}
 
-		if downloadTask.AccountID != accountID {
+		canAccess, canAccessErr := d.canAccessDownloadTask(ctx, downloadTask, accountID, morgana.OrganizationRole_ORGANIZATION_ROLE_MEMBER)
+		if canAccessErr != nil {
+			return canAccessErr
+		}
+
+		if !canAccess {
 			return status.Error(codes.PermissionDenied, "you do not have permission to delete this download task")
 		}
 
 		return d.downloadTaskDataAccessor.WithDatabase(td).DeleteDownloadTask(ctx, params.DownloadTaskID)
 	})
+	if txErr != nil {
+		return txErr
+	}
+
+	if fileName := d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameFileName); fileName != "" {
+		d.deleteDownloadTaskFile(ctx, fileName)
+	}
+
+	return nil
 }
 
-func (d downloadTask) GetDownloadTaskList(ctx context.Context, params GetDownloadTaskListParams) (GetDownloadTaskListOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+func (d downloadTask) deleteDownloadTaskFile(ctx context.Context, fileName string) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("file_name", fileName))
+
+	err := d.fileClient.Delete(ctx, fileName)
 	if err != nil {
-		return GetDownloadTaskListOutput{}, err
+		logger.With(zap.Error(err)).Warn("failed to delete download task file")
 	}
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+}
+
+func (d downloadTask) GetDownloadTaskList(ctx context.Context, params GetDownloadTaskListParams) (GetDownloadTaskListOutput, error) {
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeTasksRead)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
-	totalDownloadTaskCount, err := d.downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, accountID)
+	organizationID, err := d.authorizeDownloadTaskOwner(
+		ctx, accountID, params.Owner, morgana.OrganizationRole_ORGANIZATION_ROLE_VIEWER,
+	)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
-	downloadTaskList, err := d.downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, accountID, params.Offset, params.Limit)
+	var (
+		totalDownloadTaskCount uint64
+		downloadTaskList       []database.DownloadTask
+	)
+	if organizationID != 0 {
+		totalDownloadTaskCount, err = d.downloadTaskDataAccessor.GetDownloadTaskCountOfOrganization(ctx, organizationID)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+
+		downloadTaskList, err = d.downloadTaskDataAccessor.GetDownloadTaskListOfOrganization(ctx, organizationID, params.Offset, params.Limit)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+	} else {
+		totalDownloadTaskCount, err = d.downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, accountID)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+
+		downloadTaskList, err = d.downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, accountID, params.Offset, params.Limit)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+	}
+
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, downloadTaskList)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
 	return GetDownloadTaskListOutput{
 		TotalDownloadTaskCount: totalDownloadTaskCount,
-		DownloadTaskList: lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) *morgana.DownloadTask {
-			return d.databaseDownloadTaskToProtoDownloadTask(item, account)
-		}),
+		DownloadTaskList:       protoDownloadTaskList,
 	}, nil
 }
 
 func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
-	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
-	}
-
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeTasksWrite)
 	if err != nil {
 		return UpdateDownloadTaskOutput{}, err
 	}
 
-	output := UpdateDownloadTaskOutput{}
+	var downloadTask database.DownloadTask
 	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		downloadTask, getDownloadTaskWithLockErr := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
+		var getDownloadTaskWithLockErr error
+		downloadTask, getDownloadTaskWithLockErr = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
 		if getDownloadTaskWithLockErr != nil {
 			return getDownloadTaskWithLockErr
 		}
 
-		if downloadTask.AccountID != accountID {
+		canAccess, canAccessErr := d.canAccessDownloadTask(ctx, downloadTask, accountID, morgana.OrganizationRole_ORGANIZATION_ROLE_MEMBER)
+		if canAccessErr != nil {
+			return canAccessErr
+		}
+
+		if !canAccess {
 			return status.Error(codes.PermissionDenied, "you do not have permission to update this download task")
 		}
 
 		downloadTask.URL = params.URL
-		output.DownloadTask = d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
 
 		return d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
 	})
@@ -251,7 +668,14 @@ func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownl
 		return UpdateDownloadTaskOutput{}, txErr
 	}
 
-	return output, nil
+	protoDownloadTask, err := d.getProtoDownloadTask(ctx, downloadTask)
+	if err != nil {
+		return UpdateDownloadTaskOutput{}, err
+	}
+
+	return UpdateDownloadTaskOutput{
+		DownloadTask: protoDownloadTask,
+	}, nil
 }
 
 func (d downloadTask) updateDownloadTaskStatusFromPendingToDownloading(
@@ -298,6 +722,18 @@ func (d downloadTask) updateDownloadTaskStatusFromPendingToDownloading(
 }
 
 func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error {
+	ctx, span := d.tracer.Start(ctx, "ExecuteDownloadTask", trace.WithAttributes(attribute.Int64("download_task.id", int64(id))))
+	defer span.End()
+
+	// Download tasks are not started once the process is asked to stop, but the ones already
+	// started are given the shutdown timeout to finish instead of being canceled right away.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ctx, cancel := utils.WithoutCancelUntilShutdownDeadline(ctx)
+	defer cancel()
+
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 
 	updated, downloadTask, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, id)
@@ -312,7 +748,7 @@ func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error
 	var downloader Downloader
 	switch downloadTask.DownloadType {
 	case morgana.DownloadType_DOWNLOAD_TYPE_HTTP:
-		downloader = NewHTTPDownloader(downloadTask.URL, d.logger)
+		downloader = NewHTTPDownloader(downloadTask.URL, d.httpClient, d.logger)
 	default:
 		logger.With(zap.Any("download_type", downloadTask.DownloadType)).Error("unsupported download type")
 		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
@@ -320,71 +756,243 @@ func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error
 	}
 
 	fileName := fmt.Sprintf("download_file_%d", id)
-	fileWriteCloser, err := d.fileClient.Write(ctx, fileName)
+	storageFileWriteCloser, err := d.fileClient.Write(ctx, fileName)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get download file writer")
 		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
 		return err
 	}
 
-	defer fileWriteCloser.Close()
+	// Content is compressed before it is encrypted, since ciphertext does not compress.
+	encryptedFileWriteCloser, err := d.fileEncryptor.NewWriteCloser(storageFileWriteCloser)
+	if err != nil {
+		storageFileWriteCloser.Close()
+		d.deleteDownloadTaskFile(ctx, fileName)
+		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
+		return err
+	}
+
+	fileWriteCloser := d.fileCompressor.NewWriteCloser(encryptedFileWriteCloser)
+
+	contentHash := sha256.New()
+	fileByteCountingWriter := &byteCountingWriter{writer: io.MultiWriter(fileWriteCloser, contentHash)}
+
+	// Content addressed storage cannot tell identical files apart from what it stores, since every
+	// file is encrypted with a data key of its own, so it is told the content instead.
+	deduplicatingWriteCloser, isDeduplicating := storageFileWriteCloser.(file.DeduplicatingWriteCloser)
+	if isDeduplicating {
+		deduplicatingWriteCloser.SetStoredContentFunc(func() file.StoredContent {
+			return file.StoredContent{
+				SHA256:         hex.EncodeToString(contentHash.Sum(nil)),
+				Codec:          fileWriteCloser.Codec(),
+				WrappedDataKey: encryptedFileWriteCloser.WrappedDataKey(),
+			}
+		})
+	}
+
+	downloadType := downloadTask.DownloadType.String()
+	downloadResult := metrics.DownloadResultFailed
+	downloadStartTime := time.Now()
+	metrics.DownloadsStartedTotal.WithLabelValues(downloadType).Inc()
+	metrics.ActiveDownloads.WithLabelValues(downloadType).Inc()
+	defer func() {
+		metrics.ActiveDownloads.WithLabelValues(downloadType).Dec()
+		metrics.DownloadsFinishedTotal.WithLabelValues(downloadType, downloadResult).Inc()
+		metrics.DownloadedBytesTotal.WithLabelValues(downloadType).Add(float64(fileByteCountingWriter.byteCount))
+		metrics.DownloadDurationSeconds.
+			WithLabelValues(downloadType, downloadResult).
+			Observe(time.Since(downloadStartTime).Seconds())
+		span.SetAttributes(
+			attribute.String("download.result", downloadResult),
+			attribute.Int64("download.byte_count", int64(fileByteCountingWriter.byteCount)),
+		)
+	}()
+
+	metadata, err := downloader.Download(ctx, fileByteCountingWriter)
+	if err != nil && ctx.Err() != nil {
+		// The download did not finish before the shutdown timeout expired rather than failing, so
+		// it is started over once pending download tasks are executed again.
+		logger.With(zap.Error(err)).Warn("download was interrupted by the shutdown, marking download task as pending")
+		downloadResult = metrics.DownloadResultInterrupted
+		ctx = context.WithoutCancel(ctx)
+		fileWriteCloser.Close()
+		d.deleteDownloadTaskFile(ctx, fileName)
+		d.updateDownloadTaskStatusToPending(ctx, downloadTask)
+		return err
+	}
 
-	metadata, err := downloader.Download(ctx, fileWriteCloser)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to download")
+		if closeErr := fileWriteCloser.Close(); closeErr == nil {
+			d.deleteDownloadTaskFile(ctx, fileName)
+		}
 		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
 		return err
 	}
 
-	metadata["file-name"] = fileName
-	downloadTask.DownloadStatus = morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS
-	downloadTask.Metadata = database.JSON{
-		Data: metadata,
+	// The file must be fully stored before the download task is marked as successful.
+	err = fileWriteCloser.Close()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to close download file writer")
+		d.updateDownloadTaskStatusToFailed(ctx, downloadTask)
+		return err
+	}
+
+	codec, wrappedDataKey := fileWriteCloser.Codec(), encryptedFileWriteCloser.WrappedDataKey()
+	if isDeduplicating {
+		// A file sharing the blob of an earlier one is read with the data key of that blob.
+		storedContent := deduplicatingWriteCloser.StoredContent()
+		codec, wrappedDataKey = storedContent.Codec, storedContent.WrappedDataKey
 	}
-	err = d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask)
+
+	metadata[downloadTaskMetadataFieldNameFileName] = fileName
+	metadata[downloadTaskMetadataFieldNameCodec] = string(codec)
+	d.setDownloadTaskWrappedDataKey(metadata, wrappedDataKey)
+	if originalFileName := d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameOriginalFileName); originalFileName != "" {
+		// The file name provided by the user when creating the task takes precedence over the detected one.
+		metadata[downloadTaskMetadataFieldNameOriginalFileName] = originalFileName
+	}
+
+	updated, err = d.updateDownloadTaskStatusFromDownloading(
+		ctx,
+		id,
+		morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+		metadata,
+		fileByteCountingWriter.byteCount,
+	)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to update download task status to success")
 		return err
 	}
 
+	if !updated {
+		logger.Info("download task was canceled while downloading, discarding downloaded file")
+		downloadResult = metrics.DownloadResultCanceled
+		d.deleteDownloadTaskFile(ctx, fileName)
+		return nil
+	}
+
+	downloadResult = metrics.DownloadResultSuccess
 	logger.Info("download task executed successfully")
 
 	return nil
 }
 
+// updateDownloadTaskStatusFromDownloading finishes the execution of a download task, unless it
+// was canceled in the meantime. The metadata is left unchanged if nil.
+func (d downloadTask) updateDownloadTaskStatusFromDownloading(
+	ctx context.Context,
+	id uint64,
+	downloadStatus morgana.DownloadStatus,
+	metadata map[string]any,
+	fileSize uint64,
+) (bool, error) {
+	updated := false
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if downloadTask.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			return nil
+		}
+
+		downloadTask.DownloadStatus = downloadStatus
+		downloadTask.FileSize = fileSize
+		if metadata != nil {
+			downloadTask.Metadata = database.JSON{
+				Data: metadata,
+			}
+		}
+
+		err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		if err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+
+	return updated, txErr
+}
+
 func (d downloadTask) GetDownloadTaskFile(
 	ctx context.Context,
 	params GetDownloadTaskFileParams,
-) (io.ReadCloser, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+) (GetDownloadTaskFileOutput, error) {
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeFilesRead)
 	if err != nil {
-		return nil, err
+		return GetDownloadTaskFileOutput{}, err
 	}
 
 	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, params.DownloadTaskID)
 	if err != nil {
-		return nil, err
+		return GetDownloadTaskFileOutput{}, err
 	}
 
-	if downloadTask.AccountID != accountID {
-		return nil, status.Error(codes.PermissionDenied, "trying to get file of a download task the account does not own")
+	canAccess, err := d.canAccessDownloadTask(ctx, downloadTask, accountID, morgana.OrganizationRole_ORGANIZATION_ROLE_VIEWER)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	if !canAccess {
+		return GetDownloadTaskFileOutput{}, status.Error(codes.PermissionDenied, "trying to get file of a download task the account does not own")
 	}
 
 	if downloadTask.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS {
-		return nil, status.Error(codes.InvalidArgument, "download task does not have status of success")
+		return GetDownloadTaskFileOutput{}, status.Error(codes.InvalidArgument, "download task does not have status of success")
 	}
 
 	downloadTaskMetadata, ok := downloadTask.Metadata.Data.(map[string]any)
 	if !ok {
-		return nil, status.Error(codes.Internal, "download task metadata is not a map[string]any")
+		return GetDownloadTaskFileOutput{}, status.Error(codes.Internal, "download task metadata is not a map[string]any")
 	}
 
-	fileName, ok := downloadTaskMetadata[downloadTaskMetadataFieldNameFileName]
+	fileName, ok := downloadTaskMetadata[downloadTaskMetadataFieldNameFileName].(string)
 	if !ok {
-		return nil, status.Error(codes.Internal, "download task metadata does not contain file name")
+		return GetDownloadTaskFileOutput{}, status.Error(codes.Internal, "download task metadata does not contain file name")
+	}
+
+	wrappedDataKey, err := d.getDownloadTaskWrappedDataKey(downloadTask)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	storageFileReadCloser, err := d.fileClient.Read(ctx, fileName)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	fileReadCloser, err := d.fileEncryptor.NewReadCloser(storageFileReadCloser, wrappedDataKey)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
 	}
 
-	return d.fileClient.Read(ctx, fileName.(string))
+	output := GetDownloadTaskFileOutput{
+		FileReadCloser: fileReadCloser,
+		FileName:       d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameOriginalFileName),
+		ContentType:    d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameContentType),
+	}
+
+	// Files stored before compression was introduced do not have a codec in their metadata.
+	codec := file.Codec(d.getDownloadTaskMetadataString(downloadTask, downloadTaskMetadataFieldNameCodec))
+	if codec == "" || codec == file.CodecNone {
+		return output, nil
+	}
+
+	if lo.Contains(params.AcceptedContentEncodingList, string(codec)) {
+		output.ContentEncoding = string(codec)
+		return output, nil
+	}
+
+	output.FileReadCloser, err = d.fileCompressor.NewReadCloser(fileReadCloser, codec)
+	if err != nil {
+		return GetDownloadTaskFileOutput{}, err
+	}
+
+	return output, nil
 }
 
 func (d downloadTask) ExecuteAllPendingDownloadTask(ctx context.Context) error {
@@ -407,6 +1015,11 @@ func (d downloadTask) ExecuteAllPendingDownloadTask(ctx context.Context) error {
 	workerPool := workerpool.New(d.cronConfig.ExecuteAllPendingDownloadTask.ConcurrencyLimit)
 	for _, id := range pendingDownloadTaskIDList {
 		workerPool.Submit(func() {
+			// Download tasks that did not start before a shutdown are left pending.
+			if ctx.Err() != nil {
+				return
+			}
+
 			executeDownloadTaskErr := d.ExecuteDownloadTask(ctx, id)
 			if executeDownloadTaskErr != nil {
 				logger.
@@ -425,13 +1038,244 @@ func (d downloadTask) ExecuteAllPendingDownloadTask(ctx context.Context) error {
 func (d downloadTask) updateDownloadTaskStatusToFailed(ctx context.Context, downloadTask database.DownloadTask) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
 
-	downloadTask.DownloadStatus = morgana.DownloadStatus_DOWNLOAD_STATUS_FAILED
-	updateDownloadTaskErr := d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask)
+	_, updateDownloadTaskErr := d.updateDownloadTaskStatusFromDownloading(
+		ctx,
+		downloadTask.ID,
+		morgana.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+		nil,
+		0,
+	)
 	if updateDownloadTaskErr != nil {
 		logger.With(zap.Error(updateDownloadTaskErr)).Warn("failed to update download task status to failed")
 	}
 }
 
+func (d downloadTask) updateDownloadTaskStatusToPending(ctx context.Context, downloadTask database.DownloadTask) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	_, updateDownloadTaskErr := d.updateDownloadTaskStatusFromDownloading(
+		ctx,
+		downloadTask.ID,
+		morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		nil,
+		0,
+	)
+	if updateDownloadTaskErr != nil {
+		logger.With(zap.Error(updateDownloadTaskErr)).Warn("failed to update download task status to pending")
+	}
+}
+
 func (d downloadTask) UpdateDownloadingAndFailedDownloadTaskStatusToPending(ctx context.Context) error {
 	return d.downloadTaskDataAccessor.UpdateDownloadingAndFailedDownloadTaskStatusToPending(ctx)
 }
+
+func (d downloadTask) rewrapDownloadTaskDataKey(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	// The task is only locked if its data key has to be wrapped again, which after a rotation has
+	// completed is none of them.
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	wrappedDataKey, err := d.getDownloadTaskWrappedDataKey(downloadTask)
+	if err != nil {
+		return err
+	}
+
+	if !d.fileEncryptor.NeedsRewrapDataKey(wrappedDataKey) {
+		return nil
+	}
+
+	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		wrappedDataKey, err := d.getDownloadTaskWrappedDataKey(downloadTask)
+		if err != nil {
+			return err
+		}
+
+		rewrappedDataKey, rewrapped, err := d.fileEncryptor.RewrapDataKey(wrappedDataKey)
+		if err != nil {
+			return err
+		}
+
+		if !rewrapped {
+			return nil
+		}
+
+		metadata := d.getDownloadTaskMetadata(downloadTask)
+		d.setDownloadTaskWrappedDataKey(metadata, rewrappedDataKey)
+		downloadTask.Metadata = database.JSON{
+			Data: metadata,
+		}
+
+		err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		if err != nil {
+			return err
+		}
+
+		logger.
+			With(zap.String("previous_master_key_id", wrappedDataKey.MasterKeyID)).
+			With(zap.String("master_key_id", rewrappedDataKey.MasterKeyID)).
+			Info("rewrapped download task data key")
+		return nil
+	})
+}
+
+// RewrapAllDownloadTaskDataKey wraps the data keys of all stored files with the active master key,
+// so that previous master keys can be retired after a rotation. File contents are left untouched.
+func (d downloadTask) RewrapAllDownloadTaskDataKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	if !d.fileEncryptor.CanRewrapDataKeys() {
+		logger.Debug("no previous master key configured, skipping rewrapping download task data keys")
+		return nil
+	}
+
+	afterID := uint64(0)
+	for {
+		downloadTaskIDList, err := d.downloadTaskDataAccessor.GetSuccessfulDownloadTaskIDList(
+			ctx, afterID, rewrapDownloadTaskDataKeyBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range downloadTaskIDList {
+			err = d.rewrapDownloadTaskDataKey(ctx, id)
+			if err != nil {
+				logger.With(zap.Uint64("id", id)).With(zap.Error(err)).Error("failed to rewrap download task data key")
+				return err
+			}
+		}
+
+		if len(downloadTaskIDList) < rewrapDownloadTaskDataKeyBatchSize {
+			return nil
+		}
+
+		afterID = downloadTaskIDList[len(downloadTaskIDList)-1]
+	}
+}
+
+func (d downloadTask) GetDownloadTaskListOfAllAccounts(
+	ctx context.Context,
+	params GetDownloadTaskListOfAllAccountsParams,
+) (GetDownloadTaskListOutput, error) {
+	filter := database.DownloadTaskFilter{
+		AccountID:      params.AccountID,
+		DownloadStatus: params.DownloadStatus,
+	}
+
+	totalDownloadTaskCount, err := d.downloadTaskDataAccessor.GetDownloadTaskCount(ctx, filter)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	downloadTaskList, err := d.downloadTaskDataAccessor.GetDownloadTaskList(ctx, filter, params.Offset, params.Limit)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, downloadTaskList)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	return GetDownloadTaskListOutput{
+		TotalDownloadTaskCount: totalDownloadTaskCount,
+		DownloadTaskList:       protoDownloadTaskList,
+	}, nil
+}
+
+// RetryDownloadTask queues a download task again regardless of how many times it failed. A task
+// that is still downloading has to be canceled first, so that two executions never write the same
+// file.
+func (d downloadTask) RetryDownloadTask(ctx context.Context, id uint64) (*morgana.DownloadTask, error) {
+	var downloadTask database.DownloadTask
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch downloadTask.DownloadStatus {
+		case morgana.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING:
+			return status.Error(codes.FailedPrecondition, "download task is downloading, cancel it before retrying")
+		case morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS:
+			return status.Error(codes.FailedPrecondition, "download task has already succeeded")
+		}
+
+		downloadTask.DownloadStatus = morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING
+		err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		if err != nil {
+			return err
+		}
+
+		return d.downloadTaskCreatedProducer.Produce(ctx, producer.DownloadTaskCreated{
+			ID: downloadTask.ID,
+		})
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+
+	return d.getProtoDownloadTask(ctx, downloadTask)
+}
+
+// CancelDownloadTask stops a download task from being executed. A task that is already downloading
+// runs to completion, but its file is discarded instead of being marked as successful.
+func (d downloadTask) CancelDownloadTask(ctx context.Context, id uint64) (*morgana.DownloadTask, error) {
+	var downloadTask database.DownloadTask
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if downloadTask.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING &&
+			downloadTask.DownloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			return status.Error(codes.FailedPrecondition, "only pending or downloading download tasks can be canceled")
+		}
+
+		downloadTask.DownloadStatus = morgana.DownloadStatus_DOWNLOAD_STATUS_CANCELED
+		return d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+
+	return d.getProtoDownloadTask(ctx, downloadTask)
+}
+
+func (d downloadTask) GetUsage(ctx context.Context, params GetUsageParams) (GetUsageOutput, error) {
+	accountID, err := d.getAccountID(ctx, params.Token, APIKeyScopeTasksRead)
+	if err != nil {
+		return GetUsageOutput{}, err
+	}
+
+	organizationID, err := d.authorizeDownloadTaskOwner(
+		ctx, accountID, params.Owner, morgana.OrganizationRole_ORGANIZATION_ROLE_VIEWER,
+	)
+	if err != nil {
+		return GetUsageOutput{}, err
+	}
+
+	downloadTaskUsage, ownerQuota, err := d.getDownloadTaskUsageAndQuota(ctx, d.downloadTaskDataAccessor, accountID, organizationID)
+	if err != nil {
+		return GetUsageOutput{}, err
+	}
+
+	return GetUsageOutput{
+		DownloadTaskCount:    downloadTaskUsage.DownloadTaskCount,
+		TotalFileSize:        downloadTaskUsage.TotalFileSize,
+		MaxDownloadTaskCount: ownerQuota.maxDownloadTaskCount,
+		MaxTotalFileSize:     ownerQuota.maxTotalFileSize,
+	}, nil
+}
@@ -0,0 +1,396 @@
+package logic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/dataaccess/file"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+// fakeToken authenticates every token as the account with accountID.
+type fakeToken struct {
+	Token
+
+	accountID uint64
+}
+
+func (f *fakeToken) GetAccountIDAndExpireTime(context.Context, string) (uint64, time.Time, error) {
+	return f.accountID, time.Now().Add(time.Hour), nil
+}
+
+func (f *fakeToken) GetTokenClaims(context.Context, string) (TokenClaims, error) {
+	return TokenClaims{AccountID: f.accountID, ExpireTime: time.Now().Add(time.Hour)}, nil
+}
+
+// newTestDownloadTask returns a download task logic backed by a temporary SQLite database and
+// download directory, with only what executing download tasks and reading their files needs.
+func newTestDownloadTask(t *testing.T, downloadConfig configs.Download) downloadTask {
+	t.Helper()
+
+	goquDatabase := newTestGoquDatabase(t)
+	logger := zap.NewNop()
+	downloadConfig.Mode = configs.DownloadModeLocal
+	if downloadConfig.DownloadDirectory == "" {
+		downloadConfig.DownloadDirectory = t.TempDir()
+	}
+
+	fileClient, err := file.NewClient(
+		downloadConfig,
+		goquDatabase,
+		database.NewBlobDataAccessor(goquDatabase, logger),
+		database.NewBlobReferenceDataAccessor(goquDatabase, logger),
+		logger,
+	)
+	if err != nil {
+		t.Fatalf("failed to create file client: %v", err)
+	}
+
+	fileCompressor, err := file.NewCompressor(downloadConfig, logger)
+	if err != nil {
+		t.Fatalf("failed to create file compressor: %v", err)
+	}
+
+	fileEncryptor, err := file.NewEncryptor(downloadConfig, logger)
+	if err != nil {
+		t.Fatalf("failed to create file encryptor: %v", err)
+	}
+
+	return downloadTask{
+		tokenLogic:               &fakeToken{},
+		accountDataAccessor:      database.NewAccountDataAccessor(goquDatabase, logger),
+		downloadTaskDataAccessor: database.NewDownloadTaskDataAccessor(goquDatabase, logger),
+		goquDatabase:             goquDatabase,
+		fileClient:               fileClient,
+		fileCompressor:           fileCompressor,
+		fileEncryptor:            fileEncryptor,
+		httpClient:               http.DefaultClient,
+		tracer:                   noop.NewTracerProvider().Tracer(tracerName),
+		logger:                   logger,
+	}
+}
+
+// newTestEncryptionConfig returns an encryption config with a random master key.
+func newTestEncryptionConfig(t *testing.T) configs.DownloadEncryption {
+	t.Helper()
+
+	masterKey := make([]byte, 32)
+	if _, err := rand.Read(masterKey); err != nil {
+		t.Fatalf("failed to generate master key: %v", err)
+	}
+
+	return configs.DownloadEncryption{
+		Enabled:           true,
+		ActiveMasterKeyID: "test",
+		MasterKeys: []configs.DownloadEncryptionMasterKey{
+			{ID: "test", Key: base64.StdEncoding.EncodeToString(masterKey)},
+		},
+	}
+}
+
+// readTestDownloadTaskFile reads the file of a download task the way it is served to its owner,
+// decompressed unless the owner accepts acceptedContentEncodingList.
+func readTestDownloadTaskFile(t *testing.T, d downloadTask, id uint64, acceptedContentEncodingList ...string) (GetDownloadTaskFileOutput, []byte) {
+	t.Helper()
+
+	ctx := context.Background()
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, id)
+	if err != nil {
+		t.Fatalf("failed to get download task: %v", err)
+	}
+
+	d.tokenLogic = &fakeToken{accountID: downloadTask.AccountID}
+	output, err := d.GetDownloadTaskFile(ctx, GetDownloadTaskFileParams{
+		DownloadTaskID:              id,
+		AcceptedContentEncodingList: acceptedContentEncodingList,
+	})
+	if err != nil {
+		t.Fatalf("failed to get download task file: %v", err)
+	}
+	defer output.FileReadCloser.Close()
+
+	content, err := io.ReadAll(output.FileReadCloser)
+	if err != nil {
+		t.Fatalf("failed to read download task file: %v", err)
+	}
+
+	return output, content
+}
+
+// startFileServer starts an HTTP server that serves content as a file of the given content type.
+func startFileServer(t *testing.T, contentType string, content []byte) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Write(content)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func createTestDownloadTask(t *testing.T, d downloadTask, url string) uint64 {
+	t.Helper()
+
+	ctx := context.Background()
+	account, err := d.accountDataAccessor.GetAccountByAccountName(ctx, "user")
+	if errors.Is(err, database.ErrAccountNotFound) {
+		account.ID, err = d.accountDataAccessor.CreateAccount(ctx, database.Account{AccountName: "user"})
+	}
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	id, err := d.downloadTaskDataAccessor.CreateDownloadTask(ctx, database.DownloadTask{
+		AccountID:      account.ID,
+		DownloadType:   morgana.DownloadType_DOWNLOAD_TYPE_HTTP,
+		URL:            url,
+		DownloadStatus: morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		Metadata:       database.JSON{Data: map[string]any{}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create download task: %v", err)
+	}
+
+	return id
+}
+
+// startSlowFileServer starts an HTTP server that sends the headers of a file right away, and its
+// content once release is closed or the request is canceled.
+func startSlowFileServer(t *testing.T) (string, <-chan struct{}, chan<- struct{}) {
+	t.Helper()
+
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		requested <- struct{}{}
+
+		select {
+		case <-release:
+			w.Write([]byte("content"))
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, requested, release
+}
+
+// startTestShutdown returns the context a role runs under, as set up by the runner, along with
+// the functions delivering the shutdown signal and expiring the shutdown timeout.
+func startTestShutdown(t *testing.T) (context.Context, context.CancelFunc, context.CancelFunc) {
+	t.Helper()
+
+	shutdownDeadlineCtx, expireShutdownTimeout := context.WithCancel(context.Background())
+	t.Cleanup(expireShutdownTimeout)
+
+	ctx, signal := context.WithCancel(utils.WithShutdownDeadline(context.Background(), shutdownDeadlineCtx))
+	t.Cleanup(signal)
+
+	return ctx, signal, expireShutdownTimeout
+}
+
+func getTestDownloadTaskStatus(t *testing.T, d downloadTask, id uint64) morgana.DownloadStatus {
+	t.Helper()
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(context.Background(), id)
+	if err != nil {
+		t.Fatalf("failed to get download task: %v", err)
+	}
+
+	return downloadTask.DownloadStatus
+}
+
+func TestExecuteDownloadTaskFinishesAfterShutdownSignal(t *testing.T) {
+	d := newTestDownloadTask(t, configs.Download{})
+	url, requested, release := startSlowFileServer(t)
+	id := createTestDownloadTask(t, d, url)
+	ctx, signal, _ := startTestShutdown(t)
+
+	executeErr := make(chan error, 1)
+	go func() {
+		executeErr <- d.ExecuteDownloadTask(ctx, id)
+	}()
+
+	<-requested
+	signal()
+	close(release)
+
+	if err := <-executeErr; err != nil {
+		t.Fatalf("expected download in progress to finish after the shutdown signal, got %v", err)
+	}
+
+	if downloadStatus := getTestDownloadTaskStatus(t, d, id); downloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_SUCCESS {
+		t.Fatalf("expected download task to succeed, got %s", downloadStatus)
+	}
+
+	// Download tasks are no longer started once the shutdown signal was received.
+	pendingID := createTestDownloadTask(t, d, url)
+	if err := d.ExecuteDownloadTask(ctx, pendingID); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected download task not to start after the shutdown signal, got %v", err)
+	}
+
+	if downloadStatus := getTestDownloadTaskStatus(t, d, pendingID); downloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING {
+		t.Fatalf("expected download task to be left pending, got %s", downloadStatus)
+	}
+}
+
+func TestExecuteDownloadTaskInterruptedByShutdownTimeout(t *testing.T) {
+	d := newTestDownloadTask(t, configs.Download{})
+	url, requested, _ := startSlowFileServer(t)
+	id := createTestDownloadTask(t, d, url)
+	ctx, signal, expireShutdownTimeout := startTestShutdown(t)
+
+	executeErr := make(chan error, 1)
+	go func() {
+		executeErr <- d.ExecuteDownloadTask(ctx, id)
+	}()
+
+	<-requested
+	signal()
+
+	select {
+	case err := <-executeErr:
+		t.Fatalf("expected download to keep running until the shutdown timeout expires, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	expireShutdownTimeout()
+	if err := <-executeErr; err == nil {
+		t.Fatalf("expected download to be interrupted by the shutdown timeout")
+	}
+
+	if downloadStatus := getTestDownloadTaskStatus(t, d, id); downloadStatus != morgana.DownloadStatus_DOWNLOAD_STATUS_PENDING {
+		t.Fatalf("expected interrupted download task to be pending, got %s", downloadStatus)
+	}
+}
+
+func TestExecuteDownloadTaskDeduplicatesEncryptedContent(t *testing.T) {
+	downloadDirectory := t.TempDir()
+	d := newTestDownloadTask(t, configs.Download{
+		DownloadDirectory: downloadDirectory,
+		StorageLayout:     configs.DownloadStorageLayoutContentAddressed,
+		Compression:       configs.DownloadCompression{Codec: configs.DownloadCompressionCodecGzip},
+		Encryption:        newTestEncryptionConfig(t),
+	})
+	content := []byte(strings.Repeat("identical content\n", 1024))
+	url := startFileServer(t, "text/plain", content)
+
+	idList := []uint64{createTestDownloadTask(t, d, url), createTestDownloadTask(t, d, url)}
+	for _, id := range idList {
+		if err := d.ExecuteDownloadTask(context.Background(), id); err != nil {
+			t.Fatalf("failed to execute download task: %v", err)
+		}
+	}
+
+	// Both files are stored in a single blob, which is only readable with the data key of the first
+	// upload.
+	entryList, err := os.ReadDir(downloadDirectory)
+	if err != nil {
+		t.Fatalf("failed to read download directory: %v", err)
+	}
+
+	if len(entryList) != 1 {
+		t.Fatalf("expected identical downloads to share 1 blob, %d files are stored", len(entryList))
+	}
+
+	for _, id := range idList {
+		if _, fileContent := readTestDownloadTaskFile(t, d, id); !bytes.Equal(fileContent, content) {
+			t.Fatalf("unexpected content of download task %d", id)
+		}
+	}
+
+	// The blob is kept until no file points to it anymore.
+	d.deleteDownloadTaskFile(context.Background(), fmt.Sprintf("download_file_%d", idList[0]))
+	if _, fileContent := readTestDownloadTaskFile(t, d, idList[1]); !bytes.Equal(fileContent, content) {
+		t.Fatalf("unexpected content of download task %d after the other file was deleted", idList[1])
+	}
+}
+
+func TestGetDownloadTaskFileCompressedContent(t *testing.T) {
+	d := newTestDownloadTask(t, configs.Download{
+		Compression: configs.DownloadCompression{Codec: configs.DownloadCompressionCodecGzip},
+	})
+	content := []byte(strings.Repeat("compressible content\n", 1024))
+	id := createTestDownloadTask(t, d, startFileServer(t, "text/plain", content))
+	if err := d.ExecuteDownloadTask(context.Background(), id); err != nil {
+		t.Fatalf("failed to execute download task: %v", err)
+	}
+
+	// Clients that accept the codec of the file get it as stored.
+	output, storedContent := readTestDownloadTaskFile(t, d, id, "br", "gzip")
+	if output.ContentEncoding != "gzip" {
+		t.Fatalf("expected gzip content encoding, got %q", output.ContentEncoding)
+	}
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(storedContent))
+	if err != nil {
+		t.Fatalf("expected gzip compressed content: %v", err)
+	}
+
+	if decompressedContent, err := io.ReadAll(gzipReader); err != nil || !bytes.Equal(decompressedContent, content) {
+		t.Fatalf("unexpected content of gzip compressed file: %v", err)
+	}
+
+	// Other clients get it decompressed.
+	output, fileContent := readTestDownloadTaskFile(t, d, id, "zstd")
+	if output.ContentEncoding != "" || !bytes.Equal(fileContent, content) {
+		t.Fatalf("expected decompressed content, got content encoding %q and %d bytes", output.ContentEncoding, len(fileContent))
+	}
+}
+
+func TestRewrapAllDownloadTaskDataKeyKeepsFilesReadable(t *testing.T) {
+	oldEncryptionConfig, newEncryptionConfig := newTestEncryptionConfig(t), newTestEncryptionConfig(t)
+	oldEncryptionConfig.ActiveMasterKeyID, oldEncryptionConfig.MasterKeys[0].ID = "old", "old"
+	newEncryptionConfig.ActiveMasterKeyID, newEncryptionConfig.MasterKeys[0].ID = "new", "new"
+
+	d := newTestDownloadTask(t, configs.Download{Encryption: oldEncryptionConfig})
+	content := []byte(strings.Repeat("encrypted content\n", 1024))
+	id := createTestDownloadTask(t, d, startFileServer(t, "text/plain", content))
+	if err := d.ExecuteDownloadTask(context.Background(), id); err != nil {
+		t.Fatalf("failed to execute download task: %v", err)
+	}
+
+	newTestEncryptor := func(encryptionConfig configs.DownloadEncryption) file.Encryptor {
+		fileEncryptor, err := file.NewEncryptor(configs.Download{Encryption: encryptionConfig}, zap.NewNop())
+		if err != nil {
+			t.Fatalf("failed to create file encryptor: %v", err)
+		}
+
+		return fileEncryptor
+	}
+
+	// The new master key is made active, while the old one is kept until the data keys are rewrapped.
+	rotatedEncryptionConfig := newEncryptionConfig
+	rotatedEncryptionConfig.MasterKeys = append(rotatedEncryptionConfig.MasterKeys, oldEncryptionConfig.MasterKeys...)
+	d.fileEncryptor = newTestEncryptor(rotatedEncryptionConfig)
+	if err := d.RewrapAllDownloadTaskDataKey(context.Background()); err != nil {
+		t.Fatalf("failed to rewrap data keys: %v", err)
+	}
+
+	d.fileEncryptor = newTestEncryptor(newEncryptionConfig)
+	if _, fileContent := readTestDownloadTaskFile(t, d, id); !bytes.Equal(fileContent, content) {
+		t.Fatalf("unexpected content after rewrapping the data key")
+	}
+}
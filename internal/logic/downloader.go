@@ -10,8 +10,9 @@ import (
 )
 
 const (
-	HTTPResponseHeaderContentType = "Content-Type"
-	HTTPMetadataKeyContentType    = "content-type"
+	HTTPResponseHeaderContentType   = "Content-Type"
+	HTTPMetadataKeyContentType      = "content-type"
+	HTTPMetadataKeyOriginalFileName = "original-file-name"
 )
 
 type Downloader interface {
@@ -19,17 +20,20 @@ type Downloader interface {
 }
 
 type HTTPDownloader struct {
-	url    string
-	logger *zap.Logger
+	url        string
+	httpClient *http.Client
+	logger     *zap.Logger
 }
 
 func NewHTTPDownloader(
 	url string,
+	httpClient *http.Client,
 	logger *zap.Logger,
 ) Downloader {
 	return &HTTPDownloader{
-		url:    url,
-		logger: logger,
+		url:        url,
+		httpClient: httpClient,
+		logger:     logger,
 	}
 }
 
@@ -42,7 +46,7 @@ func (h HTTPDownloader) Download(ctx context.Context, writer io.Writer) (map[str
 		return nil, err
 	}
 
-	response, err := http.DefaultClient.Do(request)
+	response, err := h.httpClient.Do(request)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to make http get request")
 		return nil, err
@@ -56,7 +60,8 @@ func (h HTTPDownloader) Download(ctx context.Context, writer io.Writer) (map[str
 	}
 
 	metadata := map[string]any{
-		HTTPMetadataKeyContentType: response.Header.Get(HTTPResponseHeaderContentType),
+		HTTPMetadataKeyContentType:      response.Header.Get(HTTPResponseHeaderContentType),
+		HTTPMetadataKeyOriginalFileName: getHTTPResponseFileName(response),
 	}
 
 	return metadata, nil
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	encryptionKeyByteCount = 32
+)
+
+// getEncryptionAEAD returns an AES-GCM AEAD for a base64 encoded 32 byte key. The key is read from
+// encryptionKeyFile when it is set, then taken from encodedKey, and finally from the environment
+// variable encryptionKeyEnvName, so that no key has to be committed along with the config.
+func getEncryptionAEAD(encodedKey, encryptionKeyFile, encryptionKeyEnvName string) (cipher.AEAD, error) {
+	if encryptionKeyFile != "" {
+		keyFileBytes, err := os.ReadFile(encryptionKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+		}
+
+		encodedKey = string(keyFileBytes)
+	}
+
+	if encodedKey == "" && encryptionKeyEnvName != "" {
+		encodedKey = os.Getenv(encryptionKeyEnvName)
+	}
+
+	if strings.TrimSpace(encodedKey) == "" {
+		if encryptionKeyEnvName == "" {
+			return nil, errors.New("no encryption key configured, set encryption_key_file")
+		}
+
+		return nil, fmt.Errorf("no encryption key configured, set encryption_key_file or %s", encryptionKeyEnvName)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key: %w", err)
+	}
+
+	if len(key) != encryptionKeyByteCount {
+		return nil, fmt.Errorf("encryption key must be %d bytes long", encryptionKeyByteCount)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// sealWithRandomNonce encrypts data with a random nonce, which is put in front of the result.
+func sealWithRandomNonce(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, data, additionalData), nil
+}
+
+// openWithPrependedNonce decrypts data sealed by sealWithRandomNonce.
+func openWithPrependedNonce(aead cipher.AEAD, encryptedData, additionalData []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(encryptedData) < nonceSize {
+		return nil, errors.New("encrypted data is too short")
+	}
+
+	return aead.Open(nil, encryptedData[:nonceSize], encryptedData[nonceSize:], additionalData)
+}
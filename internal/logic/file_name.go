@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	HTTPResponseHeaderContentDisposition = "Content-Disposition"
+
+	defaultFileNameWithoutExtension = "download"
+	maxFileNameByteCount            = 255
+	fileNameReservedCharacters      = `<>:"/\|?*`
+)
+
+// preferredFileExtensionMap holds the usual extension of common media types, since
+// mime.ExtensionsByType sorts extensions alphabetically and would name a text/plain file .asc or
+// an image/jpeg one .jfif.
+var preferredFileExtensionMap = map[string]string{
+	"application/gzip":   ".gz",
+	"application/json":   ".json",
+	"application/msword": ".doc",
+	"application/pdf":    ".pdf",
+	"application/xml":    ".xml",
+	"application/zip":    ".zip",
+	"audio/mpeg":         ".mp3",
+	"image/gif":          ".gif",
+	"image/jpeg":         ".jpg",
+	"image/png":          ".png",
+	"image/svg+xml":      ".svg",
+	"image/tiff":         ".tif",
+	"image/webp":         ".webp",
+	"text/csv":           ".csv",
+	"text/html":          ".html",
+	"text/javascript":    ".js",
+	"text/markdown":      ".md",
+	"text/plain":         ".txt",
+	"text/xml":           ".xml",
+	"video/mp4":          ".mp4",
+	"video/mpeg":         ".mpeg",
+	"video/quicktime":    ".mov",
+}
+
+// sanitizeFileName turns an untrusted file name into one that is safe to use as a single path
+// component. It returns an empty string if nothing usable is left.
+func sanitizeFileName(fileName string) string {
+	fileName = strings.ToValidUTF8(fileName, "_")
+	fileName = strings.ReplaceAll(fileName, `\`, "/")
+	fileName = path.Base(fileName)
+	if fileName == "/" {
+		return ""
+	}
+
+	fileName = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(fileNameReservedCharacters, r) {
+			return '_'
+		}
+		return r
+	}, fileName)
+	fileName = strings.Trim(fileName, " .")
+
+	if len(fileName) <= maxFileNameByteCount {
+		return fileName
+	}
+
+	extension := path.Ext(fileName)
+	if len(extension) >= maxFileNameByteCount {
+		extension = ""
+	}
+
+	baseName := fileName[:maxFileNameByteCount-len(extension)]
+	for !utf8.ValidString(baseName) {
+		baseName = baseName[:len(baseName)-1]
+	}
+
+	return baseName + extension
+}
+
+func getFileExtensionFromContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	if extension, ok := preferredFileExtensionMap[mediaType]; ok {
+		return extension
+	}
+
+	extensionList, err := mime.ExtensionsByType(mediaType)
+	if err != nil || len(extensionList) == 0 {
+		return ""
+	}
+
+	return extensionList[0]
+}
+
+func getFileNameFromContentDisposition(contentDisposition string) string {
+	if contentDisposition == "" {
+		return ""
+	}
+
+	// mime.ParseMediaType decodes RFC 2231/5987 extended parameters, and prefers filename* over
+	// filename when both are present.
+	_, params, err := mime.ParseMediaType(contentDisposition)
+	if err != nil {
+		return ""
+	}
+
+	return sanitizeFileName(params["filename"])
+}
+
+// getHTTPResponseFileName derives the original file name of an HTTP download, in order of
+// preference from the Content-Disposition header, the path of the final (post-redirect) request
+// URL, and finally a generic name with an extension matching the response content type.
+func getHTTPResponseFileName(response *http.Response) string {
+	if fileName := getFileNameFromContentDisposition(response.Header.Get(HTTPResponseHeaderContentDisposition)); fileName != "" {
+		return fileName
+	}
+
+	extension := getFileExtensionFromContentType(response.Header.Get(HTTPResponseHeaderContentType))
+
+	if response.Request != nil && response.Request.URL != nil {
+		if fileName := sanitizeFileName(response.Request.URL.Path); fileName != "" {
+			if path.Ext(fileName) == "" {
+				fileName = sanitizeFileName(fileName + extension)
+			}
+			return fileName
+		}
+	}
+
+	return defaultFileNameWithoutExtension + extension
+}
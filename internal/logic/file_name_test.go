@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeFileName(t *testing.T) {
+	for _, testCase := range []struct {
+		fileName         string
+		expectedFileName string
+	}{
+		{fileName: "report.pdf", expectedFileName: "report.pdf"},
+		{fileName: "../../etc/passwd", expectedFileName: "passwd"},
+		{fileName: `..\..\windows\system.ini`, expectedFileName: "system.ini"},
+		{fileName: "/", expectedFileName: ""},
+		{fileName: "..", expectedFileName: ""},
+		{fileName: "dir/..", expectedFileName: ""},
+		{fileName: " .hidden. ", expectedFileName: "hidden"},
+		{fileName: "line\nbreak\x00.txt", expectedFileName: "line_break_.txt"},
+		{fileName: `what?<is>"this"|*.txt`, expectedFileName: "what__is__this___.txt"},
+		{fileName: "invalid\xffutf8.txt", expectedFileName: "invalid_utf8.txt"},
+		{fileName: "résumé.pdf", expectedFileName: "résumé.pdf"},
+	} {
+		if fileName := sanitizeFileName(testCase.fileName); fileName != testCase.expectedFileName {
+			t.Errorf("sanitizeFileName(%q) = %q, expected %q", testCase.fileName, fileName, testCase.expectedFileName)
+		}
+	}
+}
+
+func TestSanitizeFileNameTruncatesLongNames(t *testing.T) {
+	// Truncation keeps the extension and does not cut a multi-byte character in half.
+	fileName := sanitizeFileName(strings.Repeat("é", 200) + ".txt")
+	if len(fileName) > maxFileNameByteCount || !strings.HasSuffix(fileName, ".txt") || strings.ContainsRune(fileName, '�') {
+		t.Fatalf("unexpected truncated file name %q of %d bytes", fileName, len(fileName))
+	}
+}
+
+func TestGetFileExtensionFromContentType(t *testing.T) {
+	for _, testCase := range []struct {
+		contentType       string
+		expectedExtension string
+	}{
+		{contentType: "text/plain; charset=utf-8", expectedExtension: ".txt"},
+		{contentType: "image/jpeg", expectedExtension: ".jpg"},
+		{contentType: "TEXT/HTML", expectedExtension: ".html"},
+		{contentType: "application/pdf", expectedExtension: ".pdf"},
+		{contentType: "application/x-unknown-type", expectedExtension: ""},
+		{contentType: "not a content type", expectedExtension: ""},
+		{contentType: "", expectedExtension: ""},
+	} {
+		if extension := getFileExtensionFromContentType(testCase.contentType); extension != testCase.expectedExtension {
+			t.Errorf("getFileExtensionFromContentType(%q) = %q, expected %q", testCase.contentType, extension, testCase.expectedExtension)
+		}
+	}
+}
+
+func TestGetFileNameFromContentDisposition(t *testing.T) {
+	for _, testCase := range []struct {
+		contentDisposition string
+		expectedFileName   string
+	}{
+		{contentDisposition: `attachment; filename="report.pdf"`, expectedFileName: "report.pdf"},
+		{contentDisposition: `attachment; filename=report.pdf`, expectedFileName: "report.pdf"},
+		{contentDisposition: `attachment; filename*=UTF-8''r%C3%A9sum%C3%A9.pdf`, expectedFileName: "résumé.pdf"},
+		{
+			contentDisposition: `attachment; filename="fallback.pdf"; filename*=UTF-8''r%C3%A9sum%C3%A9.pdf`,
+			expectedFileName:   "résumé.pdf",
+		},
+		{contentDisposition: `attachment; filename="../../etc/passwd"`, expectedFileName: "passwd"},
+		{contentDisposition: `attachment`, expectedFileName: ""},
+		{contentDisposition: `inline; filename=`, expectedFileName: ""},
+		{contentDisposition: "", expectedFileName: ""},
+	} {
+		if fileName := getFileNameFromContentDisposition(testCase.contentDisposition); fileName != testCase.expectedFileName {
+			t.Errorf("getFileNameFromContentDisposition(%q) = %q, expected %q", testCase.contentDisposition, fileName, testCase.expectedFileName)
+		}
+	}
+}
+
+func TestGetHTTPResponseFileName(t *testing.T) {
+	for _, testCase := range []struct {
+		name               string
+		requestURL         string
+		contentType        string
+		contentDisposition string
+		expectedFileName   string
+	}{
+		{
+			name:               "content disposition takes precedence",
+			requestURL:         "https://example.com/files/1234",
+			contentDisposition: `attachment; filename="report.pdf"`,
+			expectedFileName:   "report.pdf",
+		},
+		{
+			name:             "url path",
+			requestURL:       "https://example.com/files/report.pdf?token=secret",
+			contentType:      "text/plain",
+			expectedFileName: "report.pdf",
+		},
+		{
+			name:             "escaped url path",
+			requestURL:       "https://example.com/files/my%20report.pdf",
+			expectedFileName: "my report.pdf",
+		},
+		{
+			name:             "url path without extension",
+			requestURL:       "https://example.com/files/report",
+			contentType:      "image/jpeg",
+			expectedFileName: "report.jpg",
+		},
+		{
+			name:             "url without path",
+			requestURL:       "https://example.com/",
+			contentType:      "text/plain; charset=utf-8",
+			expectedFileName: "download.txt",
+		},
+		{
+			name:             "url without path and unknown content type",
+			requestURL:       "https://example.com",
+			expectedFileName: "download",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			requestURL, err := url.Parse(testCase.requestURL)
+			if err != nil {
+				t.Fatalf("failed to parse url: %v", err)
+			}
+
+			response := &http.Response{
+				Header:  http.Header{},
+				Request: &http.Request{URL: requestURL},
+			}
+			if testCase.contentType != "" {
+				response.Header.Set(HTTPResponseHeaderContentType, testCase.contentType)
+			}
+			if testCase.contentDisposition != "" {
+				response.Header.Set(HTTPResponseHeaderContentDisposition, testCase.contentDisposition)
+			}
+
+			if fileName := getHTTPResponseFileName(response); fileName != testCase.expectedFileName {
+				t.Fatalf("expected file name %q, got %q", testCase.expectedFileName, fileName)
+			}
+		})
+	}
+}
@@ -2,44 +2,97 @@ package logic
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/hoangdv99/morgana/internal/configs"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var (
+	errUnknownHashAlgorithm = status.Error(codes.Internal, "hash was made with an unknown algorithm")
+)
+
 type Hash interface {
 	Hash(ctx context.Context, data string) (string, error)
 	IsHashEqual(ctx context.Context, data string, hash string) (bool, error)
+	// NeedsRehash reports whether a hash was made with another algorithm or other parameters than
+	// the configured ones, and should be replaced the next time the data is known.
+	NeedsRehash(ctx context.Context, hash string) bool
+}
+
+// hasher is a hashing algorithm. Hashes are encoded along with their parameters, and start with a
+// prefix that tells which algorithm made them.
+type hasher interface {
+	hash(data string) (string, error)
+	isHashEqual(data string, hash string) (bool, error)
+	isHashPrefix(hash string) bool
+	hasSameParameters(hash string) bool
 }
 
 type hash struct {
-	authConfig configs.Auth
+	hasher     hasher
+	hasherList []hasher
 }
 
-func NewHash(authConfig configs.Auth) Hash {
+func NewHash(authConfig configs.Auth) (Hash, error) {
+	bcryptHasher := newBcryptHasher(authConfig.Hash.Cost)
+	argon2idHasher, err := newArgon2idHasher(authConfig.Hash.Argon2id)
+	if err != nil {
+		return nil, err
+	}
+
+	var configuredHasher hasher
+	switch authConfig.Hash.Algorithm {
+	case configs.HashAlgorithmBcrypt, "":
+		configuredHasher = bcryptHasher
+	case configs.HashAlgorithmArgon2id:
+		configuredHasher = argon2idHasher
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm: %s", authConfig.Hash.Algorithm)
+	}
+
 	return &hash{
-		authConfig: authConfig,
+		hasher:     configuredHasher,
+		hasherList: []hasher{bcryptHasher, argon2idHasher},
+	}, nil
+}
+
+func (h hash) getHasherOfHash(hash string) (hasher, bool) {
+	for _, candidateHasher := range h.hasherList {
+		if candidateHasher.isHashPrefix(hash) {
+			return candidateHasher, true
+		}
 	}
+
+	return nil, false
 }
 
 func (h hash) Hash(_ context.Context, data string) (string, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(data), h.authConfig.Hash.Cost)
-	if err != nil {
-		return "", status.Error(codes.Internal, "failed to hash data")
-	}
-	return string(hashed), nil
+	return h.hasher.hash(data)
 }
 
 func (h hash) IsHashEqual(_ context.Context, data string, hash string) (bool, error) {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(data))
-	if err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return false, nil
+	hashHasher, ok := h.getHasherOfHash(hash)
+	if !ok {
+		return false, errUnknownHashAlgorithm
+	}
+
+	return hashHasher.isHashEqual(data, hash)
+}
+
+func (h hash) NeedsRehash(_ context.Context, hash string) bool {
+	return !h.hasher.isHashPrefix(hash) || !h.hasher.hasSameParameters(hash)
+}
+
+// hasHashPrefix is a helper for hashers identified by one of several prefixes.
+func hasHashPrefix(hash string, prefixList ...string) bool {
+	for _, prefix := range prefixList {
+		if strings.HasPrefix(hash, prefix) {
+			return true
 		}
-		return false, status.Error(codes.Internal, "failed to check if data equal hash")
 	}
-	return true, nil
+
+	return false
 }
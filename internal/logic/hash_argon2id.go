@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	argon2idHashPrefix = "$argon2id$"
+)
+
+var (
+	errInvalidArgon2idHash = errors.New("invalid argon2id hash")
+)
+
+type argon2idParameters struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+// argon2idHasher encodes hashes in the PHC string format used by the reference implementation,
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>, with the salt and the key
+// base64 encoded without padding.
+type argon2idHasher struct {
+	parameters argon2idParameters
+}
+
+func newArgon2idHasher(argon2idConfig configs.Argon2id) (hasher, error) {
+	memory, err := argon2idConfig.GetMemoryInKibibytes()
+	if err != nil {
+		return nil, fmt.Errorf("invalid argon2id memory: %w", err)
+	}
+
+	return &argon2idHasher{
+		parameters: argon2idParameters{
+			memory:      memory,
+			iterations:  argon2idConfig.Iterations,
+			parallelism: argon2idConfig.Parallelism,
+			saltLength:  argon2idConfig.SaltLength,
+			keyLength:   argon2idConfig.KeyLength,
+		},
+	}, nil
+}
+
+func (a argon2idHasher) hash(data string) (string, error) {
+	if a.parameters.iterations == 0 || a.parameters.parallelism == 0 ||
+		a.parameters.saltLength == 0 || a.parameters.keyLength == 0 {
+		return "", status.Error(codes.Internal, "argon2id parameters are not configured")
+	}
+
+	salt := make([]byte, a.parameters.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", status.Error(codes.Internal, "failed to hash data")
+	}
+
+	key := argon2.IDKey([]byte(data), salt, a.parameters.iterations, a.parameters.memory, a.parameters.parallelism, a.parameters.keyLength)
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idHashPrefix,
+		argon2.Version,
+		a.parameters.memory,
+		a.parameters.iterations,
+		a.parameters.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a argon2idHasher) decodeHash(hash string) (argon2idParameters, []byte, []byte, error) {
+	partList := strings.Split(hash, "$")
+	if len(partList) != 6 {
+		return argon2idParameters{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(partList[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return argon2idParameters{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	parameters := argon2idParameters{}
+	_, err := fmt.Sscanf(partList[3], "m=%d,t=%d,p=%d", &parameters.memory, &parameters.iterations, &parameters.parallelism)
+	if err != nil {
+		return argon2idParameters{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(partList[4])
+	if err != nil {
+		return argon2idParameters{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(partList[5])
+	if err != nil || len(key) == 0 {
+		return argon2idParameters{}, nil, nil, errInvalidArgon2idHash
+	}
+
+	parameters.saltLength = uint32(len(salt))
+	parameters.keyLength = uint32(len(key))
+	return parameters, salt, key, nil
+}
+
+func (a argon2idHasher) isHashEqual(data string, hash string) (bool, error) {
+	parameters, salt, key, err := a.decodeHash(hash)
+	if err != nil {
+		return false, status.Error(codes.Internal, "failed to check if data equal hash")
+	}
+
+	dataKey := argon2.IDKey([]byte(data), salt, parameters.iterations, parameters.memory, parameters.parallelism, parameters.keyLength)
+	return subtle.ConstantTimeCompare(key, dataKey) == 1, nil
+}
+
+func (a argon2idHasher) isHashPrefix(hash string) bool {
+	return hasHashPrefix(hash, argon2idHashPrefix)
+}
+
+func (a argon2idHasher) hasSameParameters(hash string) bool {
+	parameters, _, _, err := a.decodeHash(hash)
+	if err != nil {
+		return false
+	}
+
+	return parameters == a.parameters
+}
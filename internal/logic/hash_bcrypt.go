@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type bcryptHasher struct {
+	cost int
+}
+
+func newBcryptHasher(cost int) hasher {
+	return &bcryptHasher{
+		cost: cost,
+	}
+}
+
+func (b bcryptHasher) hash(data string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(data), b.cost)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return "", status.Error(codes.InvalidArgument, "data is too long to be hashed")
+		}
+		return "", status.Error(codes.Internal, "failed to hash data")
+	}
+	return string(hashed), nil
+}
+
+func (b bcryptHasher) isHashEqual(data string, hash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(data))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, status.Error(codes.Internal, "failed to check if data equal hash")
+	}
+	return true, nil
+}
+
+func (b bcryptHasher) isHashPrefix(hash string) bool {
+	return hasHashPrefix(hash, "$2a$", "$2b$", "$2y$")
+}
+
+// hasSameParameters compares the cost the same way bcrypt.GenerateFromPassword would pick it, so
+// that a cost below the minimum is not rehashed on every login.
+func (b bcryptHasher) hasSameParameters(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return false
+	}
+
+	expectedCost := b.cost
+	if expectedCost < bcrypt.MinCost {
+		expectedCost = bcrypt.DefaultCost
+	}
+
+	return cost == expectedCost
+}
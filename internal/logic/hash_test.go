@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newTestArgon2idConfig returns argon2id parameters cheap enough for tests.
+func newTestArgon2idConfig(iterations uint32) configs.Argon2id {
+	return configs.Argon2id{
+		Memory:      "1MiB",
+		Iterations:  iterations,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+func newTestHash(t *testing.T, hashConfig configs.Hash) Hash {
+	t.Helper()
+
+	hashLogic, err := NewHash(configs.Auth{Hash: hashConfig})
+	if err != nil {
+		t.Fatalf("failed to create hash logic: %v", err)
+	}
+
+	return hashLogic
+}
+
+func TestArgon2idHash(t *testing.T) {
+	ctx := context.Background()
+	hashLogic := newTestHash(t, configs.Hash{
+		Algorithm: configs.HashAlgorithmArgon2id,
+		Argon2id:  newTestArgon2idConfig(1),
+	})
+
+	passwordHash, err := hashLogic.Hash(ctx, "password")
+	if err != nil {
+		t.Fatalf("failed to hash: %v", err)
+	}
+
+	if !strings.HasPrefix(passwordHash, argon2idHashPrefix) {
+		t.Fatalf("expected an argon2id hash, got %q", passwordHash)
+	}
+
+	if isHashEqual, err := hashLogic.IsHashEqual(ctx, "password", passwordHash); err != nil || !isHashEqual {
+		t.Fatalf("expected the password to match its hash, got %v, %v", isHashEqual, err)
+	}
+
+	if isHashEqual, err := hashLogic.IsHashEqual(ctx, "other password", passwordHash); err != nil || isHashEqual {
+		t.Fatalf("expected another password not to match the hash, got %v, %v", isHashEqual, err)
+	}
+
+	if hashLogic.NeedsRehash(ctx, passwordHash) {
+		t.Fatal("expected a hash made with the configured parameters not to need a rehash")
+	}
+}
+
+func TestBcryptHashVerifiesWithArgon2idConfigured(t *testing.T) {
+	ctx := context.Background()
+	hashLogic := newTestHash(t, configs.Hash{
+		Algorithm: configs.HashAlgorithmArgon2id,
+		Argon2id:  newTestArgon2idConfig(1),
+	})
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash with bcrypt: %v", err)
+	}
+
+	if isHashEqual, err := hashLogic.IsHashEqual(ctx, "password", string(bcryptHash)); err != nil || !isHashEqual {
+		t.Fatalf("expected the password to match its bcrypt hash, got %v, %v", isHashEqual, err)
+	}
+
+	if isHashEqual, err := hashLogic.IsHashEqual(ctx, "other password", string(bcryptHash)); err != nil || isHashEqual {
+		t.Fatalf("expected another password not to match the bcrypt hash, got %v, %v", isHashEqual, err)
+	}
+
+	if !hashLogic.NeedsRehash(ctx, string(bcryptHash)) {
+		t.Fatal("expected a bcrypt hash to need a rehash when argon2id is configured")
+	}
+}
+
+func TestNeedsRehashWithOutdatedParameters(t *testing.T) {
+	ctx := context.Background()
+
+	oldArgon2idHash, err := newTestHash(t, configs.Hash{
+		Algorithm: configs.HashAlgorithmArgon2id,
+		Argon2id:  newTestArgon2idConfig(1),
+	}).Hash(ctx, "password")
+	if err != nil {
+		t.Fatalf("failed to hash: %v", err)
+	}
+
+	argon2idHashLogic := newTestHash(t, configs.Hash{
+		Algorithm: configs.HashAlgorithmArgon2id,
+		Argon2id:  newTestArgon2idConfig(2),
+	})
+	if !argon2idHashLogic.NeedsRehash(ctx, oldArgon2idHash) {
+		t.Fatal("expected an argon2id hash with fewer iterations to need a rehash")
+	}
+
+	if isHashEqual, err := argon2idHashLogic.IsHashEqual(ctx, "password", oldArgon2idHash); err != nil || !isHashEqual {
+		t.Fatalf("expected the password to match its hash made with other parameters, got %v, %v", isHashEqual, err)
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash with bcrypt: %v", err)
+	}
+
+	bcryptHashLogic := newTestHash(t, configs.Hash{Cost: bcrypt.MinCost + 1, Argon2id: newTestArgon2idConfig(1)})
+	if !bcryptHashLogic.NeedsRehash(ctx, string(bcryptHash)) {
+		t.Fatal("expected a bcrypt hash with a lower cost to need a rehash")
+	}
+
+	if newTestHash(t, configs.Hash{Cost: bcrypt.MinCost, Argon2id: newTestArgon2idConfig(1)}).NeedsRehash(ctx, string(bcryptHash)) {
+		t.Fatal("expected a bcrypt hash with the configured cost not to need a rehash")
+	}
+}
+
+func TestIsHashEqualRejectsUnknownAlgorithm(t *testing.T) {
+	hashLogic := newTestHash(t, configs.Hash{Argon2id: newTestArgon2idConfig(1)})
+
+	if _, err := hashLogic.IsHashEqual(context.Background(), "password", "$unknown$hash"); !errors.Is(err, errUnknownHashAlgorithm) {
+		t.Fatalf("expected an unknown algorithm error, got %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/metrics"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// The name of the leader lease row created by migrations for the scheduler.
+	leaderLeaseNameScheduler = "scheduler"
+)
+
+var (
+	errNotLeader = errors.New("not the leader")
+)
+
+// LeaderElection elects one of the replicas running the scheduler as the leader, which is the
+// only one that runs cron jobs. Replicas rely on their clocks being roughly in sync, since a lease
+// is considered expired based on the clock of the replica taking it over.
+type LeaderElection interface {
+	// Run acquires the leadership when it is available, and renews it while held, until ctx is
+	// canceled. Resign must not be called before Run returns, and the leadership must not be
+	// given up while jobs started as the leader are still running.
+	Run(ctx context.Context)
+	// IsLeader returns nil if this replica currently holds the leadership, and an error otherwise.
+	IsLeader(ctx context.Context) error
+	// Resign gives up the leadership if held, so that another replica can take over right away
+	// instead of waiting for the lease to expire.
+	Resign(ctx context.Context)
+}
+
+type leaderElection struct {
+	leaderLeaseDataAccessor database.LeaderLeaseDataAccessor
+	goquDatabase            *goqu.Database
+	holderID                string
+	leaseTTL                time.Duration
+	renewInterval           time.Duration
+	logger                  *zap.Logger
+
+	// mutex guards the fields below, which are read by IsLeader while the lease is renewed.
+	mutex sync.Mutex
+	// leaseExpiresAt is when the lease held by this replica expires, measured from before it was
+	// acquired or renewed so that it never outlasts the one stored in the database.
+	leaseExpiresAt time.Time
+	// leaderHolderID is the last known holder of the lease, used to log leadership changes.
+	leaderHolderID string
+}
+
+func NewLeaderElection(
+	leaderLeaseDataAccessor database.LeaderLeaseDataAccessor,
+	goquDatabase *goqu.Database,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) (LeaderElection, error) {
+	leaseTTL, err := cronConfig.LeaderElection.GetLeaseTTLDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get leader election lease ttl duration")
+		return nil, err
+	}
+
+	renewInterval, err := cronConfig.LeaderElection.GetRenewIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get leader election renew interval duration")
+		return nil, err
+	}
+
+	if renewInterval <= 0 || leaseTTL <= renewInterval {
+		return nil, errors.New("leader election renew interval must be positive and shorter than the lease ttl")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	holderID := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	return &leaderElection{
+		leaderLeaseDataAccessor: leaderLeaseDataAccessor,
+		goquDatabase:            goquDatabase,
+		holderID:                holderID,
+		leaseTTL:                leaseTTL,
+		renewInterval:           renewInterval,
+		logger:                  logger.With(zap.String("holder_id", holderID)),
+	}, nil
+}
+
+func (l *leaderElection) isLeader(now time.Time) bool {
+	return now.Before(l.leaseExpiresAt)
+}
+
+// setLeaderHolderID logs when the leadership changes hands, as seen by this replica.
+func (l *leaderElection) setLeaderHolderID(ctx context.Context, leaderHolderID string) {
+	if leaderHolderID == l.leaderHolderID {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("leader_holder_id", leaderHolderID))
+	switch {
+	case leaderHolderID == l.holderID:
+		logger.Info("became the scheduler leader")
+		metrics.SchedulerLeadershipChangesTotal.WithLabelValues(metrics.SchedulerLeadershipChangeAcquired).Inc()
+	case l.leaderHolderID == l.holderID:
+		logger.Warn("lost the scheduler leadership")
+		metrics.SchedulerLeadershipChangesTotal.WithLabelValues(metrics.SchedulerLeadershipChangeLost).Inc()
+	default:
+		logger.Info("scheduler leader changed")
+	}
+
+	l.leaderHolderID = leaderHolderID
+}
+
+// acquireOrRenewLease takes the lease if it is free or expired, or extends it if already held.
+func (l *leaderElection) acquireOrRenewLease(ctx context.Context) error {
+	now := time.Now()
+	leaderHolderID := ""
+	err := l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		leaderLease, err := l.leaderLeaseDataAccessor.WithDatabase(td).GetLeaderLeaseWithXLock(ctx, leaderLeaseNameScheduler)
+		if err != nil {
+			return err
+		}
+
+		if leaderLease.HolderID != l.holderID && leaderLease.HolderID != "" && now.Before(leaderLease.ExpiresAt) {
+			leaderHolderID = leaderLease.HolderID
+			return nil
+		}
+
+		leaderLease.HolderID = l.holderID
+		leaderLease.ExpiresAt = now.Add(l.leaseTTL)
+		if err = l.leaderLeaseDataAccessor.WithDatabase(td).UpdateLeaderLease(ctx, leaderLease); err != nil {
+			return err
+		}
+
+		leaderHolderID = l.holderID
+		return nil
+	})
+	if err != nil {
+		// The lease held so far is kept until it expires, since no other replica can take it over
+		// before then.
+		return err
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if leaderHolderID == l.holderID {
+		l.leaseExpiresAt = now.Add(l.leaseTTL)
+	} else {
+		l.leaseExpiresAt = time.Time{}
+	}
+
+	l.setLeaderHolderID(ctx, leaderHolderID)
+	return nil
+}
+
+func (l *leaderElection) Run(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	ticker := time.NewTicker(l.renewInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.acquireOrRenewLease(ctx); err != nil && ctx.Err() == nil {
+			logger.With(zap.Error(err)).Error("failed to acquire or renew scheduler leader lease")
+		}
+
+		if l.IsLeader(ctx) == nil {
+			metrics.SchedulerLeader.Set(1)
+		} else {
+			metrics.SchedulerLeader.Set(0)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *leaderElection) IsLeader(context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.isLeader(time.Now()) {
+		return errNotLeader
+	}
+
+	return nil
+}
+
+func (l *leaderElection) Resign(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	if l.IsLeader(ctx) != nil {
+		return
+	}
+
+	err := l.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		leaderLease, err := l.leaderLeaseDataAccessor.WithDatabase(td).GetLeaderLeaseWithXLock(ctx, leaderLeaseNameScheduler)
+		if err != nil {
+			return err
+		}
+
+		if leaderLease.HolderID != l.holderID {
+			return nil
+		}
+
+		leaderLease.HolderID = ""
+		leaderLease.ExpiresAt = time.Now()
+		return l.leaderLeaseDataAccessor.WithDatabase(td).UpdateLeaderLease(ctx, leaderLease)
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to resign scheduler leadership")
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.leaseExpiresAt = time.Time{}
+	l.leaderHolderID = ""
+	metrics.SchedulerLeader.Set(0)
+	metrics.SchedulerLeadershipChangesTotal.WithLabelValues(metrics.SchedulerLeadershipChangeResigned).Inc()
+	logger.Info("resigned the scheduler leadership")
+}
@@ -0,0 +1,101 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"go.uber.org/zap"
+)
+
+const testLeaseTTL = 200 * time.Millisecond
+
+// newTestLeaderElection returns the leader election of a replica identified by holderID, so that
+// several replicas can be run by the same process.
+func newTestLeaderElection(t *testing.T, goquDatabase *goqu.Database, holderID string) *leaderElection {
+	t.Helper()
+
+	cronConfig := configs.Cron{LeaderElection: configs.LeaderElection{
+		Enabled:       true,
+		LeaseTTL:      testLeaseTTL.String(),
+		RenewInterval: (testLeaseTTL / 4).String(),
+	}}
+	l, err := NewLeaderElection(database.NewLeaderLeaseDataAccessor(goquDatabase, zap.NewNop()), goquDatabase, cronConfig, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create leader election: %v", err)
+	}
+
+	leaderElection := l.(*leaderElection)
+	leaderElection.holderID = holderID
+	return leaderElection
+}
+
+func TestLeaderElectionFailover(t *testing.T) {
+	goquDatabase := newTestGoquDatabase(t)
+	leader := newTestLeaderElection(t, goquDatabase, "leader")
+	follower := newTestLeaderElection(t, goquDatabase, "follower")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runStopped := make(chan struct{})
+	go func() {
+		defer close(runStopped)
+		leader.Run(ctx)
+	}()
+
+	for leader.IsLeader(ctx) != nil {
+		time.Sleep(testLeaseTTL / 20)
+	}
+
+	// The follower does not take the lease over while the leader keeps renewing it, for longer
+	// than the lease ttl.
+	for range 3 {
+		if err := follower.acquireOrRenewLease(ctx); err != nil {
+			t.Fatalf("failed to acquire lease: %v", err)
+		}
+
+		if follower.IsLeader(ctx) == nil {
+			t.Fatalf("follower took the lease over while the leader renewed it")
+		}
+
+		time.Sleep(testLeaseTTL / 2)
+	}
+
+	cancel()
+	<-runStopped
+	leader.Resign(context.Background())
+	if leader.IsLeader(context.Background()) == nil {
+		t.Fatalf("leader is still the leader after resigning")
+	}
+
+	// The follower takes over right away once the leader resigned.
+	if err := follower.acquireOrRenewLease(context.Background()); err != nil || follower.IsLeader(context.Background()) != nil {
+		t.Fatalf("expected follower to take over after the leader resigned, got %v", err)
+	}
+}
+
+func TestLeaderElectionCrashedLeaderExpires(t *testing.T) {
+	ctx := context.Background()
+	goquDatabase := newTestGoquDatabase(t)
+	crashedLeader := newTestLeaderElection(t, goquDatabase, "crashed-leader")
+	follower := newTestLeaderElection(t, goquDatabase, "follower")
+
+	if err := crashedLeader.acquireOrRenewLease(ctx); err != nil || crashedLeader.IsLeader(ctx) != nil {
+		t.Fatalf("expected first replica to become the leader, got %v", err)
+	}
+
+	// The leader crashes, so its lease is neither renewed nor given up.
+	time.Sleep(testLeaseTTL + testLeaseTTL/2)
+
+	if crashedLeader.IsLeader(ctx) == nil {
+		t.Fatalf("expected leadership to expire along with the lease")
+	}
+
+	if err := follower.acquireOrRenewLease(ctx); err != nil || follower.IsLeader(ctx) != nil {
+		t.Fatalf("expected follower to take over the expired lease, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errTooManyLoginAttempts = status.Error(codes.ResourceExhausted, "too many failed login attempts, try again later")
+)
+
+// LoginThrottle protects password logins against guessing. Failures are counted both per account
+// name, whether the account exists or not, and per IP address, so that neither guessing many
+// passwords of one account nor one password of many accounts goes unnoticed. The cache being
+// unavailable does not prevent logins, it only disables the protection until it is back.
+type LoginThrottle interface {
+	CheckLoginAllowed(ctx context.Context, accountName, ipAddress string) error
+	RecordLoginFailure(ctx context.Context, accountName, ipAddress string)
+	RecordLoginSuccess(ctx context.Context, accountName string)
+	// UnlockLogin lifts the lockout and the delay of an account name, an IP address, or both,
+	// on behalf of actorAccountID.
+	UnlockLogin(ctx context.Context, actorAccountID uint64, accountName, ipAddress string) error
+}
+
+// loginThrottleSubject is something logins are throttled by, along with the events recorded
+// when it is locked and unlocked.
+type loginThrottleSubject struct {
+	key               string
+	lockoutThreshold  uint64
+	accountName       string
+	ipAddress         string
+	lockedEventType   morgana.AuditEventType
+	unlockedEventType morgana.AuditEventType
+}
+
+type loginThrottle struct {
+	loginAttemptCache      cache.LoginAttempt
+	auditEventDataAccessor database.AuditEventDataAccessor
+	loginThrottleConfig    configs.LoginThrottle
+	failedAttemptWindow    time.Duration
+	baseDelay              time.Duration
+	maxDelay               time.Duration
+	lockoutDuration        time.Duration
+	logger                 *zap.Logger
+}
+
+func NewLoginThrottle(
+	loginAttemptCache cache.LoginAttempt,
+	auditEventDataAccessor database.AuditEventDataAccessor,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (LoginThrottle, error) {
+	loginThrottleConfig := authConfig.LoginThrottle
+	l := &loginThrottle{
+		loginAttemptCache:      loginAttemptCache,
+		auditEventDataAccessor: auditEventDataAccessor,
+		loginThrottleConfig:    loginThrottleConfig,
+		logger:                 logger,
+	}
+
+	if !loginThrottleConfig.Enabled {
+		return l, nil
+	}
+
+	var err error
+	l.failedAttemptWindow, err = loginThrottleConfig.GetFailedAttemptWindowDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get failed login attempt window duration")
+		return nil, err
+	}
+
+	l.baseDelay, err = loginThrottleConfig.GetBaseDelayDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get login base delay duration")
+		return nil, err
+	}
+
+	l.maxDelay, err = loginThrottleConfig.GetMaxDelayDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get login max delay duration")
+		return nil, err
+	}
+
+	l.lockoutDuration, err = loginThrottleConfig.GetLockoutDurationDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get login lockout duration")
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l loginThrottle) getAccountSubject(accountName string) loginThrottleSubject {
+	// Account names are compared case insensitively by the database, so they are throttled the
+	// same way.
+	return loginThrottleSubject{
+		key:               "account:" + strings.ToLower(accountName),
+		lockoutThreshold:  l.loginThrottleConfig.AccountLockoutThreshold,
+		accountName:       accountName,
+		lockedEventType:   morgana.AuditEventType_AUDIT_EVENT_TYPE_ACCOUNT_LOCKED,
+		unlockedEventType: morgana.AuditEventType_AUDIT_EVENT_TYPE_ACCOUNT_UNLOCKED,
+	}
+}
+
+func (l loginThrottle) getIPAddressSubject(ipAddress string) loginThrottleSubject {
+	return loginThrottleSubject{
+		key:               "ip_address:" + ipAddress,
+		lockoutThreshold:  l.loginThrottleConfig.IPAddressLockoutThreshold,
+		ipAddress:         ipAddress,
+		lockedEventType:   morgana.AuditEventType_AUDIT_EVENT_TYPE_IP_ADDRESS_LOCKED,
+		unlockedEventType: morgana.AuditEventType_AUDIT_EVENT_TYPE_IP_ADDRESS_UNLOCKED,
+	}
+}
+
+func (l loginThrottle) getSubjectList(accountName, ipAddress string) []loginThrottleSubject {
+	subjectList := make([]loginThrottleSubject, 0, 2)
+	if accountName != "" {
+		subjectList = append(subjectList, l.getAccountSubject(accountName))
+	}
+
+	if ipAddress != "" {
+		subjectList = append(subjectList, l.getIPAddressSubject(ipAddress))
+	}
+
+	return subjectList
+}
+
+// getDelay returns how long logins are blocked after a failure, doubling with every failure past
+// the free ones.
+func (l loginThrottle) getDelay(failedAttemptCount uint64) time.Duration {
+	if failedAttemptCount <= l.loginThrottleConfig.FreeAttemptCount {
+		return 0
+	}
+
+	delay := l.baseDelay
+	for i := l.loginThrottleConfig.FreeAttemptCount + 1; i < failedAttemptCount && delay < l.maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, l.maxDelay)
+}
+
+func (l loginThrottle) CheckLoginAllowed(ctx context.Context, accountName, ipAddress string) error {
+	if !l.loginThrottleConfig.Enabled {
+		return nil
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		isBlocked, err := l.loginAttemptCache.IsBlocked(ctx, subject.key)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to check if login is blocked, allowing it")
+			continue
+		}
+
+		if isBlocked {
+			return errTooManyLoginAttempts
+		}
+	}
+
+	return nil
+}
+
+func (l loginThrottle) RecordLoginFailure(ctx context.Context, accountName, ipAddress string) {
+	if !l.loginThrottleConfig.Enabled {
+		return
+	}
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		l.recordSubjectLoginFailure(ctx, subject)
+	}
+}
+
+func (l loginThrottle) recordSubjectLoginFailure(ctx context.Context, subject loginThrottleSubject) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("key", subject.key))
+
+	failedAttemptCount, err := l.loginAttemptCache.IncrementFailedAttemptCount(ctx, subject.key, l.failedAttemptWindow)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to record failed login attempt")
+		return
+	}
+
+	if subject.lockoutThreshold > 0 && failedAttemptCount >= subject.lockoutThreshold {
+		l.lockSubject(ctx, subject)
+		return
+	}
+
+	if delay := l.getDelay(failedAttemptCount); delay > 0 {
+		err = l.loginAttemptCache.Block(ctx, subject.key, delay)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to delay login")
+		}
+	}
+}
+
+// lockSubject blocks logins for the lockout duration. The failed attempt count starts over, so
+// that failures after the lockout expires have to reach the threshold again to cause another one.
+func (l loginThrottle) lockSubject(ctx context.Context, subject loginThrottleSubject) {
+	logger := utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("account_name", subject.accountName)).
+		With(zap.String("ip_address", subject.ipAddress))
+
+	err := l.loginAttemptCache.Block(ctx, subject.key, l.lockoutDuration)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to lock out login")
+		return
+	}
+
+	err = l.loginAttemptCache.ResetFailedAttemptCount(ctx, subject.key)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to reset failed login attempt count")
+	}
+
+	_, err = l.auditEventDataAccessor.CreateAuditEvent(ctx, database.AuditEvent{
+		EventType:   subject.lockedEventType,
+		AccountName: subject.accountName,
+		IPAddress:   subject.ipAddress,
+		ExpiresAt:   sql.NullTime{Time: time.Now().Add(l.lockoutDuration), Valid: true},
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create login lockout audit event")
+	}
+
+	logger.
+		With(zap.String("event_type", subject.lockedEventType.String())).
+		With(zap.Duration("lockout_duration", l.lockoutDuration)).
+		Warn("login locked out after too many failed attempts")
+}
+
+// RecordLoginSuccess only forgets the failures of the account, the failures of the IP address
+// still count so that an attacker cannot reset them by logging into an account of their own.
+func (l loginThrottle) RecordLoginSuccess(ctx context.Context, accountName string) {
+	if !l.loginThrottleConfig.Enabled {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	err := l.loginAttemptCache.ResetFailedAttemptCount(ctx, l.getAccountSubject(accountName).key)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to reset failed login attempt count")
+	}
+}
+
+func (l loginThrottle) UnlockLogin(ctx context.Context, actorAccountID uint64, accountName, ipAddress string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.Uint64("actor_account_id", actorAccountID))
+
+	for _, subject := range l.getSubjectList(accountName, ipAddress) {
+		err := l.loginAttemptCache.Unblock(ctx, subject.key)
+		if err != nil {
+			return err
+		}
+
+		err = l.loginAttemptCache.ResetFailedAttemptCount(ctx, subject.key)
+		if err != nil {
+			return err
+		}
+
+		_, err = l.auditEventDataAccessor.CreateAuditEvent(ctx, database.AuditEvent{
+			EventType:      subject.unlockedEventType,
+			AccountName:    subject.accountName,
+			IPAddress:      subject.ipAddress,
+			ActorAccountID: sql.Null[uint64]{V: actorAccountID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.
+			With(zap.String("account_name", subject.accountName)).
+			With(zap.String("ip_address", subject.ipAddress)).
+			Info("login unlocked")
+	}
+
+	return nil
+}
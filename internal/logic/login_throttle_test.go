@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"go.uber.org/zap"
+)
+
+// TestLoginThrottleLockoutExpires runs the login throttle in the single binary mode, with SQLite
+// and the in-memory cache, where lockouts used to last until a restart.
+func TestLoginThrottleLockoutExpires(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	goquDatabase := newTestGoquDatabase(t)
+	auditEventDataAccessor := database.NewAuditEventDataAccessor(goquDatabase, logger)
+
+	loginThrottle, err := NewLoginThrottle(
+		cache.NewLoginAttempt(cache.NewInMemoryClient(configs.Cache{Type: configs.CacheTypeInMemory}, logger), logger),
+		auditEventDataAccessor,
+		configs.Auth{LoginThrottle: configs.LoginThrottle{
+			Enabled:                 true,
+			FailedAttemptWindow:     "1m",
+			FreeAttemptCount:        5,
+			BaseDelay:               "1s",
+			MaxDelay:                "1s",
+			AccountLockoutThreshold: 2,
+			LockoutDuration:         "100ms",
+		}},
+		logger,
+	)
+	if err != nil {
+		t.Fatalf("failed to create login throttle: %v", err)
+	}
+
+	for range 2 {
+		if err = loginThrottle.CheckLoginAllowed(ctx, "user", ""); err != nil {
+			t.Fatalf("expected login to be allowed before the lockout, got %v", err)
+		}
+
+		loginThrottle.RecordLoginFailure(ctx, "user", "")
+	}
+
+	if err = loginThrottle.CheckLoginAllowed(ctx, "user", ""); !errors.Is(err, errTooManyLoginAttempts) {
+		t.Fatalf("expected login to be locked out, got %v", err)
+	}
+
+	auditEventCount, err := auditEventDataAccessor.GetAuditEventCount(ctx)
+	if err != nil || auditEventCount != 1 {
+		t.Fatalf("expected 1 lockout audit event, got %d, %v", auditEventCount, err)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	if err = loginThrottle.CheckLoginAllowed(ctx, "user", ""); err != nil {
+		t.Fatalf("expected login to be allowed once the lockout expired, got %v", err)
+	}
+
+	// The failures before the lockout do not count anymore.
+	loginThrottle.RecordLoginFailure(ctx, "user", "")
+	if err = loginThrottle.CheckLoginAllowed(ctx, "user", ""); err != nil {
+		t.Fatalf("expected a single failure after the lockout to be allowed, got %v", err)
+	}
+}
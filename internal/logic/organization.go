@@ -0,0 +1,495 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	morgana "github.com/hoangdv99/morgana/internal/generated/morgana/v1"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	organizationNameMaxLength = 256
+)
+
+var (
+	errNotOrganizationMember         = status.Error(codes.PermissionDenied, "account is not a member of the organization")
+	errInsufficientOrganizationRole  = status.Error(codes.PermissionDenied, "account does not have the required role in the organization")
+	errInvalidOrganizationRole       = status.Error(codes.InvalidArgument, "invalid organization role")
+	errInvalidOrganizationName       = status.Error(codes.InvalidArgument, "invalid organization name")
+	errOrganizationNameTaken         = status.Error(codes.AlreadyExists, "organization name is already taken")
+	errOrganizationMemberExists      = status.Error(codes.AlreadyExists, "account is already a member of the organization")
+	errOrganizationNeedsAnotherOwner = status.Error(codes.FailedPrecondition, "organization must keep at least one owner")
+)
+
+type CreateOrganizationParams struct {
+	Token            string
+	OrganizationName string
+}
+
+type CreateOrganizationOutput struct {
+	Organization *morgana.Organization
+}
+
+type GetOrganizationListParams struct {
+	Token string
+}
+
+type GetOrganizationListOutput struct {
+	OrganizationList []*morgana.AccountOrganization
+}
+
+type GetOrganizationMemberListParams struct {
+	Token          string
+	OrganizationID uint64
+}
+
+type GetOrganizationMemberListOutput struct {
+	OrganizationMemberList []*morgana.OrganizationMember
+}
+
+type AddOrganizationMemberParams struct {
+	Token          string
+	OrganizationID uint64
+	AccountName    string
+	Role           morgana.OrganizationRole
+}
+
+type AddOrganizationMemberOutput struct {
+	OrganizationMember *morgana.OrganizationMember
+}
+
+type UpdateOrganizationMemberParams struct {
+	Token          string
+	OrganizationID uint64
+	AccountID      uint64
+	Role           morgana.OrganizationRole
+}
+
+type UpdateOrganizationMemberOutput struct {
+	OrganizationMember *morgana.OrganizationMember
+}
+
+type RemoveOrganizationMemberParams struct {
+	Token          string
+	OrganizationID uint64
+	AccountID      uint64
+}
+
+// Organization manages organizations and their memberships. Organizations can only be managed
+// with a session, API keys are limited to download tasks.
+type Organization interface {
+	CreateOrganization(ctx context.Context, params CreateOrganizationParams) (CreateOrganizationOutput, error)
+	GetOrganizationList(ctx context.Context, params GetOrganizationListParams) (GetOrganizationListOutput, error)
+	GetOrganizationMemberList(ctx context.Context, params GetOrganizationMemberListParams) (GetOrganizationMemberListOutput, error)
+	AddOrganizationMember(ctx context.Context, params AddOrganizationMemberParams) (AddOrganizationMemberOutput, error)
+	UpdateOrganizationMember(ctx context.Context, params UpdateOrganizationMemberParams) (UpdateOrganizationMemberOutput, error)
+	RemoveOrganizationMember(ctx context.Context, params RemoveOrganizationMemberParams) error
+}
+
+type organization struct {
+	goquDatabase                   *goqu.Database
+	accountDataAccessor            database.AccountDataAccessor
+	organizationDataAccessor       database.OrganizationDataAccessor
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor
+	tokenLogic                     Token
+	logger                         *zap.Logger
+}
+
+func NewOrganization(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	organizationDataAccessor database.OrganizationDataAccessor,
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor,
+	tokenLogic Token,
+	logger *zap.Logger,
+) Organization {
+	return &organization{
+		goquDatabase:                   goquDatabase,
+		accountDataAccessor:            accountDataAccessor,
+		organizationDataAccessor:       organizationDataAccessor,
+		organizationMemberDataAccessor: organizationMemberDataAccessor,
+		tokenLogic:                     tokenLogic,
+		logger:                         logger,
+	}
+}
+
+// authorizeOrganizationMember checks that an account has at least the given role in an
+// organization, and returns its membership.
+func authorizeOrganizationMember(
+	ctx context.Context,
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor,
+	organizationID uint64,
+	accountID uint64,
+	minimumRole morgana.OrganizationRole,
+) (database.OrganizationMember, error) {
+	organizationMember, err := organizationMemberDataAccessor.GetOrganizationMember(ctx, organizationID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrOrganizationMemberNotFound) {
+			return database.OrganizationMember{}, errNotOrganizationMember
+		}
+
+		return database.OrganizationMember{}, err
+	}
+
+	if organizationMember.Role < minimumRole {
+		return database.OrganizationMember{}, errInsufficientOrganizationRole
+	}
+
+	return organizationMember, nil
+}
+
+func isValidOrganizationRole(role morgana.OrganizationRole) bool {
+	_, ok := morgana.OrganizationRole_name[int32(role)]
+	return ok && role != morgana.OrganizationRole_ORGANIZATION_ROLE_UNSPECIFIED
+}
+
+func databaseOrganizationToProtoOrganization(organization database.Organization) *morgana.Organization {
+	return &morgana.Organization{
+		Id:               organization.ID,
+		OrganizationName: organization.OrganizationName,
+	}
+}
+
+func (o organization) databaseOrganizationMemberToProtoOrganizationMember(
+	organizationMember database.OrganizationMember,
+	account database.Account,
+) *morgana.OrganizationMember {
+	return &morgana.OrganizationMember{
+		Account: &morgana.Account{
+			Id:          account.ID,
+			AccountName: account.AccountName,
+		},
+		Role: organizationMember.Role,
+	}
+}
+
+// checkOrganizationKeepsOwner makes sure that an organization is not left without an owner once
+// the given member stops being one. The organization has to be locked by the caller, so that two
+// owners cannot both step down at the same time.
+func (o organization) checkOrganizationKeepsOwner(
+	ctx context.Context,
+	organizationMemberDataAccessor database.OrganizationMemberDataAccessor,
+	organizationID uint64,
+	accountID uint64,
+) error {
+	organizationMemberList, err := organizationMemberDataAccessor.GetOrganizationMemberListOfOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if !lo.ContainsBy(organizationMemberList, func(item database.OrganizationMember) bool {
+		return item.AccountID != accountID && item.Role == morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER
+	}) {
+		return errOrganizationNeedsAnotherOwner
+	}
+
+	return nil
+}
+
+func (o organization) CreateOrganization(ctx context.Context, params CreateOrganizationParams) (CreateOrganizationOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	accountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return CreateOrganizationOutput{}, err
+	}
+
+	organizationName := strings.TrimSpace(params.OrganizationName)
+	if organizationName == "" || utf8.RuneCountInString(organizationName) > organizationNameMaxLength {
+		return CreateOrganizationOutput{}, errInvalidOrganizationName
+	}
+
+	organization := database.Organization{
+		OrganizationName: organizationName,
+	}
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		_, err := o.organizationDataAccessor.WithDatabase(td).GetOrganizationByOrganizationName(ctx, organizationName)
+		if err == nil {
+			return errOrganizationNameTaken
+		}
+
+		if !errors.Is(err, database.ErrOrganizationNotFound) {
+			return err
+		}
+
+		organization.ID, err = o.organizationDataAccessor.WithDatabase(td).CreateOrganization(ctx, organization)
+		if err != nil {
+			return err
+		}
+
+		return o.organizationMemberDataAccessor.WithDatabase(td).CreateOrganizationMember(ctx, database.OrganizationMember{
+			OrganizationID: organization.ID,
+			AccountID:      accountID,
+			Role:           morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+		})
+	})
+	if txErr != nil {
+		return CreateOrganizationOutput{}, txErr
+	}
+
+	logger.
+		With(zap.Uint64("organization_id", organization.ID)).
+		With(zap.Uint64("account_id", accountID)).
+		Info("organization created")
+
+	return CreateOrganizationOutput{
+		Organization: databaseOrganizationToProtoOrganization(organization),
+	}, nil
+}
+
+func (o organization) GetOrganizationList(ctx context.Context, params GetOrganizationListParams) (GetOrganizationListOutput, error) {
+	accountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetOrganizationListOutput{}, err
+	}
+
+	organizationMemberList, err := o.organizationMemberDataAccessor.GetOrganizationMemberListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetOrganizationListOutput{}, err
+	}
+
+	organizationList := make([]*morgana.AccountOrganization, 0, len(organizationMemberList))
+	for _, organizationMember := range organizationMemberList {
+		organization, err := o.organizationDataAccessor.GetOrganizationByID(ctx, organizationMember.OrganizationID)
+		if err != nil {
+			return GetOrganizationListOutput{}, err
+		}
+
+		organizationList = append(organizationList, &morgana.AccountOrganization{
+			Organization: databaseOrganizationToProtoOrganization(organization),
+			Role:         organizationMember.Role,
+		})
+	}
+
+	return GetOrganizationListOutput{
+		OrganizationList: organizationList,
+	}, nil
+}
+
+func (o organization) GetOrganizationMemberList(
+	ctx context.Context,
+	params GetOrganizationMemberListParams,
+) (GetOrganizationMemberListOutput, error) {
+	accountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return GetOrganizationMemberListOutput{}, err
+	}
+
+	_, err = authorizeOrganizationMember(
+		ctx, o.organizationMemberDataAccessor, params.OrganizationID, accountID, morgana.OrganizationRole_ORGANIZATION_ROLE_VIEWER,
+	)
+	if err != nil {
+		return GetOrganizationMemberListOutput{}, err
+	}
+
+	organizationMemberList, err := o.organizationMemberDataAccessor.GetOrganizationMemberListOfOrganization(ctx, params.OrganizationID)
+	if err != nil {
+		return GetOrganizationMemberListOutput{}, err
+	}
+
+	protoOrganizationMemberList := make([]*morgana.OrganizationMember, 0, len(organizationMemberList))
+	for _, organizationMember := range organizationMemberList {
+		account, err := o.accountDataAccessor.GetAccountByID(ctx, organizationMember.AccountID)
+		if err != nil {
+			return GetOrganizationMemberListOutput{}, err
+		}
+
+		protoOrganizationMemberList = append(
+			protoOrganizationMemberList,
+			o.databaseOrganizationMemberToProtoOrganizationMember(organizationMember, account),
+		)
+	}
+
+	return GetOrganizationMemberListOutput{
+		OrganizationMemberList: protoOrganizationMemberList,
+	}, nil
+}
+
+func (o organization) AddOrganizationMember(ctx context.Context, params AddOrganizationMemberParams) (AddOrganizationMemberOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	actorAccountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return AddOrganizationMemberOutput{}, err
+	}
+
+	if !isValidOrganizationRole(params.Role) {
+		return AddOrganizationMemberOutput{}, errInvalidOrganizationRole
+	}
+
+	_, err = authorizeOrganizationMember(
+		ctx, o.organizationMemberDataAccessor, params.OrganizationID, actorAccountID, morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+	)
+	if err != nil {
+		return AddOrganizationMemberOutput{}, err
+	}
+
+	account, err := o.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+	if err != nil {
+		return AddOrganizationMemberOutput{}, err
+	}
+
+	organizationMember := database.OrganizationMember{
+		OrganizationID: params.OrganizationID,
+		AccountID:      account.ID,
+		Role:           params.Role,
+	}
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		_, err := o.organizationDataAccessor.WithDatabase(td).GetOrganizationByIDWithXLock(ctx, params.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		_, err = o.organizationMemberDataAccessor.WithDatabase(td).GetOrganizationMember(ctx, params.OrganizationID, account.ID)
+		if err == nil {
+			return errOrganizationMemberExists
+		}
+
+		if !errors.Is(err, database.ErrOrganizationMemberNotFound) {
+			return err
+		}
+
+		return o.organizationMemberDataAccessor.WithDatabase(td).CreateOrganizationMember(ctx, organizationMember)
+	})
+	if txErr != nil {
+		return AddOrganizationMemberOutput{}, txErr
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccountID)).
+		With(zap.Uint64("organization_id", params.OrganizationID)).
+		With(zap.Uint64("account_id", account.ID)).
+		With(zap.String("role", params.Role.String())).
+		Info("organization member added")
+
+	return AddOrganizationMemberOutput{
+		OrganizationMember: o.databaseOrganizationMemberToProtoOrganizationMember(organizationMember, account),
+	}, nil
+}
+
+func (o organization) UpdateOrganizationMember(
+	ctx context.Context,
+	params UpdateOrganizationMemberParams,
+) (UpdateOrganizationMemberOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	actorAccountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return UpdateOrganizationMemberOutput{}, err
+	}
+
+	if !isValidOrganizationRole(params.Role) {
+		return UpdateOrganizationMemberOutput{}, errInvalidOrganizationRole
+	}
+
+	_, err = authorizeOrganizationMember(
+		ctx, o.organizationMemberDataAccessor, params.OrganizationID, actorAccountID, morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER,
+	)
+	if err != nil {
+		return UpdateOrganizationMemberOutput{}, err
+	}
+
+	account, err := o.accountDataAccessor.GetAccountByID(ctx, params.AccountID)
+	if err != nil {
+		return UpdateOrganizationMemberOutput{}, err
+	}
+
+	var organizationMember database.OrganizationMember
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		_, err := o.organizationDataAccessor.WithDatabase(td).GetOrganizationByIDWithXLock(ctx, params.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		organizationMember, err = o.organizationMemberDataAccessor.WithDatabase(td).GetOrganizationMember(ctx, params.OrganizationID, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if organizationMember.Role == morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER &&
+			params.Role != morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER {
+			err = o.checkOrganizationKeepsOwner(ctx, o.organizationMemberDataAccessor.WithDatabase(td), params.OrganizationID, params.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		organizationMember.Role = params.Role
+		return o.organizationMemberDataAccessor.WithDatabase(td).UpdateOrganizationMember(ctx, organizationMember)
+	})
+	if txErr != nil {
+		return UpdateOrganizationMemberOutput{}, txErr
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccountID)).
+		With(zap.Uint64("organization_id", params.OrganizationID)).
+		With(zap.Uint64("account_id", params.AccountID)).
+		With(zap.String("role", params.Role.String())).
+		Info("organization member updated")
+
+	return UpdateOrganizationMemberOutput{
+		OrganizationMember: o.databaseOrganizationMemberToProtoOrganizationMember(organizationMember, account),
+	}, nil
+}
+
+func (o organization) RemoveOrganizationMember(ctx context.Context, params RemoveOrganizationMemberParams) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	actorAccountID, _, err := o.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	// Any member can leave an organization, removing someone else takes an owner.
+	minimumRole := morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER
+	if actorAccountID == params.AccountID {
+		minimumRole = morgana.OrganizationRole_ORGANIZATION_ROLE_VIEWER
+	}
+
+	_, err = authorizeOrganizationMember(ctx, o.organizationMemberDataAccessor, params.OrganizationID, actorAccountID, minimumRole)
+	if err != nil {
+		return err
+	}
+
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		_, err := o.organizationDataAccessor.WithDatabase(td).GetOrganizationByIDWithXLock(ctx, params.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		organizationMember, err := o.organizationMemberDataAccessor.WithDatabase(td).GetOrganizationMember(ctx, params.OrganizationID, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if organizationMember.Role == morgana.OrganizationRole_ORGANIZATION_ROLE_OWNER {
+			err = o.checkOrganizationKeepsOwner(ctx, o.organizationMemberDataAccessor.WithDatabase(td), params.OrganizationID, params.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return o.organizationMemberDataAccessor.WithDatabase(td).DeleteOrganizationMember(ctx, params.OrganizationID, params.AccountID)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	logger.
+		With(zap.Uint64("actor_account_id", actorAccountID)).
+		With(zap.Uint64("organization_id", params.OrganizationID)).
+		With(zap.Uint64("account_id", params.AccountID)).
+		Info("organization member removed")
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errPasswordBreached = status.Error(codes.InvalidArgument, "password is known to have been leaked, choose another one")
+)
+
+// PasswordPolicy checks new passwords against the configured rules. Existing passwords are not
+// affected when the rules change.
+type PasswordPolicy interface {
+	Check(ctx context.Context, password string) error
+}
+
+type passwordPolicy struct {
+	passwordPolicyConfig configs.PasswordPolicy
+	breachedPasswordSet  map[string]struct{}
+}
+
+func NewPasswordPolicy(authConfig configs.Auth, logger *zap.Logger) (PasswordPolicy, error) {
+	breachedPasswordSet, err := loadBreachedPasswordSet(authConfig.PasswordPolicy.BreachedPasswordListFile)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to load breached password list")
+		return nil, err
+	}
+
+	return &passwordPolicy{
+		passwordPolicyConfig: authConfig.PasswordPolicy,
+		breachedPasswordSet:  breachedPasswordSet,
+	}, nil
+}
+
+func loadBreachedPasswordSet(filePath string) (map[string]struct{}, error) {
+	breachedPasswordSet := make(map[string]struct{})
+	if filePath == "" {
+		return breachedPasswordSet, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached password list file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			breachedPasswordSet[strings.ToLower(password)] = struct{}{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breached password list file %s: %w", filePath, err)
+	}
+
+	return breachedPasswordSet, nil
+}
+
+func (p passwordPolicy) Check(_ context.Context, password string) error {
+	passwordLength := utf8.RuneCountInString(password)
+	if passwordLength < p.passwordPolicyConfig.MinLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at least %d characters long", p.passwordPolicyConfig.MinLength)
+	}
+
+	if p.passwordPolicyConfig.MaxLength > 0 && passwordLength > p.passwordPolicyConfig.MaxLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at most %d characters long", p.passwordPolicyConfig.MaxLength)
+	}
+
+	if p.passwordPolicyConfig.RequireLowercase && strings.IndexFunc(password, unicode.IsLower) == -1 {
+		return status.Error(codes.InvalidArgument, "password must contain a lowercase letter")
+	}
+
+	if p.passwordPolicyConfig.RequireUppercase && strings.IndexFunc(password, unicode.IsUpper) == -1 {
+		return status.Error(codes.InvalidArgument, "password must contain an uppercase letter")
+	}
+
+	if p.passwordPolicyConfig.RequireDigit && strings.IndexFunc(password, unicode.IsDigit) == -1 {
+		return status.Error(codes.InvalidArgument, "password must contain a digit")
+	}
+
+	isSymbol := func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r)
+	}
+	if p.passwordPolicyConfig.RequireSymbol && strings.IndexFunc(password, isSymbol) == -1 {
+		return status.Error(codes.InvalidArgument, "password must contain a symbol")
+	}
+
+	if _, ok := p.breachedPasswordSet[strings.ToLower(password)]; ok {
+		return errPasswordBreached
+	}
+
+	return nil
+}
@@ -2,14 +2,18 @@ package logic
 
 import (
 	"context"
+	"crypto/cipher"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"database/sql"
 	"encoding/pem"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/golang-jwt/jwt"
 	"github.com/hoangdv99/morgana/internal/configs"
 	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
@@ -30,26 +34,44 @@ var (
 	errCannotGetTokensKidClaim = status.Error(codes.Unauthenticated, "cannot get token's kid claim")
 	errCannotGetTokensSubClaim = status.Error(codes.Unauthenticated, "cannot get token's sub claim")
 	errCannotGetTokensExpClaim = status.Error(codes.Unauthenticated, "cannot get token's exp claim")
+	errSessionRevoked          = status.Error(codes.Unauthenticated, "session has been revoked")
 	errTokenPublicKeyNotFound  = status.Error(codes.Unauthenticated, "token public key not found")
 	errInvalidToken            = status.Error(codes.Unauthenticated, "invalid token")
 	errFailedToSignToken       = status.Error(codes.Internal, "failed to sign token")
 )
 
+type TokenClaims struct {
+	AccountID uint64
+	// SessionID is 0 for tokens issued before sessions were introduced.
+	SessionID  uint64
+	ExpireTime time.Time
+}
+
 type Token interface {
-	GetToken(ctx context.Context, accountID uint64) (string, time.Time, error)
+	GetToken(ctx context.Context, accountID uint64, sessionID uint64) (string, time.Time, error)
+	GetTokenClaims(ctx context.Context, token string) (TokenClaims, error)
 	GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error)
+	RevokeSession(ctx context.Context, sessionID uint64) error
+	RotateSigningKey(ctx context.Context) error
+	GetJSONWebKeySet(ctx context.Context) (GetJSONWebKeySetOutput, error)
 	WithDatabase(database database.Database) Token
 }
 
 type token struct {
-	accountDataAccessor database.AccountDataAccessor
-	tokenPublicKeyCache cache.TokenPublicKey
-	tokenPublicKey      database.TokenPublicKeyDataAccessor
-	expiresIn           time.Duration
-	privateKey          *rsa.PrivateKey
-	tokenPublicKeyID    uint64
-	authConfig          configs.Auth
-	logger              *zap.Logger
+	accountDataAccessor      database.AccountDataAccessor
+	sessionDataAccessor      database.SessionDataAccessor
+	tokenPublicKeyCache      cache.TokenPublicKey
+	revokedSessionCache      cache.RevokedSession
+	tokenPublicKey           database.TokenPublicKeyDataAccessor
+	goquDatabase             *goqu.Database
+	expiresIn                time.Duration
+	rotationInterval         time.Duration
+	overlap                  time.Duration
+	signingKeyEncryptionAEAD cipher.AEAD
+	signingKeyCache          *tokenSigningKeyCache
+	authConfig               configs.Auth
+	cacheConfig              configs.Cache
+	logger                   *zap.Logger
 }
 
 func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
@@ -77,9 +99,13 @@ func pemEncodePrivateKey(pubKey *rsa.PublicKey) ([]byte, error) {
 
 func NewToken(
 	accountDataAccessor database.AccountDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
 	tokenPublicKeyCache cache.TokenPublicKey,
+	revokedSessionCache cache.RevokedSession,
 	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	goquDatabase *goqu.Database,
 	authConfig configs.Auth,
+	cacheConfig configs.Cache,
 	logger *zap.Logger,
 ) (Token, error) {
 	expiresIn, err := authConfig.Token.GetExpiresInDuration()
@@ -88,40 +114,56 @@ func NewToken(
 		return nil, err
 	}
 
-	rsaKeyPair, err := generateRSAKeyPair(rs512KeyPairBitCount)
+	rotationInterval, err := authConfig.Token.SigningKey.GetRotationIntervalDuration()
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to generate RSA key pair")
+		logger.With(zap.Error(err)).Error("failed to get token signing key rotation interval duration")
 		return nil, err
 	}
 
-	publicKeyBytes, err := pemEncodePrivateKey(&rsaKeyPair.PublicKey)
+	overlap, err := authConfig.Token.SigningKey.GetOverlapDuration()
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to encode public key to PEM format")
+		logger.With(zap.Error(err)).Error("failed to get token signing key overlap duration")
 		return nil, err
 	}
 
-	tokenPublicKeyID, err := tokenPublicKeyDataAccessor.CreatePublicKey(
-		context.Background(),
-		database.TokenPublicKey{
-			PublicKey: string(publicKeyBytes),
-		},
-	)
+	if overlap < tokenSigningKeyRefreshInterval || rotationInterval <= overlap {
+		return nil, fmt.Errorf(
+			"token signing key overlap must be at least %s and shorter than the rotation interval",
+			tokenSigningKeyRefreshInterval,
+		)
+	}
 
+	signingKeyEncryptionAEAD, err := getTokenSigningKeyEncryptionAEAD(authConfig.Token.SigningKey)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create token public key")
+		logger.With(zap.Error(err)).Error("failed to initialize token signing key encryption")
 		return nil, err
 	}
 
-	return &token{
-		accountDataAccessor: accountDataAccessor,
-		tokenPublicKeyCache: tokenPublicKeyCache,
-		tokenPublicKey:      tokenPublicKeyDataAccessor,
-		expiresIn:           expiresIn,
-		privateKey:          rsaKeyPair,
-		tokenPublicKeyID:    tokenPublicKeyID,
-		authConfig:          authConfig,
-		logger:              logger,
-	}, nil
+	t := &token{
+		accountDataAccessor:      accountDataAccessor,
+		sessionDataAccessor:      sessionDataAccessor,
+		tokenPublicKeyCache:      tokenPublicKeyCache,
+		revokedSessionCache:      revokedSessionCache,
+		tokenPublicKey:           tokenPublicKeyDataAccessor,
+		goquDatabase:             goquDatabase,
+		expiresIn:                expiresIn,
+		rotationInterval:         rotationInterval,
+		overlap:                  overlap,
+		signingKeyEncryptionAEAD: signingKeyEncryptionAEAD,
+		signingKeyCache:          new(tokenSigningKeyCache),
+		authConfig:               authConfig,
+		cacheConfig:              cacheConfig,
+		logger:                   logger,
+	}
+
+	// Makes sure a signing key exists before the first token is issued. Later rotations are done
+	// by the cron job.
+	err = t.RotateSigningKey(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
 }
 
 func (t token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey, error) {
@@ -141,6 +183,7 @@ func (t token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey,
 		}
 
 		logger.With(zap.Error(err)).Error("cannot get token's public key from database")
+		return nil, err
 	}
 
 	err = t.tokenPublicKeyCache.Set(ctx, id, tokenPublicKey.PublicKey)
@@ -151,7 +194,32 @@ func (t token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey,
 	return jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
 }
 
-func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error) {
+func (t token) isSessionRevoked(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("session_id", sessionID))
+
+	revoked, err := t.revokedSessionCache.Has(ctx, sessionID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to check revoked sessions cache, will fall back to database")
+	} else if revoked || t.cacheConfig.Type != configs.CacheTypeInMemory {
+		return revoked, nil
+	}
+
+	// The in-memory cache only holds the sessions revoked by this replica, so a miss there is not
+	// enough: revoked sessions are deleted from the database, which every replica shares.
+
+	session, err := t.sessionDataAccessor.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, database.ErrSessionNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return !session.ExpiresAt.After(time.Now()), nil
+}
+
+func (t token) GetTokenClaims(ctx context.Context, token string) (TokenClaims, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
 	parsedToken, err := jwt.Parse(token, func(parsedToken *jwt.Token) (interface{}, error) {
@@ -166,6 +234,18 @@ func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uin
 			return nil, errCannotGetTokensClaims
 		}
 
+		// Tokens carry the key ID in their header, as expected by JWKS consumers. Tokens issued
+		// before that only have it as a claim.
+		if kid, ok := parsedToken.Header["kid"].(string); ok {
+			tokenPublicKeyID, parseErr := strconv.ParseUint(kid, 10, 64)
+			if parseErr != nil {
+				logger.Error("failed to parse token public key id from header")
+				return nil, errCannotGetTokensKidClaim
+			}
+
+			return t.getJWTPublicKey(ctx, tokenPublicKeyID)
+		}
+
 		tokenPublicKeyID, ok := claims["kid"].(float64)
 		if !ok {
 			logger.Error("failed to get token public key id from claims")
@@ -177,46 +257,89 @@ func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uin
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to parse token")
-		return 0, time.Time{}, err
+		return TokenClaims{}, err
 	}
 
 	if !parsedToken.Valid {
 		logger.Error("invalid token")
-		return 0, time.Time{}, errInvalidToken
+		return TokenClaims{}, errInvalidToken
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		logger.Error("cannot get token's claims")
-		return 0, time.Time{}, errCannotGetTokensClaims
+		return TokenClaims{}, errCannotGetTokensClaims
 	}
 
 	accountID, ok := claims["sub"].(float64)
 	if !ok {
 		logger.Error("cannot get token's sub claim")
-		return 0, time.Time{}, errCannotGetTokensSubClaim
+		return TokenClaims{}, errCannotGetTokensSubClaim
 	}
 
 	expireTimeUnix, ok := claims["exp"].(float64)
 	if !ok {
 		logger.Error("cannot get token's exp claim")
-		return 0, time.Time{}, errCannotGetTokensExpClaim
+		return TokenClaims{}, errCannotGetTokensExpClaim
+	}
+
+	// Tokens issued before sessions were introduced have no sid claim. They are still accepted
+	// until they expire, which is at most expires_in after the upgrade, but cannot be revoked.
+	sessionID, ok := claims["sid"].(float64)
+	if ok {
+		sessionRevoked, err := t.isSessionRevoked(ctx, uint64(sessionID))
+		if err != nil {
+			return TokenClaims{}, err
+		}
+
+		if sessionRevoked {
+			return TokenClaims{}, errSessionRevoked
+		}
+	} else {
+		logger.Debug("token has no sid claim, accepting it without a session")
 	}
 
-	return uint64(accountID), time.Unix(int64(expireTimeUnix), 0), nil
+	utils.SetAccountID(ctx, uint64(accountID))
+	return TokenClaims{
+		AccountID:  uint64(accountID),
+		SessionID:  uint64(sessionID),
+		ExpireTime: time.Unix(int64(expireTimeUnix), 0),
+	}, nil
 }
 
-func (t token) GetToken(ctx context.Context, accountID uint64) (string, time.Time, error) {
+func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error) {
+	tokenClaims, err := t.GetTokenClaims(ctx, token)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return tokenClaims.AccountID, tokenClaims.ExpireTime, nil
+}
+
+// RevokeSession makes access tokens already issued for the session fail verification before they
+// expire. The session itself is expected to be deleted by the caller.
+func (t token) RevokeSession(ctx context.Context, sessionID uint64) error {
+	return t.revokedSessionCache.Add(ctx, sessionID, t.expiresIn)
+}
+
+func (t token) GetToken(ctx context.Context, accountID uint64, sessionID uint64) (string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	signingKey, err := t.getSigningKey(ctx)
+	if err != nil {
+		return "", time.Time{}, errFailedToSignToken
+	}
+
 	expireTime := time.Now().Add(t.expiresIn)
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
 		"sub": accountID,
+		"sid": sessionID,
 		"exp": expireTime.Unix(),
-		"kid": t.tokenPublicKeyID,
+		"kid": signingKey.id,
 	})
+	token.Header["kid"] = strconv.FormatUint(signingKey.id, 10)
 
-	tokenString, err := token.SignedString(t.privateKey)
+	tokenString, err := token.SignedString(signingKey.privateKey)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to sign token")
 		return "", time.Time{}, errFailedToSignToken
@@ -227,5 +350,6 @@ func (t token) GetToken(ctx context.Context, accountID uint64) (string, time.Tim
 
 func (t token) WithDatabase(database database.Database) Token {
 	t.accountDataAccessor = t.accountDataAccessor.WithDatabase(database)
+	t.sessionDataAccessor = t.sessionDataAccessor.WithDatabase(database)
 	return t
 }
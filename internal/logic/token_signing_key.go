@@ -0,0 +1,305 @@
+package logic
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/golang-jwt/jwt"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The signing key is looked up again at this interval, so that every replica switches to a
+	// newly activated key shortly after its activation time. The rotation overlap must be longer.
+	tokenSigningKeyRefreshInterval = time.Minute
+
+	jsonWebKeyTypeRSA      = "RSA"
+	jsonWebKeyUseSignature = "sig"
+
+	tokenSigningKeyEncryptionKeyEnvName = "MORGANA_TOKEN_SIGNING_KEY_ENCRYPTION_KEY"
+)
+
+var (
+	errTokenSigningKeyNotFound = status.Error(codes.Internal, "token signing key not found")
+	// tokenSigningKeyAdditionalData binds encrypted private keys to their purpose.
+	tokenSigningKeyAdditionalData = []byte("morgana-token-signing-key")
+)
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+type GetJSONWebKeySetOutput struct {
+	Keys []JSONWebKey `json:"keys"`
+	// MaxAge is how long verifiers may cache the key set without missing a newly published key
+	// before it starts signing.
+	MaxAge time.Duration `json:"-"`
+}
+
+type tokenSigningKey struct {
+	id         uint64
+	privateKey *rsa.PrivateKey
+}
+
+// tokenSigningKeyCache holds the key tokens are currently signed with. It is shared by all copies
+// of a token logic created through WithDatabase.
+type tokenSigningKeyCache struct {
+	mutex       sync.Mutex
+	signingKey  *tokenSigningKey
+	refreshTime time.Time
+}
+
+func getTokenSigningKeyEncryptionAEAD(signingKeyConfig configs.TokenSigningKey) (cipher.AEAD, error) {
+	aead, err := getEncryptionAEAD(
+		signingKeyConfig.EncryptionKey,
+		signingKeyConfig.EncryptionKeyFile,
+		tokenSigningKeyEncryptionKeyEnvName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token signing key encryption key: %w", err)
+	}
+
+	return aead, nil
+}
+
+func (t token) encryptPrivateKey(privateKey *rsa.PrivateKey) ([]byte, error) {
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return sealWithRandomNonce(t.signingKeyEncryptionAEAD, privateKeyBytes, tokenSigningKeyAdditionalData)
+}
+
+func (t token) decryptPrivateKey(encryptedPrivateKey []byte) (*rsa.PrivateKey, error) {
+	privateKeyBytes, err := openWithPrependedNonce(t.signingKeyEncryptionAEAD, encryptedPrivateKey, tokenSigningKeyAdditionalData)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA private key")
+	}
+
+	return rsaPrivateKey, nil
+}
+
+// getActiveTokenPublicKey returns the most recently activated key that can sign tokens.
+func getActiveTokenPublicKey(tokenPublicKeyList []database.TokenPublicKey, now time.Time) (database.TokenPublicKey, bool) {
+	for i := len(tokenPublicKeyList) - 1; i >= 0; i-- {
+		if len(tokenPublicKeyList[i].EncryptedPrivateKey) > 0 && !tokenPublicKeyList[i].ActivatedAt.After(now) {
+			return tokenPublicKeyList[i], true
+		}
+	}
+
+	return database.TokenPublicKey{}, false
+}
+
+func (t token) getSigningKey(ctx context.Context) (*tokenSigningKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	t.signingKeyCache.mutex.Lock()
+	defer t.signingKeyCache.mutex.Unlock()
+
+	now := time.Now()
+	if t.signingKeyCache.signingKey != nil && now.Before(t.signingKeyCache.refreshTime) {
+		return t.signingKeyCache.signingKey, nil
+	}
+
+	tokenPublicKeyList, err := t.tokenPublicKey.GetPublicKeyList(ctx)
+	if err != nil {
+		if t.signingKeyCache.signingKey != nil {
+			logger.With(zap.Error(err)).Warn("failed to refresh token signing key, will keep using the current one")
+			return t.signingKeyCache.signingKey, nil
+		}
+		return nil, err
+	}
+
+	activeTokenPublicKey, ok := getActiveTokenPublicKey(tokenPublicKeyList, now)
+	if !ok {
+		logger.Error("no active token signing key found")
+		return nil, errTokenSigningKeyNotFound
+	}
+
+	if t.signingKeyCache.signingKey == nil || t.signingKeyCache.signingKey.id != activeTokenPublicKey.ID {
+		privateKey, err := t.decryptPrivateKey(activeTokenPublicKey.EncryptedPrivateKey)
+		if err != nil {
+			logger.With(zap.Uint64("id", activeTokenPublicKey.ID)).With(zap.Error(err)).Error("failed to decrypt token signing key")
+			return nil, errTokenSigningKeyNotFound
+		}
+
+		t.signingKeyCache.signingKey = &tokenSigningKey{
+			id:         activeTokenPublicKey.ID,
+			privateKey: privateKey,
+		}
+		logger.With(zap.Uint64("id", activeTokenPublicKey.ID)).Info("switched token signing key")
+	}
+
+	t.signingKeyCache.refreshTime = now.Add(tokenSigningKeyRefreshInterval)
+	return t.signingKeyCache.signingKey, nil
+}
+
+func (t token) createSigningKey(ctx context.Context, td *goqu.TxDatabase, activatedAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	rsaKeyPair, err := generateRSAKeyPair(rs512KeyPairBitCount)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate RSA key pair")
+		return status.Error(codes.Internal, "failed to generate RSA key pair")
+	}
+
+	publicKeyBytes, err := pemEncodePrivateKey(&rsaKeyPair.PublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encode public key to PEM format")
+		return status.Error(codes.Internal, "failed to encode public key to PEM format")
+	}
+
+	encryptedPrivateKey, err := t.encryptPrivateKey(rsaKeyPair)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encrypt private key")
+		return status.Error(codes.Internal, "failed to encrypt private key")
+	}
+
+	tokenPublicKeyID, err := t.tokenPublicKey.WithDatabase(td).CreatePublicKey(ctx, database.TokenPublicKey{
+		PublicKey:           string(publicKeyBytes),
+		EncryptedPrivateKey: encryptedPrivateKey,
+		ActivatedAt:         activatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.
+		With(zap.Uint64("id", tokenPublicKeyID)).
+		With(zap.Time("activated_at", activatedAt)).
+		Info("created token signing key")
+	return nil
+}
+
+// RotateSigningKey creates a new signing key once the current one is due for rotation, and
+// deletes keys that no unexpired token can have been signed with anymore. It is safe to run
+// concurrently from several replicas.
+func (t token) RotateSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	retiredTokenPublicKeyIDList := make([]uint64, 0)
+	err := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		tokenPublicKeyList, err := t.tokenPublicKey.WithDatabase(td).GetPublicKeyListWithXLock(ctx)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		var latestTokenPublicKey *database.TokenPublicKey
+		for i := range tokenPublicKeyList {
+			if len(tokenPublicKeyList[i].EncryptedPrivateKey) > 0 {
+				latestTokenPublicKey = &tokenPublicKeyList[i]
+			}
+		}
+
+		if latestTokenPublicKey == nil || !now.Before(latestTokenPublicKey.ActivatedAt.Add(t.rotationInterval-t.overlap)) {
+			// Without a key that can sign right now, there is nothing to overlap with.
+			activatedAt := now.Add(t.overlap)
+			if _, ok := getActiveTokenPublicKey(tokenPublicKeyList, now); !ok {
+				activatedAt = now
+			}
+
+			err = t.createSigningKey(ctx, td, activatedAt)
+			if err != nil {
+				return err
+			}
+
+			tokenPublicKeyList = append(tokenPublicKeyList, database.TokenPublicKey{ActivatedAt: activatedAt})
+		}
+
+		// A key stops signing when its successor is activated. Tokens it signed remain valid for at
+		// most the token lifetime after that, plus the overlap to account for replicas switching
+		// keys late.
+		for i := 0; i+1 < len(tokenPublicKeyList); i++ {
+			if now.Sub(tokenPublicKeyList[i+1].ActivatedAt) < t.expiresIn+t.overlap {
+				break
+			}
+
+			err = t.tokenPublicKey.WithDatabase(td).DeletePublicKey(ctx, tokenPublicKeyList[i].ID)
+			if err != nil {
+				return err
+			}
+
+			retiredTokenPublicKeyIDList = append(retiredTokenPublicKeyIDList, tokenPublicKeyList[i].ID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to rotate token signing key")
+		return err
+	}
+
+	for _, id := range retiredTokenPublicKeyIDList {
+		logger.With(zap.Uint64("id", id)).Info("retired token signing key")
+		if err = t.tokenPublicKeyCache.Delete(ctx, id); err != nil {
+			logger.With(zap.Uint64("id", id)).With(zap.Error(err)).Warn("failed to delete retired public key from cache")
+		}
+	}
+
+	return nil
+}
+
+func (t token) GetJSONWebKeySet(ctx context.Context) (GetJSONWebKeySetOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKeyList, err := t.tokenPublicKey.GetPublicKeyList(ctx)
+	if err != nil {
+		return GetJSONWebKeySetOutput{}, err
+	}
+
+	jsonWebKeyList := make([]JSONWebKey, 0, len(tokenPublicKeyList))
+	for _, tokenPublicKey := range tokenPublicKeyList {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
+		if err != nil {
+			logger.With(zap.Uint64("id", tokenPublicKey.ID)).With(zap.Error(err)).Error("failed to parse public key")
+			continue
+		}
+
+		jsonWebKeyList = append(jsonWebKeyList, JSONWebKey{
+			KeyType:   jsonWebKeyTypeRSA,
+			Use:       jsonWebKeyUseSignature,
+			Algorithm: jwt.SigningMethodRS512.Alg(),
+			KeyID:     strconv.FormatUint(tokenPublicKey.ID, 10),
+			Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		})
+	}
+
+	return GetJSONWebKeySetOutput{
+		Keys:   jsonWebKeyList,
+		MaxAge: t.overlap / 2,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/cache"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"go.uber.org/zap"
+)
+
+// newTestToken returns a token logic backed by a temporary SQLite database and its own in-memory
+// revoked sessions cache, like one replica of a deployment using the in-memory cache.
+func newTestToken(t *testing.T) (token, database.Session) {
+	t.Helper()
+
+	goquDatabase := newTestGoquDatabase(t)
+	logger := zap.NewNop()
+	cacheConfig := configs.Cache{Type: configs.CacheTypeInMemory}
+
+	accountID, err := database.NewAccountDataAccessor(goquDatabase, logger).
+		CreateAccount(context.Background(), database.Account{AccountName: "user"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	session := database.Session{
+		AccountID:   accountID,
+		RefreshedAt: time.Now(),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	session.ID, err = sessionDataAccessor.CreateSession(context.Background(), session)
+	if err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+
+	return token{
+		sessionDataAccessor: sessionDataAccessor,
+		revokedSessionCache: cache.NewRevokedSession(cache.NewInMemoryClient(cacheConfig, logger), logger),
+		expiresIn:           time.Hour,
+		cacheConfig:         cacheConfig,
+		logger:              logger,
+	}, session
+}
+
+func TestIsSessionRevokedByThisReplica(t *testing.T) {
+	tokenLogic, session := newTestToken(t)
+
+	revoked, err := tokenLogic.isSessionRevoked(context.Background(), session.ID)
+	if err != nil {
+		t.Fatalf("failed to check session: %v", err)
+	}
+	if revoked {
+		t.Fatal("expected an active session not to be revoked")
+	}
+
+	if err := tokenLogic.RevokeSession(context.Background(), session.ID); err != nil {
+		t.Fatalf("failed to revoke session: %v", err)
+	}
+
+	revoked, err = tokenLogic.isSessionRevoked(context.Background(), session.ID)
+	if err != nil {
+		t.Fatalf("failed to check session: %v", err)
+	}
+	if !revoked {
+		t.Fatal("expected the session revoked by this replica to be revoked")
+	}
+}
+
+func TestIsSessionRevokedByAnotherReplica(t *testing.T) {
+	tokenLogic, session := newTestToken(t)
+
+	// Another replica logging the session out deletes it from the shared database and only adds it
+	// to its own in-memory cache, so the cache of this replica stays empty.
+	if err := tokenLogic.sessionDataAccessor.DeleteSession(context.Background(), session.ID); err != nil {
+		t.Fatalf("failed to delete session: %v", err)
+	}
+
+	revoked, err := tokenLogic.isSessionRevoked(context.Background(), session.ID)
+	if err != nil {
+		t.Fatalf("failed to check session: %v", err)
+	}
+	if !revoked {
+		t.Fatal("expected the session revoked by another replica to be revoked")
+	}
+}
+
+func TestIsSessionRevokedAfterExpiry(t *testing.T) {
+	tokenLogic, session := newTestToken(t)
+
+	session.ExpiresAt = time.Now().Add(-time.Minute)
+	if err := tokenLogic.sessionDataAccessor.UpdateSession(context.Background(), session); err != nil {
+		t.Fatalf("failed to update session: %v", err)
+	}
+
+	revoked, err := tokenLogic.isSessionRevoked(context.Background(), session.ID)
+	if err != nil {
+		t.Fatalf("failed to check session: %v", err)
+	}
+	if !revoked {
+		t.Fatal("expected an expired session to be revoked")
+	}
+}
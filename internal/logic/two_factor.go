@@ -0,0 +1,487 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/hoangdv99/morgana/internal/utils"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	totpPeriod = 30
+	// totpAllowedTimeStepSkew is how many time steps a code may be off by, to tolerate clocks
+	// that drift a little and codes typed in just as they changed.
+	totpAllowedTimeStepSkew = 1
+	totpQRCodeImageSize     = 256
+
+	recoveryCodeByteCount      = 10
+	recoveryCodeGroupRuneCount = 4
+
+	twoFactorSecretEncryptionKeyEnvName = "MORGANA_TWO_FACTOR_ENCRYPTION_KEY"
+)
+
+var (
+	errTwoFactorAlreadyEnabled = status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	errTwoFactorNotEnrolled    = status.Error(codes.FailedPrecondition, "two-factor authentication has not been enrolled")
+	errTwoFactorNotEnabled     = status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	errInvalidTwoFactorCode    = status.Error(codes.Unauthenticated, "invalid two-factor code")
+	// twoFactorSecretAdditionalData binds encrypted secrets to their purpose.
+	twoFactorSecretAdditionalData = []byte("morgana-two-factor-secret")
+	recoveryCodeEncoding          = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+type EnrollTwoFactorParams struct {
+	Token string
+}
+
+type EnrollTwoFactorOutput struct {
+	Secret     string
+	OTPAuthURI string
+	QRCodePNG  []byte
+}
+
+type ConfirmTwoFactorParams struct {
+	Token string
+	Code  string
+}
+
+type ConfirmTwoFactorOutput struct {
+	RecoveryCodeList []string
+}
+
+type DisableTwoFactorParams struct {
+	Token string
+	Code  string
+}
+
+// TwoFactor manages TOTP two-factor authentication of accounts. Codes are accepted once, a TOTP
+// code cannot be used again even within the time it is valid, and recovery codes are deleted
+// along with the secret when two-factor authentication is disabled.
+type TwoFactor interface {
+	Enroll(ctx context.Context, params EnrollTwoFactorParams) (EnrollTwoFactorOutput, error)
+	Confirm(ctx context.Context, params ConfirmTwoFactorParams) (ConfirmTwoFactorOutput, error)
+	Disable(ctx context.Context, params DisableTwoFactorParams) error
+	IsEnabled(ctx context.Context, accountID uint64) (bool, error)
+	// VerifyCode checks a TOTP or recovery code of an account with two-factor authentication
+	// enabled, and uses it up if it is valid.
+	VerifyCode(ctx context.Context, accountID uint64, code string) (bool, error)
+}
+
+type twoFactor struct {
+	goquDatabase                             *goqu.Database
+	accountDataAccessor                      database.AccountDataAccessor
+	accountTwoFactorDataAccessor             database.AccountTwoFactorDataAccessor
+	accountTwoFactorRecoveryCodeDataAccessor database.AccountTwoFactorRecoveryCodeDataAccessor
+	tokenLogic                               Token
+	secretEncryptionAEAD                     cipher.AEAD
+	twoFactorConfig                          configs.TwoFactor
+	logger                                   *zap.Logger
+}
+
+func NewTwoFactor(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountTwoFactorDataAccessor database.AccountTwoFactorDataAccessor,
+	accountTwoFactorRecoveryCodeDataAccessor database.AccountTwoFactorRecoveryCodeDataAccessor,
+	tokenLogic Token,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TwoFactor, error) {
+	secretEncryptionAEAD, err := getEncryptionAEAD(
+		authConfig.TwoFactor.EncryptionKey,
+		authConfig.TwoFactor.EncryptionKeyFile,
+		twoFactorSecretEncryptionKeyEnvName,
+	)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get two-factor secret encryption key")
+		return nil, fmt.Errorf("invalid two-factor secret encryption key: %w", err)
+	}
+
+	return &twoFactor{
+		goquDatabase:                             goquDatabase,
+		accountDataAccessor:                      accountDataAccessor,
+		accountTwoFactorDataAccessor:             accountTwoFactorDataAccessor,
+		accountTwoFactorRecoveryCodeDataAccessor: accountTwoFactorRecoveryCodeDataAccessor,
+		tokenLogic:                               tokenLogic,
+		secretEncryptionAEAD:                     secretEncryptionAEAD,
+		twoFactorConfig:                          authConfig.TwoFactor,
+		logger:                                   logger,
+	}, nil
+}
+
+func (t twoFactor) getTOTPValidateOpts() totp.ValidateOpts {
+	return totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+}
+
+func (t twoFactor) decryptSecret(accountTwoFactor database.AccountTwoFactor) (string, error) {
+	secret, err := openWithPrependedNonce(t.secretEncryptionAEAD, accountTwoFactor.EncryptedSecret, twoFactorSecretAdditionalData)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
+
+// getTOTPTimeStep returns the time step a TOTP code was generated for, if it is valid at now.
+func (t twoFactor) getTOTPTimeStep(secret, code string, now time.Time) (uint64, bool) {
+	currentTimeStep := uint64(now.Unix()) / totpPeriod
+	for timeStep := currentTimeStep - totpAllowedTimeStepSkew; timeStep <= currentTimeStep+totpAllowedTimeStepSkew; timeStep++ {
+		expectedCode, err := totp.GenerateCodeCustom(secret, time.Unix(int64(timeStep*totpPeriod), 0), t.getTOTPValidateOpts())
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expectedCode), []byte(code)) == 1 {
+			return timeStep, true
+		}
+	}
+
+	return 0, false
+}
+
+// verifyTOTPCode checks a TOTP code and records its time step in accountTwoFactor, which the
+// caller is responsible for saving.
+func (t twoFactor) verifyTOTPCode(ctx context.Context, accountTwoFactor *database.AccountTwoFactor, code string) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountTwoFactor.AccountID))
+
+	secret, err := t.decryptSecret(*accountTwoFactor)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to decrypt two-factor secret")
+		return false, status.Error(codes.Internal, "failed to decrypt two-factor secret")
+	}
+
+	timeStep, ok := t.getTOTPTimeStep(secret, code, time.Now())
+	if !ok || timeStep <= accountTwoFactor.LastUsedTimeStep {
+		return false, nil
+	}
+
+	accountTwoFactor.LastUsedTimeStep = timeStep
+	return true, nil
+}
+
+func (t twoFactor) verifyRecoveryCode(ctx context.Context, td *goqu.TxDatabase, accountID uint64, code string) (bool, error) {
+	normalizedCode := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	recoveryCode, err := t.accountTwoFactorRecoveryCodeDataAccessor.WithDatabase(td).
+		GetAccountTwoFactorRecoveryCodeWithXLock(ctx, hashRandomToken(normalizedCode))
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTwoFactorRecoveryCodeNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if recoveryCode.AccountID != accountID || recoveryCode.UsedAt.Valid {
+		return false, nil
+	}
+
+	recoveryCode.UsedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err = t.accountTwoFactorRecoveryCodeDataAccessor.WithDatabase(td).UpdateAccountTwoFactorRecoveryCode(ctx, recoveryCode)
+	if err != nil {
+		return false, err
+	}
+
+	utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID)).Info("two-factor recovery code used")
+	return true, nil
+}
+
+// verifyCode checks a TOTP or recovery code of accountTwoFactor, which has to be locked in td.
+func (t twoFactor) verifyCode(
+	ctx context.Context,
+	td *goqu.TxDatabase,
+	accountTwoFactor database.AccountTwoFactor,
+	code string,
+) (bool, error) {
+	code = strings.TrimSpace(code)
+	if len(code) == otp.DigitsSix.Length() {
+		isCodeValid, err := t.verifyTOTPCode(ctx, &accountTwoFactor, code)
+		if err != nil || !isCodeValid {
+			return false, err
+		}
+
+		err = t.accountTwoFactorDataAccessor.WithDatabase(td).UpdateAccountTwoFactor(ctx, accountTwoFactor)
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return t.verifyRecoveryCode(ctx, td, accountTwoFactor.AccountID, code)
+}
+
+func (t twoFactor) generateRecoveryCode() (string, error) {
+	recoveryCodeBytes := make([]byte, recoveryCodeByteCount)
+	if _, err := rand.Read(recoveryCodeBytes); err != nil {
+		return "", err
+	}
+
+	return recoveryCodeEncoding.EncodeToString(recoveryCodeBytes), nil
+}
+
+// formatRecoveryCode splits a recovery code into dash separated groups to make it easier to copy.
+func (t twoFactor) formatRecoveryCode(recoveryCode string) string {
+	groupList := make([]string, 0)
+	for len(recoveryCode) > recoveryCodeGroupRuneCount {
+		groupList = append(groupList, recoveryCode[:recoveryCodeGroupRuneCount])
+		recoveryCode = recoveryCode[recoveryCodeGroupRuneCount:]
+	}
+
+	return strings.Join(append(groupList, recoveryCode), "-")
+}
+
+func (t twoFactor) Enroll(ctx context.Context, params EnrollTwoFactorParams) (EnrollTwoFactorOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenClaims, err := t.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, tokenClaims.AccountID)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      t.twoFactorConfig.Issuer,
+		AccountName: account.AccountName,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate two-factor secret")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to generate two-factor secret")
+	}
+
+	qrCodeImage, err := key.Image(totpQRCodeImageSize, totpQRCodeImageSize)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate two-factor qr code")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to generate two-factor qr code")
+	}
+
+	qrCodePNGBuffer := new(bytes.Buffer)
+	err = png.Encode(qrCodePNGBuffer, qrCodeImage)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encode two-factor qr code")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to encode two-factor qr code")
+	}
+
+	encryptedSecret, err := sealWithRandomNonce(t.secretEncryptionAEAD, []byte(key.Secret()), twoFactorSecretAdditionalData)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encrypt two-factor secret")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to encrypt two-factor secret")
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTwoFactor, err := t.accountTwoFactorDataAccessor.WithDatabase(td).GetAccountTwoFactorWithXLock(ctx, account.ID)
+		if err != nil {
+			if !errors.Is(err, database.ErrAccountTwoFactorNotFound) {
+				return err
+			}
+
+			return t.accountTwoFactorDataAccessor.WithDatabase(td).CreateAccountTwoFactor(ctx, database.AccountTwoFactor{
+				AccountID:       account.ID,
+				EncryptedSecret: encryptedSecret,
+			})
+		}
+
+		if accountTwoFactor.EnabledAt.Valid {
+			return errTwoFactorAlreadyEnabled
+		}
+
+		accountTwoFactor.EncryptedSecret = encryptedSecret
+		accountTwoFactor.LastUsedTimeStep = 0
+		return t.accountTwoFactorDataAccessor.WithDatabase(td).UpdateAccountTwoFactor(ctx, accountTwoFactor)
+	})
+	if txErr != nil {
+		return EnrollTwoFactorOutput{}, txErr
+	}
+
+	return EnrollTwoFactorOutput{
+		Secret:     key.Secret(),
+		OTPAuthURI: key.URL(),
+		QRCodePNG:  qrCodePNGBuffer.Bytes(),
+	}, nil
+}
+
+func (t twoFactor) Confirm(ctx context.Context, params ConfirmTwoFactorParams) (ConfirmTwoFactorOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenClaims, err := t.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return ConfirmTwoFactorOutput{}, err
+	}
+
+	recoveryCodeList := make([]string, 0, t.twoFactorConfig.RecoveryCodeCount)
+	databaseRecoveryCodeList := make([]database.AccountTwoFactorRecoveryCode, 0, t.twoFactorConfig.RecoveryCodeCount)
+	for range t.twoFactorConfig.RecoveryCodeCount {
+		recoveryCode, err := t.generateRecoveryCode()
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to generate two-factor recovery code")
+			return ConfirmTwoFactorOutput{}, status.Error(codes.Internal, "failed to generate two-factor recovery code")
+		}
+
+		recoveryCodeList = append(recoveryCodeList, t.formatRecoveryCode(recoveryCode))
+		databaseRecoveryCodeList = append(databaseRecoveryCodeList, database.AccountTwoFactorRecoveryCode{
+			CodeHash:  hashRandomToken(recoveryCode),
+			AccountID: tokenClaims.AccountID,
+		})
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTwoFactor, err := t.accountTwoFactorDataAccessor.WithDatabase(td).GetAccountTwoFactorWithXLock(ctx, tokenClaims.AccountID)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountTwoFactorNotFound) {
+				return errTwoFactorNotEnrolled
+			}
+			return err
+		}
+
+		if accountTwoFactor.EnabledAt.Valid {
+			return errTwoFactorAlreadyEnabled
+		}
+
+		isCodeValid, err := t.verifyTOTPCode(ctx, &accountTwoFactor, strings.TrimSpace(params.Code))
+		if err != nil {
+			return err
+		}
+
+		if !isCodeValid {
+			return errInvalidTwoFactorCode
+		}
+
+		accountTwoFactor.EnabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+		err = t.accountTwoFactorDataAccessor.WithDatabase(td).UpdateAccountTwoFactor(ctx, accountTwoFactor)
+		if err != nil {
+			return err
+		}
+
+		err = t.accountTwoFactorRecoveryCodeDataAccessor.WithDatabase(td).
+			DeleteAccountTwoFactorRecoveryCodeListOfAccount(ctx, tokenClaims.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if len(databaseRecoveryCodeList) == 0 {
+			return nil
+		}
+
+		return t.accountTwoFactorRecoveryCodeDataAccessor.WithDatabase(td).
+			CreateAccountTwoFactorRecoveryCodeList(ctx, databaseRecoveryCodeList)
+	})
+	if txErr != nil {
+		return ConfirmTwoFactorOutput{}, txErr
+	}
+
+	logger.With(zap.Uint64("account_id", tokenClaims.AccountID)).Info("two-factor authentication enabled")
+
+	return ConfirmTwoFactorOutput{
+		RecoveryCodeList: recoveryCodeList,
+	}, nil
+}
+
+func (t twoFactor) Disable(ctx context.Context, params DisableTwoFactorParams) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenClaims, err := t.tokenLogic.GetTokenClaims(ctx, params.Token)
+	if err != nil {
+		return err
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTwoFactor, err := t.accountTwoFactorDataAccessor.WithDatabase(td).GetAccountTwoFactorWithXLock(ctx, tokenClaims.AccountID)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountTwoFactorNotFound) {
+				return errTwoFactorNotEnabled
+			}
+			return err
+		}
+
+		if !accountTwoFactor.EnabledAt.Valid {
+			return errTwoFactorNotEnabled
+		}
+
+		isCodeValid, err := t.verifyCode(ctx, td, accountTwoFactor, params.Code)
+		if err != nil {
+			return err
+		}
+
+		if !isCodeValid {
+			return errInvalidTwoFactorCode
+		}
+
+		err = t.accountTwoFactorRecoveryCodeDataAccessor.WithDatabase(td).
+			DeleteAccountTwoFactorRecoveryCodeListOfAccount(ctx, tokenClaims.AccountID)
+		if err != nil {
+			return err
+		}
+
+		return t.accountTwoFactorDataAccessor.WithDatabase(td).DeleteAccountTwoFactor(ctx, tokenClaims.AccountID)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	logger.With(zap.Uint64("account_id", tokenClaims.AccountID)).Info("two-factor authentication disabled")
+	return nil
+}
+
+func (t twoFactor) IsEnabled(ctx context.Context, accountID uint64) (bool, error) {
+	accountTwoFactor, err := t.accountTwoFactorDataAccessor.GetAccountTwoFactor(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTwoFactorNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return accountTwoFactor.EnabledAt.Valid, nil
+}
+
+func (t twoFactor) VerifyCode(ctx context.Context, accountID uint64, code string) (bool, error) {
+	isCodeValid := false
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTwoFactor, err := t.accountTwoFactorDataAccessor.WithDatabase(td).GetAccountTwoFactorWithXLock(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountTwoFactorNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if !accountTwoFactor.EnabledAt.Valid {
+			return nil
+		}
+
+		isCodeValid, err = t.verifyCode(ctx, td, accountTwoFactor, code)
+		return err
+	})
+	if txErr != nil {
+		return false, txErr
+	}
+
+	return isCodeValid, nil
+}
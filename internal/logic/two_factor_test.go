@@ -0,0 +1,194 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"github.com/hoangdv99/morgana/internal/dataaccess/database"
+	"github.com/pquerna/otp/totp"
+	"go.uber.org/zap"
+)
+
+const testTwoFactorRecoveryCodeCount = 2
+
+// newTestTwoFactor returns a two-factor logic backed by a temporary SQLite database, and the ID of
+// an account every token is authenticated as.
+func newTestTwoFactor(t *testing.T) (twoFactor, uint64) {
+	t.Helper()
+
+	goquDatabase := newTestGoquDatabase(t)
+	logger := zap.NewNop()
+
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	accountID, err := accountDataAccessor.CreateAccount(context.Background(), database.Account{AccountName: "user"})
+	if err != nil {
+		t.Fatalf("failed to create account: %v", err)
+	}
+
+	encryptionKey := make([]byte, 32)
+	if _, err := rand.Read(encryptionKey); err != nil {
+		t.Fatalf("failed to generate encryption key: %v", err)
+	}
+
+	twoFactorLogic, err := NewTwoFactor(
+		goquDatabase,
+		accountDataAccessor,
+		database.NewAccountTwoFactorDataAccessor(goquDatabase, logger),
+		database.NewAccountTwoFactorRecoveryCodeDataAccessor(goquDatabase, logger),
+		&fakeToken{accountID: accountID},
+		configs.Auth{TwoFactor: configs.TwoFactor{
+			Issuer:            "Morgana",
+			EncryptionKey:     base64.StdEncoding.EncodeToString(encryptionKey),
+			RecoveryCodeCount: testTwoFactorRecoveryCodeCount,
+		}},
+		logger,
+	)
+	if err != nil {
+		t.Fatalf("failed to create two-factor logic: %v", err)
+	}
+
+	return *twoFactorLogic.(*twoFactor), accountID
+}
+
+// enableTestTwoFactor enrolls and confirms two-factor authentication with the code of the current
+// time step, and returns the secret and the recovery codes.
+func enableTestTwoFactor(t *testing.T, twoFactorLogic twoFactor) (string, []string) {
+	t.Helper()
+
+	enrollOutput, err := twoFactorLogic.Enroll(context.Background(), EnrollTwoFactorParams{})
+	if err != nil {
+		t.Fatalf("failed to enroll two-factor authentication: %v", err)
+	}
+
+	confirmOutput, err := twoFactorLogic.Confirm(context.Background(), ConfirmTwoFactorParams{
+		Code: generateTestTOTPCode(t, enrollOutput.Secret, time.Now()),
+	})
+	if err != nil {
+		t.Fatalf("failed to confirm two-factor authentication: %v", err)
+	}
+
+	return enrollOutput.Secret, confirmOutput.RecoveryCodeList
+}
+
+func generateTestTOTPCode(t *testing.T, secret string, now time.Time) string {
+	t.Helper()
+
+	code, err := totp.GenerateCode(secret, now)
+	if err != nil {
+		t.Fatalf("failed to generate code: %v", err)
+	}
+
+	return code
+}
+
+func TestGetTOTPTimeStepAcceptsAdjacentTimeSteps(t *testing.T) {
+	twoFactorLogic, _ := newTestTwoFactor(t)
+	secret, _ := enableTestTwoFactor(t, twoFactorLogic)
+
+	now := time.Unix(1_700_000_000, 0)
+	currentTimeStep := uint64(now.Unix()) / totpPeriod
+
+	for _, timeStepOffset := range []int{-1, 0, 1} {
+		code := generateTestTOTPCode(t, secret, now.Add(time.Duration(timeStepOffset)*totpPeriod*time.Second))
+
+		timeStep, ok := twoFactorLogic.getTOTPTimeStep(secret, code, now)
+		if !ok {
+			t.Fatalf("expected the code of time step offset %d to be accepted", timeStepOffset)
+		}
+		if timeStep != uint64(int64(currentTimeStep)+int64(timeStepOffset)) {
+			t.Fatalf("expected the code of time step offset %d to have time step %d, got %d",
+				timeStepOffset, int64(currentTimeStep)+int64(timeStepOffset), timeStep)
+		}
+	}
+
+	for _, timeStepOffset := range []int{-2, 2} {
+		code := generateTestTOTPCode(t, secret, now.Add(time.Duration(timeStepOffset)*totpPeriod*time.Second))
+		if _, ok := twoFactorLogic.getTOTPTimeStep(secret, code, now); ok {
+			t.Fatalf("expected the code of time step offset %d to be rejected", timeStepOffset)
+		}
+	}
+}
+
+func TestVerifyCodeRejectsReusedTOTPCode(t *testing.T) {
+	twoFactorLogic, accountID := newTestTwoFactor(t)
+	secret, _ := enableTestTwoFactor(t, twoFactorLogic)
+	ctx := context.Background()
+
+	// The code of the current time step was used up when confirming.
+	isCodeValid, err := twoFactorLogic.VerifyCode(ctx, accountID, generateTestTOTPCode(t, secret, time.Now()))
+	if err != nil {
+		t.Fatalf("failed to verify code: %v", err)
+	}
+	if isCodeValid {
+		t.Fatal("expected the code used to confirm two-factor authentication to be rejected")
+	}
+
+	nextCode := generateTestTOTPCode(t, secret, time.Now().Add(totpPeriod*time.Second))
+	isCodeValid, err = twoFactorLogic.VerifyCode(ctx, accountID, nextCode)
+	if err != nil {
+		t.Fatalf("failed to verify code: %v", err)
+	}
+	if !isCodeValid {
+		t.Fatal("expected the code of the next time step to be accepted")
+	}
+
+	accountTwoFactor, err := twoFactorLogic.accountTwoFactorDataAccessor.GetAccountTwoFactor(ctx, accountID)
+	if err != nil {
+		t.Fatalf("failed to get account two-factor: %v", err)
+	}
+	nextTimeStep, _ := twoFactorLogic.getTOTPTimeStep(secret, nextCode, time.Now())
+	if accountTwoFactor.LastUsedTimeStep != nextTimeStep {
+		t.Fatalf("expected the last used time step to be %d, got %d", nextTimeStep, accountTwoFactor.LastUsedTimeStep)
+	}
+
+	for _, code := range []string{nextCode, generateTestTOTPCode(t, secret, time.Now().Add(-totpPeriod*time.Second))} {
+		isCodeValid, err = twoFactorLogic.VerifyCode(ctx, accountID, code)
+		if err != nil {
+			t.Fatalf("failed to verify code: %v", err)
+		}
+		if isCodeValid {
+			t.Fatal("expected a code of a time step not after the last used one to be rejected")
+		}
+	}
+}
+
+func TestVerifyCodeAcceptsRecoveryCodeOnce(t *testing.T) {
+	twoFactorLogic, accountID := newTestTwoFactor(t)
+	_, recoveryCodeList := enableTestTwoFactor(t, twoFactorLogic)
+	ctx := context.Background()
+
+	if len(recoveryCodeList) != testTwoFactorRecoveryCodeCount {
+		t.Fatalf("expected %d recovery codes, got %d", testTwoFactorRecoveryCodeCount, len(recoveryCodeList))
+	}
+
+	// Recovery codes are accepted however they are typed in.
+	recoveryCode := strings.ToLower(strings.ReplaceAll(recoveryCodeList[0], "-", " "))
+	isCodeValid, err := twoFactorLogic.VerifyCode(ctx, accountID, recoveryCode)
+	if err != nil {
+		t.Fatalf("failed to verify recovery code: %v", err)
+	}
+	if !isCodeValid {
+		t.Fatal("expected the recovery code to be accepted")
+	}
+
+	isCodeValid, err = twoFactorLogic.VerifyCode(ctx, accountID, recoveryCodeList[0])
+	if err != nil {
+		t.Fatalf("failed to verify recovery code: %v", err)
+	}
+	if isCodeValid {
+		t.Fatal("expected the used recovery code to be rejected")
+	}
+
+	isCodeValid, err = twoFactorLogic.VerifyCode(ctx, accountID, recoveryCodeList[1])
+	if err != nil {
+		t.Fatalf("failed to verify recovery code: %v", err)
+	}
+	if !isCodeValid {
+		t.Fatal("expected the other recovery code to be accepted")
+	}
+}
@@ -5,7 +5,14 @@ import "github.com/google/wire"
 var WireSet = wire.NewSet(
 	NewAccount,
 	NewHash,
+	NewPasswordPolicy,
+	NewLoginThrottle,
+	NewTwoFactor,
 	NewToken,
+	NewAPIKey,
 	NewDownloadTask,
+	NewAdmin,
+	NewOrganization,
 	NewHTTPDownloader,
+	NewLeaderElection,
 )
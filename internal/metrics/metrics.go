@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "morgana"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+
+	DownloadResultSuccess     = "success"
+	DownloadResultFailed      = "failed"
+	DownloadResultCanceled    = "canceled"
+	DownloadResultInterrupted = "interrupted"
+
+	CacheResultHit   = "hit"
+	CacheResultMiss  = "miss"
+	CacheResultError = "error"
+
+	SchedulerLeadershipChangeAcquired = "acquired"
+	SchedulerLeadershipChangeLost     = "lost"
+	SchedulerLeadershipChangeResigned = "resigned"
+)
+
+var (
+	// Downloads can take from a fraction of a second to hours, so their buckets go from a second
+	// to a little over an hour.
+	downloadDurationBuckets = prometheus.ExponentialBuckets(1, 2, 13)
+	// Cron jobs process every matching row, so they are expected to run longer than requests.
+	cronJobDurationBuckets = prometheus.ExponentialBuckets(0.01, 4, 10)
+)
+
+var (
+	GRPCRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+	GRPCRequestDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle gRPC requests, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests handled, by handler, method and status code.",
+	}, []string{"handler", "method", "code"})
+	HTTPRequestDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle HTTP requests, by handler and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	DownloadsStartedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "download",
+		Name:      "started_total",
+		Help:      "Number of downloads started, by download type.",
+	}, []string{"download_type"})
+	DownloadsFinishedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "download",
+		Name:      "finished_total",
+		Help:      "Number of downloads finished, by download type and result.",
+	}, []string{"download_type", "result"})
+	DownloadedBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "download",
+		Name:      "bytes_total",
+		Help:      "Number of bytes downloaded, including those of downloads that did not succeed, by download type.",
+	}, []string{"download_type"})
+	DownloadDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "download",
+		Name:      "duration_seconds",
+		Help:      "Time taken by downloads, by download type and result.",
+		Buckets:   downloadDurationBuckets,
+	}, []string{"download_type", "result"})
+	ActiveDownloads = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "download",
+		Name:      "active",
+		Help:      "Number of downloads in progress, by download type.",
+	}, []string{"download_type"})
+
+	MQConsumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mq",
+		Name:      "consumer_lag",
+		Help:      "Number of messages of a queue partition not consumed yet, as of the last consumed message.",
+	}, []string{"queue_name", "partition"})
+
+	CacheLookupsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of cache lookups, by cache type and result.",
+	}, []string{"cache_type", "result"})
+
+	DatabaseQueryDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "database",
+		Name:      "query_duration_seconds",
+		Help:      "Time taken by database statements, including waiting for locks, by operation and table.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "table"})
+
+	CronJobRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cron",
+		Name:      "job_runs_total",
+		Help:      "Number of cron job runs, by job and result.",
+	}, []string{"job", "result"})
+	CronJobDurationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cron",
+		Name:      "job_duration_seconds",
+		Help:      "Time taken by cron job runs, by job.",
+		Buckets:   cronJobDurationBuckets,
+	}, []string{"job"})
+	SchedulerLeader = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "cron",
+		Name:      "scheduler_leader",
+		Help:      "Whether this replica holds the scheduler leadership, and thus runs the cron jobs.",
+	})
+	SchedulerLeadershipChangesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cron",
+		Name:      "scheduler_leadership_changes_total",
+		Help:      "Number of times this replica acquired, lost or resigned the scheduler leadership, by change.",
+	}, []string{"change"})
+)
+
+// GetResult returns the result label of an operation that returned err.
+func GetResult(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+
+	return ResultSuccess
+}
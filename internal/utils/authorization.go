@@ -0,0 +1,18 @@
+package utils
+
+import "strings"
+
+const (
+	authorizationBearerPrefix = "bearer "
+)
+
+// GetBearerToken returns the credential of an Authorization header or metadata value using the
+// Bearer scheme, whose name is case insensitive. It reports false for any other scheme.
+func GetBearerToken(authorization string) (string, bool) {
+	if len(authorization) <= len(authorizationBearerPrefix) ||
+		!strings.EqualFold(authorization[:len(authorizationBearerPrefix)], authorizationBearerPrefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(authorization[len(authorizationBearerPrefix):]), true
+}
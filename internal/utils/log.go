@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hoangdv99/morgana/internal/configs"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -40,6 +41,28 @@ func InitializeLogger(logConfig configs.Log) (*zap.Logger, func(), error) {
 	return logger, cleanup, nil
 }
 
-func LoggerWithContext(_ context.Context, logger *zap.Logger) *zap.Logger {
-	return logger
+// LoggerWithContext adds the IDs of the trace, span, request and account of ctx, when known, to
+// the fields of logger, so that the log lines of a request or task can be correlated.
+func LoggerWithContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	fieldList := make([]zap.Field, 0, 4)
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fieldList = append(fieldList,
+			zap.String("trace_id", spanContext.TraceID().String()),
+			zap.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+
+	if requestID := GetRequestID(ctx); requestID != "" {
+		fieldList = append(fieldList, zap.String("request_id", requestID))
+	}
+
+	if accountID, ok := GetAccountID(ctx); ok {
+		fieldList = append(fieldList, zap.Uint64("account_id", accountID))
+	}
+
+	if len(fieldList) == 0 {
+		return logger
+	}
+
+	return logger.With(fieldList...)
 }
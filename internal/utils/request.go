@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+)
+
+type requestInfoContextKey struct{}
+
+// requestInfo is shared by all the contexts derived from the one it was added to, so that the
+// account authenticated deep down a request is known to the log lines written after it.
+type requestInfo struct {
+	requestID string
+	accountID atomic.Uint64
+}
+
+func getRequestInfo(ctx context.Context) *requestInfo {
+	info, _ := ctx.Value(requestInfoContextKey{}).(*requestInfo)
+	return info
+}
+
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// WithRequestID marks ctx as the one of a request or task with the given ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey{}, &requestInfo{requestID: requestID})
+}
+
+func GetRequestID(ctx context.Context) string {
+	info := getRequestInfo(ctx)
+	if info == nil {
+		return ""
+	}
+
+	return info.requestID
+}
+
+// SetAccountID records the account that the request of ctx was authenticated as. It does nothing
+// if ctx does not belong to a request.
+func SetAccountID(ctx context.Context, accountID uint64) {
+	if info := getRequestInfo(ctx); info != nil {
+		info.accountID.Store(accountID)
+	}
+}
+
+func GetAccountID(ctx context.Context) (uint64, bool) {
+	info := getRequestInfo(ctx)
+	if info == nil {
+		return 0, false
+	}
+
+	accountID := info.accountID.Load()
+	return accountID, accountID != 0
+}
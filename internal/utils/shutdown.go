@@ -0,0 +1,29 @@
+package utils
+
+import "context"
+
+type shutdownDeadlineContextKey struct{}
+
+// WithShutdownDeadline returns a copy of ctx carrying deadlineCtx, which is canceled once the
+// shutdown timeout has expired after a shutdown signal was received.
+func WithShutdownDeadline(ctx context.Context, deadlineCtx context.Context) context.Context {
+	return context.WithValue(ctx, shutdownDeadlineContextKey{}, deadlineCtx)
+}
+
+// WithoutCancelUntilShutdownDeadline returns a context with the values of ctx that is not canceled
+// along with ctx, but only once the shutdown deadline has expired, so that work already in
+// progress when the process is asked to stop is given the shutdown timeout to finish. Without a
+// shutdown deadline in ctx, the returned context is canceled along with ctx.
+func WithoutCancelUntilShutdownDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadlineCtx, ok := ctx.Value(shutdownDeadlineContextKey{}).(context.Context)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(deadlineCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
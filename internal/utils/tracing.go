@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hoangdv99/morgana/internal/configs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+func newSpanExporter(ctx context.Context, tracingConfig configs.Tracing) (sdktrace.SpanExporter, error) {
+	switch tracingConfig.Exporter {
+	case configs.TracingExporterOTLPHTTP:
+		optionList := []otlptracehttp.Option{otlptracehttp.WithEndpoint(tracingConfig.Endpoint)}
+		if tracingConfig.Insecure {
+			optionList = append(optionList, otlptracehttp.WithInsecure())
+		}
+
+		return otlptracehttp.New(ctx, optionList...)
+
+	case configs.TracingExporterOTLPGRPC, "":
+		optionList := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(tracingConfig.Endpoint)}
+		if tracingConfig.Insecure {
+			optionList = append(optionList, otlptracegrpc.WithInsecure())
+		}
+
+		return otlptracegrpc.New(ctx, optionList...)
+
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter: %s", tracingConfig.Exporter)
+	}
+}
+
+// InitializeTracerProvider also registers the tracer provider and the W3C trace context
+// propagator globally, for the instrumentation that does not take them explicitly.
+func InitializeTracerProvider(tracingConfig configs.Tracing, logger *zap.Logger) (trace.TracerProvider, func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !tracingConfig.Enabled {
+		tracerProvider := noop.NewTracerProvider()
+		otel.SetTracerProvider(tracerProvider)
+		return tracerProvider, func() {}, nil
+	}
+
+	spanExporter, err := newSpanExporter(context.Background(), tracingConfig)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create span exporter")
+		return nil, nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(tracingConfig.GetServiceName()))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(tracingConfig.SampleRatio))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	cleanup := func() {
+		// Spans still buffered are exported before the process exits.
+		if err := tracerProvider.Shutdown(context.Background()); err != nil {
+			logger.With(zap.Error(err)).Error("failed to shut down tracer provider")
+		}
+	}
+
+	return tracerProvider, cleanup, nil
+}
@@ -4,4 +4,5 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	InitializeLogger,
+	InitializeTracerProvider,
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/hoangdv99/morgana/internal/app"
 	"github.com/hoangdv99/morgana/internal/configs"
 	"github.com/hoangdv99/morgana/internal/dataaccess"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/consumer"
 	"github.com/hoangdv99/morgana/internal/handler"
 	"github.com/hoangdv99/morgana/internal/logic"
 	"github.com/hoangdv99/morgana/internal/utils"
@@ -29,3 +30,27 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (*app.Sta
 
 	return nil, nil, nil
 }
+
+func InitializeAPIServer(configFilePath configs.ConfigFilePath) (*app.APIServer, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeWorker(configFilePath configs.ConfigFilePath) (*app.Worker, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeScheduler(configFilePath configs.ConfigFilePath) (*app.Scheduler, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeDeadLetterQueue(configFilePath configs.ConfigFilePath) (consumer.DeadLetterQueue, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
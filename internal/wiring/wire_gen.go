@@ -15,12 +15,15 @@ import (
 	"github.com/hoangdv99/morgana/internal/dataaccess/database"
 	"github.com/hoangdv99/morgana/internal/dataaccess/file"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/consumer"
+	"github.com/hoangdv99/morgana/internal/dataaccess/mq/inmemory"
 	"github.com/hoangdv99/morgana/internal/dataaccess/mq/producer"
+	"github.com/hoangdv99/morgana/internal/dataaccess/notifier"
 	"github.com/hoangdv99/morgana/internal/handler"
 	"github.com/hoangdv99/morgana/internal/handler/consumers"
 	"github.com/hoangdv99/morgana/internal/handler/grpc"
 	"github.com/hoangdv99/morgana/internal/handler/http"
 	"github.com/hoangdv99/morgana/internal/handler/jobs"
+	"github.com/hoangdv99/morgana/internal/handler/metrics"
 	"github.com/hoangdv99/morgana/internal/logic"
 	"github.com/hoangdv99/morgana/internal/utils"
 )
@@ -33,73 +36,684 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (*app.Sta
 		return nil, nil, err
 	}
 	configsDatabase := config.Database
+	tracing := config.Tracing
 	log := config.Log
 	logger, cleanup, err := utils.InitializeLogger(log)
 	if err != nil {
 		return nil, nil, err
 	}
-	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	tracerProvider, cleanup2, err := utils.InitializeTracerProvider(tracing, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	goquDatabase := database.InitializeGoquDB(db)
+	db, cleanup3, err := database.InitializeAndMigrateUpDB(configsDatabase, tracerProvider, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase, err := database.InitializeGoquDB(db, configsDatabase)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	configsCache := config.Cache
-	client := cache.NewRedisClient(configsCache, logger)
+	client, cleanup4 := cache.NewRedisClient(configsCache, tracerProvider, logger)
 	takenAccountName := cache.NewTakenAccountName(client, logger)
+	twoFactorChallenge := cache.NewTwoFactorChallenge(client, logger)
 	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(goquDatabase, logger)
+	accountIdentityDataAccessor := database.NewAccountIdentityDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	refreshTokenDataAccessor := database.NewRefreshTokenDataAccessor(goquDatabase, logger)
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(goquDatabase, logger)
+	configsNotifier := config.Notifier
+	notifierNotifier, err := notifier.NewNotifier(configsNotifier, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	auth := config.Auth
-	hash := logic.NewHash(auth)
+	hash, err := logic.NewHash(auth)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	tokenPublicKey := cache.NewTokenPublicKey(client, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
 	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyDataAccessor(goquDatabase, logger)
-	token, err := logic.NewToken(accountDataAccessor, tokenPublicKey, tokenPublicKeyDataAccessor, auth, logger)
+	token, err := logic.NewToken(accountDataAccessor, sessionDataAccessor, tokenPublicKey, revokedSession, tokenPublicKeyDataAccessor, goquDatabase, auth, configsCache, logger)
 	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	account := logic.NewAccount(goquDatabase, takenAccountName, accountDataAccessor, accountPasswordDataAccessor, hash, token, logger)
+	passwordPolicy, err := logic.NewPasswordPolicy(auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginAttempt := cache.NewLoginAttempt(client, logger)
+	auditEventDataAccessor := database.NewAuditEventDataAccessor(goquDatabase, logger)
+	loginThrottle, err := logic.NewLoginThrottle(loginAttempt, auditEventDataAccessor, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTwoFactorDataAccessor := database.NewAccountTwoFactorDataAccessor(goquDatabase, logger)
+	accountTwoFactorRecoveryCodeDataAccessor := database.NewAccountTwoFactorRecoveryCodeDataAccessor(goquDatabase, logger)
+	twoFactor, err := logic.NewTwoFactor(goquDatabase, accountDataAccessor, accountTwoFactorDataAccessor, accountTwoFactorRecoveryCodeDataAccessor, token, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	account, err := logic.NewAccount(goquDatabase, takenAccountName, twoFactorChallenge, accountDataAccessor, accountPasswordDataAccessor, accountIdentityDataAccessor, sessionDataAccessor, refreshTokenDataAccessor, passwordResetTokenDataAccessor, notifierNotifier, hash, token, passwordPolicy, loginThrottle, twoFactor, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, accountDataAccessor, token, logger)
+	organizationDataAccessor := database.NewOrganizationDataAccessor(goquDatabase, logger)
+	organizationMemberDataAccessor := database.NewOrganizationMemberDataAccessor(goquDatabase, logger)
 	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
 	mq := config.MQ
-	producerClient, err := producer.NewClient(mq, logger)
+	broker := inmemory.NewBroker()
+	producerClient, cleanup5, err := producer.NewClient(mq, broker, tracerProvider, logger)
 	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
 	download := config.Download
-	fileClient, err := file.NewClient(download, logger)
+	blobDataAccessor := database.NewBlobDataAccessor(goquDatabase, logger)
+	blobReferenceDataAccessor := database.NewBlobReferenceDataAccessor(goquDatabase, logger)
+	fileClient, err := file.NewClient(download, goquDatabase, blobDataAccessor, blobReferenceDataAccessor, logger)
 	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	compressor, err := file.NewCompressor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	encryptor, err := file.NewEncryptor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	cron := config.Cron
-	downloadTask := logic.NewDownloadTask(token, accountDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, logger, cron)
+	quota := config.Quota
+	downloadTask, err := logic.NewDownloadTask(token, apiKey, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, compressor, encryptor, tracerProvider, logger, cron, quota)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	organization := logic.NewOrganization(goquDatabase, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, token, logger)
 	configsGRPC := config.GRPC
-	morganaServiceServer, err := grpc.NewHandler(account, downloadTask, configsGRPC)
+	morganaServiceServer, err := grpc.NewHandler(account, twoFactor, apiKey, downloadTask, organization, configsGRPC)
 	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	server := grpc.NewServer(morganaServiceServer, configsGRPC, logger)
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, sessionDataAccessor, downloadTaskDataAccessor, auditEventDataAccessor, token, downloadTask, loginThrottle, auth, logger)
+	adminServiceServer := grpc.NewAdminHandler(admin)
+	shutdown := config.Shutdown
+	server := grpc.NewServer(morganaServiceServer, adminServiceServer, configsGRPC, shutdown, tracerProvider, logger)
 	configsHTTP := config.HTTP
-	httpServer := http.NewServer(configsGRPC, configsHTTP, auth, logger)
+	httpServer := http.NewServer(account, downloadTask, token, configsGRPC, configsHTTP, auth, shutdown, tracerProvider, logger)
+	configsMetrics := config.Metrics
+	metricsServer := metrics.NewServer(configsMetrics, shutdown, logger)
+	apiServer := app.NewAPIServer(server, httpServer, metricsServer, logger, shutdown)
 	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTask, logger)
-	consumerConsumer, err := consumer.NewConsumer(mq, logger)
+	consumerConsumer, err := consumer.NewConsumer(mq, broker, tracerProvider, logger)
 	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	root := consumers.NewRoot(downloadTaskCreated, consumerConsumer, logger)
+	worker := app.NewWorker(root, metricsServer, logger, shutdown)
 	executeAllPendingDownloadTask := jobs.NewExecuteAllPendingDownloadTask(downloadTask)
 	updateDownloadingAndFailedDownloadTaskStatusToPending := jobs.NewUpdateDownloadingAndFailedDownloadTaskStatusToPending(downloadTask)
-	standaloneServer := app.NewStandaloneServer(server, httpServer, root, executeAllPendingDownloadTask, updateDownloadingAndFailedDownloadTaskStatusToPending, logger, cron)
+	rewrapAllDownloadTaskDataKey := jobs.NewRewrapAllDownloadTaskDataKey(downloadTask)
+	rotateTokenSigningKey := jobs.NewRotateTokenSigningKey(token)
+	leaderLeaseDataAccessor := database.NewLeaderLeaseDataAccessor(goquDatabase, logger)
+	leaderElection, err := logic.NewLeaderElection(leaderLeaseDataAccessor, goquDatabase, cron, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	scheduler := app.NewScheduler(executeAllPendingDownloadTask, updateDownloadingAndFailedDownloadTaskStatusToPending, rewrapAllDownloadTaskDataKey, rotateTokenSigningKey, leaderElection, metricsServer, tracerProvider, logger, cron, shutdown)
+	standaloneServer := app.NewStandaloneServer(apiServer, worker, scheduler, metricsServer, logger, shutdown)
 	return standaloneServer, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeAPIServer(configFilePath configs.ConfigFilePath) (*app.APIServer, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	tracing := config.Tracing
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	tracerProvider, cleanup2, err := utils.InitializeTracerProvider(tracing, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	db, cleanup3, err := database.InitializeAndMigrateUpDB(configsDatabase, tracerProvider, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase, err := database.InitializeGoquDB(db, configsDatabase)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsCache := config.Cache
+	client, cleanup4 := cache.NewRedisClient(configsCache, tracerProvider, logger)
+	takenAccountName := cache.NewTakenAccountName(client, logger)
+	twoFactorChallenge := cache.NewTwoFactorChallenge(client, logger)
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(goquDatabase, logger)
+	accountIdentityDataAccessor := database.NewAccountIdentityDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	refreshTokenDataAccessor := database.NewRefreshTokenDataAccessor(goquDatabase, logger)
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(goquDatabase, logger)
+	configsNotifier := config.Notifier
+	notifierNotifier, err := notifier.NewNotifier(configsNotifier, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	auth := config.Auth
+	hash, err := logic.NewHash(auth)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenPublicKey := cache.NewTokenPublicKey(client, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
+	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyDataAccessor(goquDatabase, logger)
+	token, err := logic.NewToken(accountDataAccessor, sessionDataAccessor, tokenPublicKey, revokedSession, tokenPublicKeyDataAccessor, goquDatabase, auth, configsCache, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordPolicy, err := logic.NewPasswordPolicy(auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginAttempt := cache.NewLoginAttempt(client, logger)
+	auditEventDataAccessor := database.NewAuditEventDataAccessor(goquDatabase, logger)
+	loginThrottle, err := logic.NewLoginThrottle(loginAttempt, auditEventDataAccessor, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTwoFactorDataAccessor := database.NewAccountTwoFactorDataAccessor(goquDatabase, logger)
+	accountTwoFactorRecoveryCodeDataAccessor := database.NewAccountTwoFactorRecoveryCodeDataAccessor(goquDatabase, logger)
+	twoFactor, err := logic.NewTwoFactor(goquDatabase, accountDataAccessor, accountTwoFactorDataAccessor, accountTwoFactorRecoveryCodeDataAccessor, token, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	account, err := logic.NewAccount(goquDatabase, takenAccountName, twoFactorChallenge, accountDataAccessor, accountPasswordDataAccessor, accountIdentityDataAccessor, sessionDataAccessor, refreshTokenDataAccessor, passwordResetTokenDataAccessor, notifierNotifier, hash, token, passwordPolicy, loginThrottle, twoFactor, auth, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, accountDataAccessor, token, logger)
+	organizationDataAccessor := database.NewOrganizationDataAccessor(goquDatabase, logger)
+	organizationMemberDataAccessor := database.NewOrganizationMemberDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker()
+	producerClient, cleanup5, err := producer.NewClient(mq, broker, tracerProvider, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
+	download := config.Download
+	blobDataAccessor := database.NewBlobDataAccessor(goquDatabase, logger)
+	blobReferenceDataAccessor := database.NewBlobReferenceDataAccessor(goquDatabase, logger)
+	fileClient, err := file.NewClient(download, goquDatabase, blobDataAccessor, blobReferenceDataAccessor, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	compressor, err := file.NewCompressor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	encryptor, err := file.NewEncryptor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cron := config.Cron
+	quota := config.Quota
+	downloadTask, err := logic.NewDownloadTask(token, apiKey, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, compressor, encryptor, tracerProvider, logger, cron, quota)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	organization := logic.NewOrganization(goquDatabase, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, token, logger)
+	configsGRPC := config.GRPC
+	morganaServiceServer, err := grpc.NewHandler(account, twoFactor, apiKey, downloadTask, organization, configsGRPC)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, sessionDataAccessor, downloadTaskDataAccessor, auditEventDataAccessor, token, downloadTask, loginThrottle, auth, logger)
+	adminServiceServer := grpc.NewAdminHandler(admin)
+	shutdown := config.Shutdown
+	server := grpc.NewServer(morganaServiceServer, adminServiceServer, configsGRPC, shutdown, tracerProvider, logger)
+	configsHTTP := config.HTTP
+	httpServer := http.NewServer(account, downloadTask, token, configsGRPC, configsHTTP, auth, shutdown, tracerProvider, logger)
+	configsMetrics := config.Metrics
+	metricsServer := metrics.NewServer(configsMetrics, shutdown, logger)
+	apiServer := app.NewAPIServer(server, httpServer, metricsServer, logger, shutdown)
+	return apiServer, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeWorker(configFilePath configs.ConfigFilePath) (*app.Worker, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	tracing := config.Tracing
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	tracerProvider, cleanup2, err := utils.InitializeTracerProvider(tracing, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	db, cleanup3, err := database.InitializeAndMigrateUpDB(configsDatabase, tracerProvider, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase, err := database.InitializeGoquDB(db, configsDatabase)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	configsCache := config.Cache
+	client, cleanup4 := cache.NewRedisClient(configsCache, tracerProvider, logger)
+	tokenPublicKey := cache.NewTokenPublicKey(client, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
+	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyDataAccessor(goquDatabase, logger)
+	auth := config.Auth
+	token, err := logic.NewToken(accountDataAccessor, sessionDataAccessor, tokenPublicKey, revokedSession, tokenPublicKeyDataAccessor, goquDatabase, auth, configsCache, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, accountDataAccessor, token, logger)
+	organizationDataAccessor := database.NewOrganizationDataAccessor(goquDatabase, logger)
+	organizationMemberDataAccessor := database.NewOrganizationMemberDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker()
+	producerClient, cleanup5, err := producer.NewClient(mq, broker, tracerProvider, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
+	download := config.Download
+	blobDataAccessor := database.NewBlobDataAccessor(goquDatabase, logger)
+	blobReferenceDataAccessor := database.NewBlobReferenceDataAccessor(goquDatabase, logger)
+	fileClient, err := file.NewClient(download, goquDatabase, blobDataAccessor, blobReferenceDataAccessor, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	compressor, err := file.NewCompressor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	encryptor, err := file.NewEncryptor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cron := config.Cron
+	quota := config.Quota
+	downloadTask, err := logic.NewDownloadTask(token, apiKey, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, compressor, encryptor, tracerProvider, logger, cron, quota)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTask, logger)
+	consumerConsumer, err := consumer.NewConsumer(mq, broker, tracerProvider, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	root := consumers.NewRoot(downloadTaskCreated, consumerConsumer, logger)
+	configsMetrics := config.Metrics
+	shutdown := config.Shutdown
+	server := metrics.NewServer(configsMetrics, shutdown, logger)
+	worker := app.NewWorker(root, server, logger, shutdown)
+	return worker, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeScheduler(configFilePath configs.ConfigFilePath) (*app.Scheduler, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	tracing := config.Tracing
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	tracerProvider, cleanup2, err := utils.InitializeTracerProvider(tracing, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	db, cleanup3, err := database.InitializeAndMigrateUpDB(configsDatabase, tracerProvider, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase, err := database.InitializeGoquDB(db, configsDatabase)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	configsCache := config.Cache
+	client, cleanup4 := cache.NewRedisClient(configsCache, tracerProvider, logger)
+	tokenPublicKey := cache.NewTokenPublicKey(client, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
+	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyDataAccessor(goquDatabase, logger)
+	auth := config.Auth
+	token, err := logic.NewToken(accountDataAccessor, sessionDataAccessor, tokenPublicKey, revokedSession, tokenPublicKeyDataAccessor, goquDatabase, auth, configsCache, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, accountDataAccessor, token, logger)
+	organizationDataAccessor := database.NewOrganizationDataAccessor(goquDatabase, logger)
+	organizationMemberDataAccessor := database.NewOrganizationMemberDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker()
+	producerClient, cleanup5, err := producer.NewClient(mq, broker, tracerProvider, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
+	download := config.Download
+	blobDataAccessor := database.NewBlobDataAccessor(goquDatabase, logger)
+	blobReferenceDataAccessor := database.NewBlobReferenceDataAccessor(goquDatabase, logger)
+	fileClient, err := file.NewClient(download, goquDatabase, blobDataAccessor, blobReferenceDataAccessor, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	compressor, err := file.NewCompressor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	encryptor, err := file.NewEncryptor(download, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	cron := config.Cron
+	quota := config.Quota
+	downloadTask, err := logic.NewDownloadTask(token, apiKey, accountDataAccessor, organizationDataAccessor, organizationMemberDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, compressor, encryptor, tracerProvider, logger, cron, quota)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	executeAllPendingDownloadTask := jobs.NewExecuteAllPendingDownloadTask(downloadTask)
+	updateDownloadingAndFailedDownloadTaskStatusToPending := jobs.NewUpdateDownloadingAndFailedDownloadTaskStatusToPending(downloadTask)
+	rewrapAllDownloadTaskDataKey := jobs.NewRewrapAllDownloadTaskDataKey(downloadTask)
+	rotateTokenSigningKey := jobs.NewRotateTokenSigningKey(token)
+	leaderLeaseDataAccessor := database.NewLeaderLeaseDataAccessor(goquDatabase, logger)
+	leaderElection, err := logic.NewLeaderElection(leaderLeaseDataAccessor, goquDatabase, cron, logger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsMetrics := config.Metrics
+	shutdown := config.Shutdown
+	server := metrics.NewServer(configsMetrics, shutdown, logger)
+	scheduler := app.NewScheduler(executeAllPendingDownloadTask, updateDownloadingAndFailedDownloadTaskStatusToPending, rewrapAllDownloadTaskDataKey, rotateTokenSigningKey, leaderElection, server, tracerProvider, logger, cron, shutdown)
+	return scheduler, func() {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeDeadLetterQueue(configFilePath configs.ConfigFilePath) (consumer.DeadLetterQueue, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	mq := config.MQ
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	deadLetterQueue, cleanup2, err := consumer.NewDeadLetterQueue(mq, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return deadLetterQueue, func() {
 		cleanup2()
 		cleanup()
 	}, nil